@@ -7,6 +7,7 @@ import (
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
 
 	v1 "kubevirt.io/api/core/v1"
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
 )
@@ -32,6 +33,17 @@ type HyperConvergedTuningPolicy string
 const (
 	HyperConvergedAnnotationTuningPolicy HyperConvergedTuningPolicy = "annotation"
 	HyperConvergedHighBurstProfile       HyperConvergedTuningPolicy = "highBurst"
+	HyperConvergedCustomTuningPolicy     HyperConvergedTuningPolicy = "custom"
+)
+
+type HyperConvergedWorkloadDensityPreset string
+
+// Workload density presets configure the CPU and memory overcommit, KSM and the default eviction strategy of the
+// virtual machines together.
+const (
+	HyperConvergedDensePreset       HyperConvergedWorkloadDensityPreset = "dense"
+	HyperConvergedBalancedPreset    HyperConvergedWorkloadDensityPreset = "balanced"
+	HyperConvergedPerformancePreset HyperConvergedWorkloadDensityPreset = "performance"
 )
 
 // HyperConvergedSpec defines the desired state of HyperConverged
@@ -48,15 +60,37 @@ type HyperConvergedSpec struct {
 	// If TuningPolicy is not present the default kubevirt values are used.
 	// It can be set to `annotation` for fine-tuning the kubevirt queryPerSeconds (qps) and burst values.
 	// Qps and burst values are taken from the annotation hco.kubevirt.io/tuningPolicy
-	// +kubebuilder:validation:Enum=annotation;highBurst
+	// It can be set to `custom` to take the qps and burst values from the tuningPolicyRates field.
+	// +kubebuilder:validation:Enum=annotation;highBurst;custom
 	// +optional
 	TuningPolicy HyperConvergedTuningPolicy `json:"tuningPolicy,omitempty"`
 
-	// infra HyperConvergedConfig influences the pod configuration (currently only placement)
+	// TuningPolicyRates holds the queryPerSeconds (qps) and burst values of the kubevirt rate limiters. It is only
+	// used, and is required, when the tuningPolicy is `custom`.
+	// +optional
+	TuningPolicyRates *TuningPolicyRates `json:"tuningPolicyRates,omitempty"`
+
+	// WorkloadDensityPreset selects a named set of values for the CPU allocation ratio, the memory overcommit, KSM
+	// and the default eviction strategy of the virtual machines.
+	// - `dense` packs as many VMs as possible on each node: high CPU and memory overcommit, and KSM enabled.
+	// - `balanced` uses the KubeVirt default CPU allocation ratio, without memory overcommit.
+	// - `performance` allocates a full physical CPU for each virtual CPU, without memory overcommit.
+	// Explicitly set fields, like resourceRequirements.vmiCPUAllocationRatio or evictionStrategy, take precedence
+	// over the preset values. If WorkloadDensityPreset is not present, the default KubeVirt values are used.
+	// +kubebuilder:validation:Enum=dense;balanced;performance
+	// +optional
+	WorkloadDensityPreset HyperConvergedWorkloadDensityPreset `json:"workloadDensityPreset,omitempty"`
+
+	// HigherWorkloadDensity holds the configuration of features that allow running more virtual machines on each
+	// node.
+	// +optional
+	HigherWorkloadDensity *HigherWorkloadDensityConfiguration `json:"higherWorkloadDensity,omitempty"`
+
+	// infra HyperConvergedInfraConfig influences the pod configuration (currently only placement)
 	// for all the infra components needed on the virtualization enabled cluster
 	// but not necessarily directly on each node running VMs/VMIs.
 	// +optional
-	Infra HyperConvergedConfig `json:"infra,omitempty"`
+	Infra HyperConvergedInfraConfig `json:"infra,omitempty"`
 
 	// workloads HyperConvergedConfig influences the pod configuration (currently only placement) of components
 	// which need to be running on a node where virtualization workloads should be able to run.
@@ -76,6 +110,14 @@ type HyperConvergedSpec struct {
 	// +optional
 	LiveMigrationConfig LiveMigrationConfigurations `json:"liveMigrationConfig,omitempty"`
 
+	// MigrationPolicies is a list of KubeVirt MigrationPolicies, that override the live migration configuration for the
+	// VMs they select. HCO creates a MigrationPolicy for each item of the list and keeps it aligned with the item, and
+	// removes the MigrationPolicies of items that were removed from the list.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	MigrationPolicies []MigrationPolicyTemplate `json:"migrationPolicies,omitempty"`
+
 	// PermittedHostDevices holds information about devices allowed for passthrough
 	// +optional
 	PermittedHostDevices *PermittedHostDevices `json:"permittedHostDevices,omitempty"`
@@ -111,6 +153,7 @@ type HyperConvergedSpec struct {
 	// When VMI has CPU model set, then VMI's CPU model is preferred.
 	// When default CPU model is not set and VMI's CPU model is not set too, host-model will be set.
 	// Default CPU model can be changed when kubevirt is running.
+	// The default CPU model must not be an obsolete CPU model.
 	// +optional
 	DefaultCPUModel *string `json:"defaultCPUModel,omitempty"`
 
@@ -120,15 +163,28 @@ type HyperConvergedSpec struct {
 	// +optional
 	DefaultRuntimeClass *string `json:"defaultRuntimeClass,omitempty"`
 
+	// VMISeccompProfile defines the seccomp profile of the virt-launcher pods. Use `RuntimeDefault` to enforce the
+	// default profile of the container runtime, or `Localhost`, with the localhostProfile field, to use a profile that
+	// is installed on the nodes. `Unconfined` does not set any seccomp profile. If not set, the KubeVirt seccomp
+	// profile (kubevirt/kubevirt.json) is used.
+	// +optional
+	VMISeccompProfile *corev1.SeccompProfile `json:"vmiSeccompProfile,omitempty"`
+
 	// ObsoleteCPUs allows avoiding scheduling of VMs for obsolete CPU models
 	// +optional
 	ObsoleteCPUs *HyperConvergedObsoleteCPUs `json:"obsoleteCPUs,omitempty"`
 
 	// CommonTemplatesNamespace defines namespace in which common templates will
-	// be deployed. It overrides the default openshift namespace.
+	// be deployed. It overrides the default openshift namespace. HCO creates the
+	// namespace if it does not exist.
 	// +optional
 	CommonTemplatesNamespace *string `json:"commonTemplatesNamespace,omitempty"`
 
+	// CommonInstancetypes configures the deployment of the common cluster-wide instance types and preferences by the
+	// SSP operator.
+	// +optional
+	CommonInstancetypes *CommonInstancetypesConfig `json:"commonInstancetypes,omitempty"`
+
 	// StorageImport contains configuration for importing containerized data
 	// +optional
 	StorageImport *StorageImportConfig `json:"storageImport,omitempty"`
@@ -147,6 +203,18 @@ type HyperConvergedSpec struct {
 	// +optional
 	FilesystemOverhead *cdiv1beta1.FilesystemOverhead `json:"filesystemOverhead,omitempty"`
 
+	// DataVolumeTTLSeconds is the time in seconds after a DataVolume is completed, that it can be garbage collected.
+	// Set it to -1 to disable the garbage collection. If not set, the CDI default is used.
+	// +kubebuilder:validation:Minimum=-1
+	// +optional
+	DataVolumeTTLSeconds *int32 `json:"dataVolumeTTLSeconds,omitempty"`
+
+	// UploadProxyURLOverride overrides the URL of the CDI upload proxy, that clients like virtctl image-upload use to
+	// upload images to DataVolumes. Set it if the upload proxy is exposed by a custom route or ingress, for example on a
+	// custom ingress domain. If the URL has no scheme, https is used.
+	// +optional
+	UploadProxyURLOverride *string `json:"uploadProxyURLOverride,omitempty"`
+
 	// UninstallStrategy defines how to proceed on uninstall when workloads (VirtualMachines, DataVolumes) still exist.
 	// BlockUninstallIfWorkloadsExist will prevent the CR from being removed when workloads still exist.
 	// BlockUninstallIfWorkloadsExist is the safest choice to protect your workloads from accidental data loss, so it's strongly advised.
@@ -215,6 +283,62 @@ type HyperConvergedSpec struct {
 	//
 	// +optional
 	CommonBootImageNamespace *string `json:"commonBootImageNamespace,omitempty"`
+
+	// CommonBootImageRegistryOverride replaces the registry of the common boot images, in order to import them from a
+	// mirror registry, e.g. in disconnected environments. The value is the registry host, optionally with a port and
+	// a path prefix; e.g. "mirror.example.com:5000/containerdisks".
+	//
+	// If set, HCO replaces the registry in the source URL of each common DataImportCronTemplate, but keeps the image
+	// repository and tag. Modified common templates and custom templates are not affected. This field is not set by
+	// default.
+	//
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9]([a-zA-Z0-9.-]*[a-zA-Z0-9])?(:[0-9]+)?(/[a-zA-Z0-9._-]+)*$`
+	// +optional
+	CommonBootImageRegistryOverride *string `json:"commonBootImageRegistryOverride,omitempty"`
+
+	// TrustedCABundle is the name of a ConfigMap in the HyperConverged namespace, that contains additional trusted CA
+	// certificates in PEM format; e.g. the CA of a mirror registry. HCO copies the ConfigMap to the namespaces of the
+	// DataImportCronTemplates, and sets it as the certConfigMap of the registry sources that do not set their own
+	// certConfigMap, so the boot images can be imported from registries that are signed by this CA.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	TrustedCABundle *string `json:"trustedCABundle,omitempty"`
+
+	// TenantQuotaTemplates is a list of default virtualization quotas. When the EnableManagedTenantQuota feature gate is
+	// enabled, HCO creates a VirtualMachineMigrationResourceQuota in each one of the listed namespaces, and keeps
+	// it aligned with the template.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	TenantQuotaTemplates []TenantQuotaTemplate `json:"tenantQuotaTemplates,omitempty"`
+
+	// MaintenanceWindow defines a recurring time window for the automatic workload updates. When set, HCO defers the
+	// automatic workload updates to the maintenance window, while all the other changes are still applied immediately.
+	// The certificate rotations are not deferred. If not set, all the changes are applied immediately.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// Monitoring holds the configuration of the HCO alerts.
+	// +optional
+	Monitoring *MonitoringConfig `json:"monitoring,omitempty"`
+
+	// Metadata holds custom labels and annotations, that HCO adds to all the resources it creates; e.g. the operand
+	// CRs, the monitoring resources, the quick starts and the services. The labels and annotations that HCO sets
+	// itself take precedence over these values.
+	// +optional
+	Metadata *ResourcesMetadata `json:"metadata,omitempty"`
+}
+
+// ResourcesMetadata defines custom labels and annotations for the resources that HCO creates.
+// +k8s:openapi-gen=true
+type ResourcesMetadata struct {
+	// Labels to add to the resources that HCO creates.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations to add to the resources that HCO creates.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
 // CertRotateConfigCA contains the tunables for TLS certificates.
@@ -276,6 +400,28 @@ type HyperConvergedConfig struct {
 	// NodePlacement describes node scheduling configuration.
 	// +optional
 	NodePlacement *sdkapi.NodePlacement `json:"nodePlacement,omitempty"`
+
+	// Replicas is the number of replicas of the control-plane deployments of the operands; i.e. virt-api,
+	// virt-controller and the template validator. It is only supported in the infra configuration, and must not exceed
+	// the number of the nodes that are available for the infra components. If not set, the replica count is decided by
+	// the operands, according to the cluster topology.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Replicas *uint8 `json:"replicas,omitempty"`
+}
+
+// HyperConvergedInfraConfig defines a set of configurations to pass to the infra components
+type HyperConvergedInfraConfig struct {
+	HyperConvergedConfig `json:",inline"`
+
+	// TopologySpreadConstraints describes how the pods are spread across the topology domains; e.g. the zones. It is
+	// applied to the deployments that HCO deploys directly, as the operands do not support it yet. If the label
+	// selector of a constraint is not set, the labels of the pods of each deployment are used.
+	// +listType=map
+	// +listMapKey=topologyKey
+	// +listMapKey=whenUnsatisfiable
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 }
 
 // LiveMigrationConfigurations - Live migration limits and timeouts are applied so that migration processes do not
@@ -335,6 +481,287 @@ type LiveMigrationConfigurations struct {
 	AllowPostCopy *bool `json:"allowPostCopy,omitempty"`
 }
 
+// MaintenanceWindowDay is a day of the week
+// +kubebuilder:validation:Enum=Sunday;Monday;Tuesday;Wednesday;Thursday;Friday;Saturday
+type MaintenanceWindowDay string
+
+// MaintenanceWindow defines a weekly recurring time window
+// +k8s:openapi-gen=true
+type MaintenanceWindow struct {
+	// Days is the list of the days of the week when the maintenance window opens. If not set, the maintenance window
+	// opens every day.
+	// +listType=set
+	// +optional
+	Days []MaintenanceWindowDay `json:"days,omitempty"`
+
+	// StartTime is the time of the day when the maintenance window opens, in the 24-hour "HH:MM" format.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	StartTime string `json:"startTime"`
+
+	// Duration is the length of the maintenance window.
+	Duration metav1.Duration `json:"duration"`
+
+	// TimeZone is the IANA name of the time zone of the startTime field, e.g. "Europe/Berlin". Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// MaintenanceWindowStatus reports the state of the maintenance window
+// +k8s:openapi-gen=true
+type MaintenanceWindowStatus struct {
+	// Open is true while the maintenance window is open.
+	Open bool `json:"open"`
+
+	// NextTransition is the time when the maintenance window is going to be opened, if it's currently closed, or to be
+	// closed, if it's currently open.
+	// +optional
+	NextTransition *metav1.Time `json:"nextTransition,omitempty"`
+
+	// DeferredChanges is the list of the disruptive changes that are waiting for the maintenance window.
+	// +listType=set
+	// +optional
+	DeferredChanges []string `json:"deferredChanges,omitempty"`
+}
+
+// MonitoringConfig holds the configuration of the HCO alerts.
+// +k8s:openapi-gen=true
+type MonitoringConfig struct {
+	// RunbookURLTemplate is the template of the runbook_url annotation of the HCO alerts. It must contain exactly one
+	// "%s" placeholder, that is replaced by the alert name. If not set, HCO uses the RUNBOOK_URL_TEMPLATE environment
+	// variable of the operator, or "https://kubevirt.io/monitoring/runbooks/%s" if the environment variable is not set.
+	// +kubebuilder:validation:Pattern=`^[^%]*%s[^%]*$`
+	// +optional
+	RunbookURLTemplate string `json:"runbookURLTemplate,omitempty"`
+
+	// AlertOverrides overrides the severity and the "for" duration of specific HCO alerts. The alert expressions, and
+	// so their thresholds, can't be overridden.
+	// +listType=map
+	// +listMapKey=alertName
+	// +optional
+	AlertOverrides []AlertOverride `json:"alertOverrides,omitempty"`
+
+	// DisabledAlerts is a list of HCO alerts to remove from the HCO PrometheusRule.
+	// +listType=set
+	// +optional
+	DisabledAlerts []HCOAlertName `json:"disabledAlerts,omitempty"`
+
+	// AlertRouting routes the HCO alerts to an Alertmanager webhook receiver. When set, HCO creates and manages an
+	// AlertmanagerConfig in its namespace, if the AlertmanagerConfig CRD is available.
+	// +optional
+	AlertRouting *AlertRoutingConfig `json:"alertRouting,omitempty"`
+
+	// ServiceMonitor configures how Prometheus scrapes the HCO metrics, using the HCO ServiceMonitor. HCO still
+	// manages all the other fields of the ServiceMonitor.
+	// +optional
+	ServiceMonitor *ServiceMonitorConfig `json:"serviceMonitor,omitempty"`
+}
+
+// ServiceMonitorConfig holds the configurable scrape settings of the HCO ServiceMonitor.
+// +k8s:openapi-gen=true
+type ServiceMonitorConfig struct {
+	// Interval is the interval between the scrapes of the HCO metrics. If not set, the scrape interval of the
+	// Prometheus instance is used.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// HonorLabels keeps the labels of the scraped metrics, when they collide with the labels of the target.
+	// +optional
+	HonorLabels bool `json:"honorLabels,omitempty"`
+
+	// MetricRelabelings is a list of relabeling rules, that are applied to the scraped samples before ingestion;
+	// e.g. to drop high-cardinality series.
+	// +listType=atomic
+	// +optional
+	MetricRelabelings []MetricRelabelConfig `json:"metricRelabelings,omitempty"`
+}
+
+// MetricRelabelConfig is a Prometheus relabeling rule of the scraped samples.
+// +k8s:openapi-gen=true
+type MetricRelabelConfig struct {
+	// SourceLabels is the list of the labels to select the values from. Their values are concatenated using the
+	// separator, and matched against the regular expression.
+	// +listType=atomic
+	// +optional
+	SourceLabels []string `json:"sourceLabels,omitempty"`
+
+	// Separator is the string between the concatenated values of the source labels. Prometheus uses ";" if not set.
+	// +optional
+	Separator string `json:"separator,omitempty"`
+
+	// TargetLabel is the label to write the result to, in a replace action. It is required for the replace action.
+	// +optional
+	TargetLabel string `json:"targetLabel,omitempty"`
+
+	// Regex is the regular expression to match the concatenated values against. Prometheus uses "(.*)" if not set.
+	// +optional
+	Regex string `json:"regex,omitempty"`
+
+	// Replacement is the value to write to the target label in a replace action. Regex capture groups are available.
+	// Prometheus uses "$1" if not set.
+	// +optional
+	Replacement string `json:"replacement,omitempty"`
+
+	// Action is the relabeling action to perform. Defaults to replace.
+	// +kubebuilder:validation:Enum=replace;keep;drop;labelmap;labeldrop;labelkeep
+	// +optional
+	Action string `json:"action,omitempty"`
+}
+
+// AlertRoutingConfig defines the Alertmanager webhook receiver of the HCO alerts.
+// +kubebuilder:validation:XValidation:rule="has(self.webhookURL) != has(self.webhookURLSecret)",message="exactly one of webhookURL and webhookURLSecret must be set"
+// +k8s:openapi-gen=true
+type AlertRoutingConfig struct {
+	// WebhookURL is the URL of the webhook receiver.
+	// +optional
+	WebhookURL string `json:"webhookURL,omitempty"`
+
+	// WebhookURLSecret selects a key of a Secret in the HCO namespace, that holds the URL of the webhook receiver.
+	// +optional
+	WebhookURLSecret *corev1.SecretKeySelector `json:"webhookURLSecret,omitempty"`
+
+	// SendResolved controls whether to notify the webhook receiver about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+}
+
+// HCOAlertName is the name of an HCO alert
+// +kubebuilder:validation:Enum=KubeVirtCRModified;UnsupportedHCOModification;HCOInstallationIncomplete;SingleStackIPv6Unsupported;HCONotUpgradeable;HCOGoldenImageImportFailing;HCOOperandReconcilePaused;HCOUpgradeTimedOut
+type HCOAlertName string
+
+// AlertOverride overrides the configuration of a single HCO alert.
+// +k8s:openapi-gen=true
+type AlertOverride struct {
+	// AlertName is the name of the HCO alert to override.
+	AlertName HCOAlertName `json:"alertName"`
+
+	// Severity overrides the severity label of the alert.
+	// +kubebuilder:validation:Enum=critical;warning;info
+	// +optional
+	Severity string `json:"severity,omitempty"`
+
+	// For overrides the time that the alert condition must be true, before the alert fires. A zero duration fires the
+	// alert as soon as the condition is true.
+	// +optional
+	For *metav1.Duration `json:"for,omitempty"`
+}
+
+// ConfigurationDriftReport reports, per operand, if the live object matches the object that HCO would render from the
+// HyperConverged CR.
+// +k8s:openapi-gen=true
+type ConfigurationDriftReport struct {
+	// Trigger is the value of the hco.kubevirt.io/configurationDriftReport annotation that triggered this report.
+	Trigger string `json:"trigger"`
+
+	// ReportTime is the time when the report was generated.
+	ReportTime metav1.Time `json:"reportTime"`
+
+	// Operands is the list of the checked operands.
+	// +listType=atomic
+	// +optional
+	Operands []OperandDrift `json:"operands,omitempty"`
+}
+
+// OperandDrift reports the configuration drift of a single operand.
+// +k8s:openapi-gen=true
+type OperandDrift struct {
+	// Kind is the kind of the operand.
+	Kind string `json:"kind"`
+
+	// Name is the name of the operand.
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the operand; empty for cluster-scoped operands.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// InSync is true if the live object matches the object that HCO would render.
+	InSync bool `json:"inSync"`
+
+	// Missing is true if the object does not exist.
+	// +optional
+	Missing bool `json:"missing,omitempty"`
+
+	// DiffPaths is the list of the paths of the fields with a different value than the one that HCO would render.
+	// +listType=atomic
+	// +optional
+	DiffPaths []string `json:"diffPaths,omitempty"`
+}
+
+// ForceResyncStatus reports the last resync of the operands, that was forced by the hco.kubevirt.io/forceResync
+// annotation.
+// +k8s:openapi-gen=true
+type ForceResyncStatus struct {
+	// Trigger is the value of the hco.kubevirt.io/forceResync annotation that triggered the resync.
+	Trigger string `json:"trigger"`
+
+	// ResyncTime is the time when the resync was completed.
+	ResyncTime metav1.Time `json:"resyncTime"`
+
+	// Rewritten is the list of the objects that were created or updated by the resync, in the "Kind name" format.
+	// +listType=atomic
+	// +optional
+	Rewritten []string `json:"rewritten,omitempty"`
+}
+
+// SpecMigrationStatus reports a migration of a deprecated field of the HyperConverged CR to its replacement, that was
+// done by HCO during an upgrade.
+// +k8s:openapi-gen=true
+type SpecMigrationStatus struct {
+	// Name is the name of the migration.
+	Name string `json:"name"`
+
+	// Message describes what was migrated.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Version is the HCO version that performed the migration.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// MigrationTime is the time when the migration was done.
+	MigrationTime metav1.Time `json:"migrationTime"`
+}
+
+// TenantQuotaTemplate defines a VirtualMachineMigrationResourceQuota, to be created by HCO in a set of namespaces.
+// +k8s:openapi-gen=true
+type TenantQuotaTemplate struct {
+	// Name is the name of the VirtualMachineMigrationResourceQuota to create in each one of the namespaces.
+	Name string `json:"name"`
+
+	// Namespaces is the list of the namespaces to create the quota in.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Namespaces []string `json:"namespaces"`
+
+	// AdditionalMigrationResources is the amount of resources, on top of the namespace ResourceQuota, that the
+	// VM migrations in the namespace are allowed to use.
+	AdditionalMigrationResources corev1.ResourceList `json:"additionalMigrationResources"`
+}
+
+// MigrationPolicyTemplate defines a KubeVirt MigrationPolicy, to be created by HCO.
+// +k8s:openapi-gen=true
+type MigrationPolicyTemplate struct {
+	// Name is the name of the MigrationPolicy.
+	Name string `json:"name"`
+
+	// Spec is the spec of the MigrationPolicy. The selectors select the VMs that the policy applies to, by the labels
+	// of the VMI and of its namespace.
+	Spec migrationsv1alpha1.MigrationPolicySpec `json:"spec"`
+}
+
+// HigherWorkloadDensityConfiguration holds the configuration of features that allow running more virtual machines
+// on each node.
+// +k8s:openapi-gen=true
+type HigherWorkloadDensityConfiguration struct {
+	// MemoryOvercommitPercentage is the percentage of memory that the virtual machines get, compared to the memory
+	// requested by their virt-launcher pods. For example, 150 means that the pod of a virtual machine with 1.5Gi of
+	// memory only requests 1Gi. This field takes precedence over the memory overcommit of the workloadDensityPreset.
+	// If it is not set, the memory overcommit of the workloadDensityPreset, or the KubeVirt default, is used.
+	// +kubebuilder:validation:Minimum=10
+	// +optional
+	MemoryOvercommitPercentage *int `json:"memoryOvercommitPercentage,omitempty"`
+}
+
 // VirtualMachineOptions holds the cluster level information regarding the virtual machine.
 type VirtualMachineOptions struct {
 	// DisableFreePageReporting disable the free page reporting of
@@ -530,6 +957,17 @@ type OperandResourceRequirements struct {
 	AutoCPULimitNamespaceLabelSelector *metav1.LabelSelector `json:"autoCPULimitNamespaceLabelSelector,omitempty"`
 }
 
+// CommonInstancetypesConfig configures the deployment of the common cluster-wide instance types and preferences
+type CommonInstancetypesConfig struct {
+	// URL of a remote Kustomize target, to deploy the VirtualMachineClusterInstancetype and the
+	// VirtualMachineClusterPreference resources from, instead of the common instance types and preferences that are
+	// bundled with the SSP operator. Only https:// and git:// URLs are supported, and the URL must be pinned to a
+	// specific reference, using the "?ref=" or the "?version=" query parameter.
+	// +kubebuilder:validation:Pattern=`^(https|git)://.+[?&](ref|version)=.+$`
+	// +optional
+	URL *string `json:"url,omitempty"`
+}
+
 // HyperConvergedObsoleteCPUs allows avoiding scheduling of VMs for obsolete CPU models
 // +k8s:openapi-gen=true
 type HyperConvergedObsoleteCPUs struct {
@@ -555,6 +993,24 @@ type StorageImportConfig struct {
 	// +listType=set
 	// +optional
 	InsecureRegistries []string `json:"insecureRegistries,omitempty"`
+
+	// ImportProxy contains the proxy configuration of the CDI importer pods. The TrustedCAProxy ConfigMap, if set,
+	// must be in the namespace of HyperConverged. If not set, CDI uses the OpenShift cluster-wide proxy, if there is
+	// one.
+	// +optional
+	ImportProxy *cdiv1beta1.ImportProxy `json:"importProxy,omitempty"`
+}
+
+// TuningPolicyRates holds the rate limiter values of the custom tuning policy
+// +k8s:openapi-gen=true
+type TuningPolicyRates struct {
+	// QPS is the number of queries per second that the kubevirt components may send to the API server
+	// +kubebuilder:validation:Minimum=1
+	QPS int32 `json:"qps"`
+
+	// Burst is the maximum number of queries that the kubevirt components may send to the API server at once
+	// +kubebuilder:validation:Minimum=1
+	Burst int32 `json:"burst"`
 }
 
 // HyperConvergedWorkloadUpdateStrategy defines options related to updating a KubeVirt install
@@ -614,6 +1070,30 @@ type HyperConvergedStatus struct {
 	// +optional
 	Versions []Version `json:"versions,omitempty"`
 
+	// OperandVersions is a list of the versions of the operands that are deployed by HCO, as name/version pairs. The
+	// name is the kind of the operand CR, and the version is the version that the operand reports in the status of
+	// its CR. Once an upgrade is completed, the versions of all the operands are the versions that are shipped with the
+	// HCO version.
+	// +listType=atomic
+	// +optional
+	OperandVersions []Version `json:"operandVersions,omitempty"`
+
+	// OperandConditions is a list of the conditions of the operands that are deployed by HCO, as reported in the status
+	// of their CRs. The conditions of the HyperConverged resource are aggregated from these conditions.
+	// +listType=map
+	// +listMapKey=component
+	// +listMapKey=type
+	// +optional
+	OperandConditions []OperandCondition `json:"operandConditions,omitempty"`
+
+	// OperandObservedGenerations is a list of the HyperConverged resource generations that were last successfully
+	// rendered into each operand. If the observedGeneration of an operand is less than the resource generation in
+	// metadata, the last change of the HyperConverged resource was not applied to this operand yet.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	OperandObservedGenerations []OperandObservedGeneration `json:"operandObservedGenerations,omitempty"`
+
 	// ObservedGeneration reflects the HyperConverged resource generation. If the ObservedGeneration is less than the
 	// resource generation in metadata, the status is out of date
 	// +optional
@@ -631,6 +1111,43 @@ type HyperConvergedStatus struct {
 	// SystemHealthStatus reflects the health of HCO and its secondary resources, based on the aggregated conditions.
 	// +optional
 	SystemHealthStatus string `json:"systemHealthStatus,omitempty"`
+
+	// ComponentsHealth details the health of each operand that is deployed by HCO, based on the conditions of the
+	// operand CR. The systemHealthStatus field aggregates the health of all the operands.
+	// +listType=map
+	// +listMapKey=component
+	// +optional
+	ComponentsHealth []ComponentHealth `json:"componentsHealth,omitempty"`
+
+	// Progress reports the progress of the deployment, or of the upgrade, of the operands.
+	// +optional
+	Progress *DeploymentProgress `json:"progress,omitempty"`
+
+	// ClusterTopology is the topology of the cluster, as detected by HCO; e.g. whether the control plane is hosted
+	// outside the cluster.
+	// +optional
+	ClusterTopology *ClusterTopology `json:"clusterTopology,omitempty"`
+
+	// MaintenanceWindow reports the state of the maintenance window, if configured.
+	// +optional
+	MaintenanceWindow *MaintenanceWindowStatus `json:"maintenanceWindow,omitempty"`
+
+	// ConfigurationDrift is the configuration drift report, that was requested by the
+	// hco.kubevirt.io/configurationDriftReport annotation.
+	// +optional
+	ConfigurationDrift *ConfigurationDriftReport `json:"configurationDrift,omitempty"`
+
+	// ForceResync reports the last resync of the operands, that was forced by the hco.kubevirt.io/forceResync
+	// annotation.
+	// +optional
+	ForceResync *ForceResyncStatus `json:"forceResync,omitempty"`
+
+	// SpecMigrations is the list of the migrations of deprecated fields to their replacements, that were done by HCO
+	// during the upgrades. Only the last run of each migration is kept.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	SpecMigrations []SpecMigrationStatus `json:"specMigrations,omitempty"`
 }
 
 type Version struct {
@@ -638,6 +1155,127 @@ type Version struct {
 	Version string `json:"version,omitempty"`
 }
 
+// ComponentHealth is the health of an operand, based on the conditions of the operand CR
+// +k8s:openapi-gen=true
+type ComponentHealth struct {
+	// Component is the kind of the operand CR; e.g. KubeVirt
+	Component string `json:"component"`
+
+	// Health is the health of the operand: "error" if the operand is not available or is degraded, "warning" if it is
+	// progressing or did not report its conditions yet, or "healthy" otherwise
+	// +kubebuilder:validation:Enum=healthy;warning;error
+	Health string `json:"health"`
+
+	// Reason is the reason of the condition of the operand CR that determined its health, if it is not healthy
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is the message of the condition of the operand CR that determined its health, if it is not healthy
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ClusterTopology is the topology of the cluster, as detected by HCO
+// +k8s:openapi-gen=true
+type ClusterTopology struct {
+	// ControlPlane is the topology of the control plane nodes. External means that the control plane is hosted
+	// outside the cluster, e.g. on a HyperShift hosted control plane, so there are no control plane nodes in the cluster.
+	// +kubebuilder:validation:Enum=HighlyAvailable;SingleReplica;External
+	ControlPlane string `json:"controlPlane"`
+
+	// Infrastructure is the topology of the infrastructure (worker) nodes
+	// +kubebuilder:validation:Enum=HighlyAvailable;SingleReplica
+	Infrastructure string `json:"infrastructure"`
+
+	// ConsoleAvailable tells whether the cluster console is installed. HCO deploys the console integrations, like the
+	// console plugin, the quick start guides and the CLI downloads, only if it is.
+	ConsoleAvailable bool `json:"consoleAvailable"`
+
+	// ManagedService is the managed OpenShift offering that the cluster is running on, if any; e.g. ROSA, OSD or ARO.
+	// HCO applies the guardrails of the managed service to the resources it deploys.
+	// +optional
+	ManagedService string `json:"managedService,omitempty"`
+}
+
+// DeploymentProgress is the progress of the deployment, or of the upgrade, of the operands
+// +k8s:openapi-gen=true
+type DeploymentProgress struct {
+	// ReadyComponents is the number of the operands that are ready
+	ReadyComponents int `json:"readyComponents"`
+
+	// TotalComponents is the number of the operands that are deployed by HCO
+	TotalComponents int `json:"totalComponents"`
+
+	// Message is a human-readable summary of the progress; e.g. "4/5 components ready"
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Components is the phase of each operand
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Components []ComponentProgress `json:"components,omitempty"`
+}
+
+// ComponentPhase is the deployment phase of an operand
+// +kubebuilder:validation:Enum=Deploying;Upgrading;Ready;Degraded
+type ComponentPhase string
+
+const (
+	// ComponentPhaseDeploying means that the operand is being deployed, and is not ready yet
+	ComponentPhaseDeploying ComponentPhase = "Deploying"
+
+	// ComponentPhaseUpgrading means that HCO is upgrading, and the operand is not upgraded and ready yet
+	ComponentPhaseUpgrading ComponentPhase = "Upgrading"
+
+	// ComponentPhaseReady means that the operand is ready; during an upgrade, also that it was upgraded
+	ComponentPhaseReady ComponentPhase = "Ready"
+
+	// ComponentPhaseDegraded means that the operand reports the Degraded condition
+	ComponentPhaseDegraded ComponentPhase = "Degraded"
+)
+
+// ComponentProgress is the deployment phase of an operand
+// +k8s:openapi-gen=true
+type ComponentProgress struct {
+	// Name is the kind of the operand CR; e.g. KubeVirt
+	Name string `json:"name"`
+
+	// Phase is the deployment phase of the operand
+	Phase ComponentPhase `json:"phase"`
+}
+
+// OperandObservedGeneration is the HyperConverged resource generation that was last successfully rendered into an operand
+// +k8s:openapi-gen=true
+type OperandObservedGeneration struct {
+	// Name is the kind of the operand CR; e.g. KubeVirt
+	Name string `json:"name"`
+
+	// ObservedGeneration is the HyperConverged resource generation that was last successfully rendered into the operand
+	ObservedGeneration int64 `json:"observedGeneration"`
+}
+
+// OperandCondition is a condition of an operand, as reported in the status of the operand CR
+// +k8s:openapi-gen=true
+type OperandCondition struct {
+	// Component is the kind of the operand CR; e.g. KubeVirt
+	Component string `json:"component"`
+
+	// Type is the type of the condition
+	Type string `json:"type"`
+
+	// Status is the status of the condition; one of True, False or Unknown
+	Status metav1.ConditionStatus `json:"status"`
+
+	// Reason is the reason of the last transition of the condition
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human-readable message with details about the last transition of the condition
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // LogVerbosityConfiguration configures log verbosity for different components
 // +k8s:openapi-gen=true
 type LogVerbosityConfiguration struct {
@@ -652,6 +1290,48 @@ type DataImportCronStatus struct {
 
 	// Modified indicates if a common template was customized. Always false for custom templates.
 	Modified bool `json:"modified,omitempty"`
+
+	// CommonTemplateSpec is the spec of the common template that the customization of a modified common template is
+	// based on. When a new version of HCO ships a modified version of the common template, HCO merges the
+	// customization with the new common template, instead of ignoring the changes in the common template. Only set
+	// for modified common templates.
+	// +optional
+	CommonTemplateSpec *cdiv1beta1.DataImportCronSpec `json:"commonTemplateSpec,omitempty"`
+
+	// Conflicts is the list of the customized fields of a modified common template, that were also modified in the
+	// new version of the common template. HCO keeps the customized values of these fields.
+	// +listType=atomic
+	// +optional
+	Conflicts []string `json:"conflicts,omitempty"`
+
+	// Import is a summary of the status of the DataImportCron that was created from this template. It is not set if
+	// the DataImportCron does not exist yet.
+	// +optional
+	Import *DataImportCronImportStatus `json:"import,omitempty"`
+}
+
+// DataImportCronImportStatus summarizes the status of a DataImportCron
+type DataImportCronImportStatus struct {
+	// LastImportTimestamp is the time of the last successful import
+	// +optional
+	LastImportTimestamp *metav1.Time `json:"lastImportTimestamp,omitempty"`
+
+	// UpToDate is the status of the UpToDate condition of the DataImportCron; True if the last import succeeded and
+	// the golden image is ready to use
+	// +optional
+	UpToDate metav1.ConditionStatus `json:"upToDate,omitempty"`
+
+	// Reason is the reason of the UpToDate condition of the DataImportCron
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is the message of the UpToDate condition of the DataImportCron
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastTransitionTime is the last time the UpToDate condition of the DataImportCron changed
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
 }
 
 // DataImportCronTemplate defines the template type for DataImportCrons.
@@ -695,6 +1375,31 @@ const (
 	// has been applied to the HyperConverged resource via a specialized annotation.
 	// This condition is exposed only when its value is True, and is otherwise hidden.
 	ConditionTaintedConfiguration = "TaintedConfiguration"
+
+	// ConditionGoldenImageImportFailing indicates that the DataImportCron of at least one golden image, managed by HCO,
+	// is not up to date for a long time, usually because the import keeps failing.
+	// This condition is exposed only when its value is True, and is otherwise hidden.
+	ConditionGoldenImageImportFailing = "GoldenImageImportFailing"
+
+	// ConditionScratchSpaceStorageClassNotFound indicates that the storage class set in spec.scratchSpaceStorageClass
+	// does not exist, so CDI can't allocate the scratch space required by imports and uploads.
+	// This condition is exposed only when its value is True, and is otherwise hidden.
+	ConditionScratchSpaceStorageClassNotFound = "ScratchSpaceStorageClassNotFound"
+
+	// ConditionMigrationNetworkNotFound indicates that the NetworkAttachmentDefinition set in
+	// spec.liveMigrationConfig.network does not exist in the HCO namespace.
+	// This condition is exposed only when its value is True, and is otherwise hidden.
+	ConditionMigrationNetworkNotFound = "MigrationNetworkNotFound"
+
+	// ConditionUpgradeTimedOut indicates that the upgrade of HCO did not complete within the upgrade timeout, because
+	// at least one operand did not reach its expected version.
+	// This condition is exposed only when its value is True, and is otherwise hidden.
+	ConditionUpgradeTimedOut = "UpgradeTimedOut"
+
+	// ConditionUnsupportedFeatureGates indicates that some of the enabled feature gates are not supported on the
+	// architectures of the cluster nodes, so HCO does not deploy their operands.
+	// This condition is exposed only when its value is True, and is otherwise hidden.
+	ConditionUnsupportedFeatureGates = "UnsupportedFeatureGates"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -26,13 +26,60 @@ package v1beta1
 
 import (
 	configv1 "github.com/openshift/api/config/v1"
-	apicorev1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
-	corev1 "kubevirt.io/api/core/v1"
+	apicorev1 "kubevirt.io/api/core/v1"
 	corev1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertOverride) DeepCopyInto(out *AlertOverride) {
+	*out = *in
+	if in.For != nil {
+		in, out := &in.For, &out.For
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertOverride.
+func (in *AlertOverride) DeepCopy() *AlertOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertRoutingConfig) DeepCopyInto(out *AlertRoutingConfig) {
+	*out = *in
+	if in.WebhookURLSecret != nil {
+		in, out := &in.WebhookURLSecret, &out.WebhookURLSecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SendResolved != nil {
+		in, out := &in.SendResolved, &out.SendResolved
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertRoutingConfig.
+func (in *AlertRoutingConfig) DeepCopy() *AlertRoutingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertRoutingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertRotateConfigCA) DeepCopyInto(out *CertRotateConfigCA) {
 	*out = *in
@@ -85,9 +132,141 @@ func (in *CertRotateConfigServer) DeepCopy() *CertRotateConfigServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTopology) DeepCopyInto(out *ClusterTopology) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTopology.
+func (in *ClusterTopology) DeepCopy() *ClusterTopology {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTopology)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommonInstancetypesConfig) DeepCopyInto(out *CommonInstancetypesConfig) {
+	*out = *in
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonInstancetypesConfig.
+func (in *CommonInstancetypesConfig) DeepCopy() *CommonInstancetypesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CommonInstancetypesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentHealth) DeepCopyInto(out *ComponentHealth) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentHealth.
+func (in *ComponentHealth) DeepCopy() *ComponentHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentProgress) DeepCopyInto(out *ComponentProgress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentProgress.
+func (in *ComponentProgress) DeepCopy() *ComponentProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationDriftReport) DeepCopyInto(out *ConfigurationDriftReport) {
+	*out = *in
+	in.ReportTime.DeepCopyInto(&out.ReportTime)
+	if in.Operands != nil {
+		in, out := &in.Operands, &out.Operands
+		*out = make([]OperandDrift, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationDriftReport.
+func (in *ConfigurationDriftReport) DeepCopy() *ConfigurationDriftReport {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationDriftReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataImportCronImportStatus) DeepCopyInto(out *DataImportCronImportStatus) {
+	*out = *in
+	if in.LastImportTimestamp != nil {
+		in, out := &in.LastImportTimestamp, &out.LastImportTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataImportCronImportStatus.
+func (in *DataImportCronImportStatus) DeepCopy() *DataImportCronImportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataImportCronImportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataImportCronStatus) DeepCopyInto(out *DataImportCronStatus) {
 	*out = *in
+	if in.CommonTemplateSpec != nil {
+		in, out := &in.CommonTemplateSpec, &out.CommonTemplateSpec
+		*out = new(corev1beta1.DataImportCronSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Import != nil {
+		in, out := &in.Import, &out.Import
+		*out = new(DataImportCronImportStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -127,7 +306,7 @@ func (in *DataImportCronTemplate) DeepCopy() *DataImportCronTemplate {
 func (in *DataImportCronTemplateStatus) DeepCopyInto(out *DataImportCronTemplateStatus) {
 	*out = *in
 	in.DataImportCronTemplate.DeepCopyInto(&out.DataImportCronTemplate)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -141,6 +320,70 @@ func (in *DataImportCronTemplateStatus) DeepCopy() *DataImportCronTemplateStatus
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentProgress) DeepCopyInto(out *DeploymentProgress) {
+	*out = *in
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ComponentProgress, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentProgress.
+func (in *DeploymentProgress) DeepCopy() *DeploymentProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForceResyncStatus) DeepCopyInto(out *ForceResyncStatus) {
+	*out = *in
+	in.ResyncTime.DeepCopyInto(&out.ResyncTime)
+	if in.Rewritten != nil {
+		in, out := &in.Rewritten, &out.Rewritten
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForceResyncStatus.
+func (in *ForceResyncStatus) DeepCopy() *ForceResyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ForceResyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HigherWorkloadDensityConfiguration) DeepCopyInto(out *HigherWorkloadDensityConfiguration) {
+	*out = *in
+	if in.MemoryOvercommitPercentage != nil {
+		in, out := &in.MemoryOvercommitPercentage, &out.MemoryOvercommitPercentage
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HigherWorkloadDensityConfiguration.
+func (in *HigherWorkloadDensityConfiguration) DeepCopy() *HigherWorkloadDensityConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(HigherWorkloadDensityConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HyperConverged) DeepCopyInto(out *HyperConverged) {
 	*out = *in
@@ -194,6 +437,11 @@ func (in *HyperConvergedConfig) DeepCopyInto(out *HyperConvergedConfig) {
 		in, out := &in.NodePlacement, &out.NodePlacement
 		*out = (*in).DeepCopy()
 	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(byte)
+		**out = **in
+	}
 	return
 }
 
@@ -268,6 +516,30 @@ func (in *HyperConvergedFeatureGates) DeepCopy() *HyperConvergedFeatureGates {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperConvergedInfraConfig) DeepCopyInto(out *HyperConvergedInfraConfig) {
+	*out = *in
+	in.HyperConvergedConfig.DeepCopyInto(&out.HyperConvergedConfig)
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperConvergedInfraConfig.
+func (in *HyperConvergedInfraConfig) DeepCopy() *HyperConvergedInfraConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperConvergedInfraConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HyperConvergedList) DeepCopyInto(out *HyperConvergedList) {
 	*out = *in
@@ -325,10 +597,27 @@ func (in *HyperConvergedObsoleteCPUs) DeepCopy() *HyperConvergedObsoleteCPUs {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 	*out = *in
+	if in.TuningPolicyRates != nil {
+		in, out := &in.TuningPolicyRates, &out.TuningPolicyRates
+		*out = new(TuningPolicyRates)
+		**out = **in
+	}
+	if in.HigherWorkloadDensity != nil {
+		in, out := &in.HigherWorkloadDensity, &out.HigherWorkloadDensity
+		*out = new(HigherWorkloadDensityConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Infra.DeepCopyInto(&out.Infra)
 	in.Workloads.DeepCopyInto(&out.Workloads)
 	in.FeatureGates.DeepCopyInto(&out.FeatureGates)
 	in.LiveMigrationConfig.DeepCopyInto(&out.LiveMigrationConfig)
+	if in.MigrationPolicies != nil {
+		in, out := &in.MigrationPolicies, &out.MigrationPolicies
+		*out = make([]MigrationPolicyTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.PermittedHostDevices != nil {
 		in, out := &in.PermittedHostDevices, &out.PermittedHostDevices
 		*out = new(PermittedHostDevices)
@@ -365,6 +654,11 @@ func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.VMISeccompProfile != nil {
+		in, out := &in.VMISeccompProfile, &out.VMISeccompProfile
+		*out = new(corev1.SeccompProfile)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ObsoleteCPUs != nil {
 		in, out := &in.ObsoleteCPUs, &out.ObsoleteCPUs
 		*out = new(HyperConvergedObsoleteCPUs)
@@ -375,6 +669,11 @@ func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CommonInstancetypes != nil {
+		in, out := &in.CommonInstancetypes, &out.CommonInstancetypes
+		*out = new(CommonInstancetypesConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.StorageImport != nil {
 		in, out := &in.StorageImport, &out.StorageImport
 		*out = new(StorageImportConfig)
@@ -393,6 +692,16 @@ func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 		*out = new(corev1beta1.FilesystemOverhead)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DataVolumeTTLSeconds != nil {
+		in, out := &in.DataVolumeTTLSeconds, &out.DataVolumeTTLSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.UploadProxyURLOverride != nil {
+		in, out := &in.UploadProxyURLOverride, &out.UploadProxyURLOverride
+		*out = new(string)
+		**out = **in
+	}
 	if in.LogVerbosityConfig != nil {
 		in, out := &in.LogVerbosityConfig, &out.LogVerbosityConfig
 		*out = new(LogVerbosityConfiguration)
@@ -420,7 +729,7 @@ func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 	}
 	if in.EvictionStrategy != nil {
 		in, out := &in.EvictionStrategy, &out.EvictionStrategy
-		*out = new(corev1.EvictionStrategy)
+		*out = new(apicorev1.EvictionStrategy)
 		**out = **in
 	}
 	if in.VMStateStorageClass != nil {
@@ -438,6 +747,38 @@ func (in *HyperConvergedSpec) DeepCopyInto(out *HyperConvergedSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CommonBootImageRegistryOverride != nil {
+		in, out := &in.CommonBootImageRegistryOverride, &out.CommonBootImageRegistryOverride
+		*out = new(string)
+		**out = **in
+	}
+	if in.TrustedCABundle != nil {
+		in, out := &in.TrustedCABundle, &out.TrustedCABundle
+		*out = new(string)
+		**out = **in
+	}
+	if in.TenantQuotaTemplates != nil {
+		in, out := &in.TenantQuotaTemplates, &out.TenantQuotaTemplates
+		*out = make([]TenantQuotaTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(MonitoringConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(ResourcesMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -463,7 +804,7 @@ func (in *HyperConvergedStatus) DeepCopyInto(out *HyperConvergedStatus) {
 	}
 	if in.RelatedObjects != nil {
 		in, out := &in.RelatedObjects, &out.RelatedObjects
-		*out = make([]apicorev1.ObjectReference, len(*in))
+		*out = make([]corev1.ObjectReference, len(*in))
 		copy(*out, *in)
 	}
 	if in.Versions != nil {
@@ -471,6 +812,21 @@ func (in *HyperConvergedStatus) DeepCopyInto(out *HyperConvergedStatus) {
 		*out = make([]Version, len(*in))
 		copy(*out, *in)
 	}
+	if in.OperandVersions != nil {
+		in, out := &in.OperandVersions, &out.OperandVersions
+		*out = make([]Version, len(*in))
+		copy(*out, *in)
+	}
+	if in.OperandConditions != nil {
+		in, out := &in.OperandConditions, &out.OperandConditions
+		*out = make([]OperandCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.OperandObservedGenerations != nil {
+		in, out := &in.OperandObservedGenerations, &out.OperandObservedGenerations
+		*out = make([]OperandObservedGeneration, len(*in))
+		copy(*out, *in)
+	}
 	if in.DataImportCronTemplates != nil {
 		in, out := &in.DataImportCronTemplates, &out.DataImportCronTemplates
 		*out = make([]DataImportCronTemplateStatus, len(*in))
@@ -478,6 +834,43 @@ func (in *HyperConvergedStatus) DeepCopyInto(out *HyperConvergedStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ComponentsHealth != nil {
+		in, out := &in.ComponentsHealth, &out.ComponentsHealth
+		*out = make([]ComponentHealth, len(*in))
+		copy(*out, *in)
+	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(DeploymentProgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterTopology != nil {
+		in, out := &in.ClusterTopology, &out.ClusterTopology
+		*out = new(ClusterTopology)
+		**out = **in
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindowStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigurationDrift != nil {
+		in, out := &in.ConfigurationDrift, &out.ConfigurationDrift
+		*out = new(ConfigurationDriftReport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForceResync != nil {
+		in, out := &in.ForceResync, &out.ForceResync
+		*out = new(ForceResyncStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SpecMigrations != nil {
+		in, out := &in.SpecMigrations, &out.SpecMigrations
+		*out = make([]SpecMigrationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -583,7 +976,7 @@ func (in *LogVerbosityConfiguration) DeepCopyInto(out *LogVerbosityConfiguration
 	*out = *in
 	if in.Kubevirt != nil {
 		in, out := &in.Kubevirt, &out.Kubevirt
-		*out = new(corev1.LogVerbosity)
+		*out = new(apicorev1.LogVerbosity)
 		(*in).DeepCopyInto(*out)
 	}
 	return
@@ -599,6 +992,53 @@ func (in *LogVerbosityConfiguration) DeepCopy() *LogVerbosityConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]MaintenanceWindowDay, len(*in))
+		copy(*out, *in)
+	}
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowStatus) DeepCopyInto(out *MaintenanceWindowStatus) {
+	*out = *in
+	if in.NextTransition != nil {
+		in, out := &in.NextTransition, &out.NextTransition
+		*out = (*in).DeepCopy()
+	}
+	if in.DeferredChanges != nil {
+		in, out := &in.DeferredChanges, &out.DeferredChanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowStatus.
+func (in *MaintenanceWindowStatus) DeepCopy() *MaintenanceWindowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MediatedDevicesConfiguration) DeepCopyInto(out *MediatedDevicesConfiguration) {
 	*out = *in
@@ -648,6 +1088,82 @@ func (in *MediatedHostDevice) DeepCopy() *MediatedHostDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricRelabelConfig) DeepCopyInto(out *MetricRelabelConfig) {
+	*out = *in
+	if in.SourceLabels != nil {
+		in, out := &in.SourceLabels, &out.SourceLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricRelabelConfig.
+func (in *MetricRelabelConfig) DeepCopy() *MetricRelabelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricRelabelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationPolicyTemplate) DeepCopyInto(out *MigrationPolicyTemplate) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationPolicyTemplate.
+func (in *MigrationPolicyTemplate) DeepCopy() *MigrationPolicyTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationPolicyTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringConfig) DeepCopyInto(out *MonitoringConfig) {
+	*out = *in
+	if in.AlertOverrides != nil {
+		in, out := &in.AlertOverrides, &out.AlertOverrides
+		*out = make([]AlertOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DisabledAlerts != nil {
+		in, out := &in.DisabledAlerts, &out.DisabledAlerts
+		*out = make([]HCOAlertName, len(*in))
+		copy(*out, *in)
+	}
+	if in.AlertRouting != nil {
+		in, out := &in.AlertRouting, &out.AlertRouting
+		*out = new(AlertRoutingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceMonitor != nil {
+		in, out := &in.ServiceMonitor, &out.ServiceMonitor
+		*out = new(ServiceMonitorConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringConfig.
+func (in *MonitoringConfig) DeepCopy() *MonitoringConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeMediatedDeviceTypesConfig) DeepCopyInto(out *NodeMediatedDeviceTypesConfig) {
 	*out = *in
@@ -681,12 +1197,65 @@ func (in *NodeMediatedDeviceTypesConfig) DeepCopy() *NodeMediatedDeviceTypesConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperandCondition) DeepCopyInto(out *OperandCondition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperandCondition.
+func (in *OperandCondition) DeepCopy() *OperandCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(OperandCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperandDrift) DeepCopyInto(out *OperandDrift) {
+	*out = *in
+	if in.DiffPaths != nil {
+		in, out := &in.DiffPaths, &out.DiffPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperandDrift.
+func (in *OperandDrift) DeepCopy() *OperandDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(OperandDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperandObservedGeneration) DeepCopyInto(out *OperandObservedGeneration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperandObservedGeneration.
+func (in *OperandObservedGeneration) DeepCopy() *OperandObservedGeneration {
+	if in == nil {
+		return nil
+	}
+	out := new(OperandObservedGeneration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperandResourceRequirements) DeepCopyInto(out *OperandResourceRequirements) {
 	*out = *in
 	if in.StorageWorkloads != nil {
 		in, out := &in.StorageWorkloads, &out.StorageWorkloads
-		*out = new(apicorev1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.VmiCPUAllocationRatio != nil {
@@ -754,6 +1323,81 @@ func (in *PermittedHostDevices) DeepCopy() *PermittedHostDevices {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcesMetadata) DeepCopyInto(out *ResourcesMetadata) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcesMetadata.
+func (in *ResourcesMetadata) DeepCopy() *ResourcesMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcesMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceMonitorConfig) DeepCopyInto(out *ServiceMonitorConfig) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MetricRelabelings != nil {
+		in, out := &in.MetricRelabelings, &out.MetricRelabelings
+		*out = make([]MetricRelabelConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceMonitorConfig.
+func (in *ServiceMonitorConfig) DeepCopy() *ServiceMonitorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceMonitorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpecMigrationStatus) DeepCopyInto(out *SpecMigrationStatus) {
+	*out = *in
+	in.MigrationTime.DeepCopyInto(&out.MigrationTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpecMigrationStatus.
+func (in *SpecMigrationStatus) DeepCopy() *SpecMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SpecMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageImportConfig) DeepCopyInto(out *StorageImportConfig) {
 	*out = *in
@@ -762,6 +1406,11 @@ func (in *StorageImportConfig) DeepCopyInto(out *StorageImportConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ImportProxy != nil {
+		in, out := &in.ImportProxy, &out.ImportProxy
+		*out = new(corev1beta1.ImportProxy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -775,6 +1424,50 @@ func (in *StorageImportConfig) DeepCopy() *StorageImportConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantQuotaTemplate) DeepCopyInto(out *TenantQuotaTemplate) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalMigrationResources != nil {
+		in, out := &in.AdditionalMigrationResources, &out.AdditionalMigrationResources
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantQuotaTemplate.
+func (in *TenantQuotaTemplate) DeepCopy() *TenantQuotaTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantQuotaTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TuningPolicyRates) DeepCopyInto(out *TuningPolicyRates) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TuningPolicyRates.
+func (in *TuningPolicyRates) DeepCopy() *TuningPolicyRates {
+	if in == nil {
+		return nil
+	}
+	out := new(TuningPolicyRates)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Version) DeepCopyInto(out *Version) {
 	*out = *in
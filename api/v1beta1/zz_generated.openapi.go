@@ -33,8 +33,17 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertOverride":                        schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_AlertOverride(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertRoutingConfig":                   schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_AlertRoutingConfig(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigCA":                   schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_CertRotateConfigCA(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigServer":               schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_CertRotateConfigServer(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ClusterTopology":                      schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ClusterTopology(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ComponentHealth":                      schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ComponentHealth(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ComponentProgress":                    schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ComponentProgress(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ConfigurationDriftReport":             schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ConfigurationDriftReport(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DeploymentProgress":                   schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_DeploymentProgress(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ForceResyncStatus":                    schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ForceResyncStatus(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HigherWorkloadDensityConfiguration":   schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HigherWorkloadDensityConfiguration(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConverged":                       schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConverged(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedCertConfig":             schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedCertConfig(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedFeatureGates":           schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedFeatureGates(ref),
@@ -44,13 +53,98 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedWorkloadUpdateStrategy": schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedWorkloadUpdateStrategy(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LiveMigrationConfigurations":          schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_LiveMigrationConfigurations(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LogVerbosityConfiguration":            schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_LogVerbosityConfiguration(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MaintenanceWindow":                    schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MaintenanceWindow(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MaintenanceWindowStatus":              schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MaintenanceWindowStatus(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MediatedDevicesConfiguration":         schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MediatedDevicesConfiguration(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MediatedHostDevice":                   schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MediatedHostDevice(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MetricRelabelConfig":                  schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MetricRelabelConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MigrationPolicyTemplate":              schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MigrationPolicyTemplate(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MonitoringConfig":                     schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MonitoringConfig(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.NodeMediatedDeviceTypesConfig":        schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_NodeMediatedDeviceTypesConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandCondition":                     schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_OperandCondition(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandDrift":                         schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_OperandDrift(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandObservedGeneration":            schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_OperandObservedGeneration(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandResourceRequirements":          schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_OperandResourceRequirements(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.PciHostDevice":                        schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_PciHostDevice(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.PermittedHostDevices":                 schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_PermittedHostDevices(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ResourcesMetadata":                    schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ResourcesMetadata(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ServiceMonitorConfig":                 schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ServiceMonitorConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.SpecMigrationStatus":                  schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_SpecMigrationStatus(ref),
 		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.StorageImportConfig":                  schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_StorageImportConfig(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.TenantQuotaTemplate":                  schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_TenantQuotaTemplate(ref),
+		"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.TuningPolicyRates":                    schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_TuningPolicyRates(ref),
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_AlertOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertOverride overrides the configuration of a single HCO alert.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"alertName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AlertName is the name of the HCO alert to override.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"severity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Severity overrides the severity label of the alert.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"for": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For overrides the time that the alert condition must be true, before the alert fires. A zero duration fires the alert as soon as the condition is true.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"alertName"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_AlertRoutingConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertRoutingConfig defines the Alertmanager webhook receiver of the HCO alerts.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"webhookURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WebhookURL is the URL of the webhook receiver.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"webhookURLSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WebhookURLSecret selects a key of a Secret in the HCO namespace, that holds the URL of the webhook receiver.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"sendResolved": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SendResolved controls whether to notify the webhook receiver about resolved alerts.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -112,189 +206,266 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_CertRotateConfi
 	}
 }
 
-func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConverged(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ClusterTopology(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HyperConverged is the Schema for the hyperconvergeds API",
+				Description: "ClusterTopology is the topology of the cluster, as detected by HCO",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"controlPlane": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "ControlPlane is the topology of the control plane nodes. External means that the control plane is hosted outside the cluster, e.g. on a HyperShift hosted control plane, so there are no control plane nodes in the cluster.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"infrastructure": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Infrastructure is the topology of the infrastructure (worker) nodes",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
+					"consoleAvailable": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedSpec"),
+							Description: "ConsoleAvailable tells whether the cluster console is installed. HCO deploys the console integrations, like the console plugin, the quick start guides and the CLI downloads, only if it is.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"managedService": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedStatus"),
+							Description: "ManagedService is the managed OpenShift offering that the cluster is running on, if any; e.g. ROSA, OSD or ARO. HCO applies the guardrails of the managed service to the resources it deploys.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"controlPlane", "infrastructure", "consoleAvailable"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedSpec", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedCertConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ComponentHealth(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HyperConvergedCertConfig holds the CertConfig entries for the HCO operands",
+				Description: "ComponentHealth is the health of an operand, based on the conditions of the operand CR",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ca": {
+					"component": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CA configuration - CA certs are kept in the CA bundle as long as they are valid",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigCA"),
+							Description: "Component is the kind of the operand CR; e.g. KubeVirt",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"server": {
+					"health": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Server configuration - Certs are rotated and discarded",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigServer"),
+							Description: "Health is the health of the operand: \"error\" if the operand is not available or is degraded, \"warning\" if it is progressing or did not report its conditions yet, or \"healthy\" otherwise",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is the reason of the condition of the operand CR that determined its health, if it is not healthy",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is the message of the condition of the operand CR that determined its health, if it is not healthy",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"component", "health"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigCA", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigServer"},
 	}
 }
 
-func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedFeatureGates(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ComponentProgress(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HyperConvergedFeatureGates is a set of optional feature gates to enable or disable new features that are not enabled by default yet.",
+				Description: "ComponentProgress is the deployment phase of an operand",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"withHostPassthroughCPU": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Allow migrating a virtual machine with CPU host-passthrough mode. This should be enabled only when the Cluster is homogeneous from CPU HW perspective doc here",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "Name is the kind of the operand CR; e.g. KubeVirt",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"enableCommonBootImageImport": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Opt-in to automatic delivery/updates of the common data import cron templates. There are two sources for the data import cron templates: hard coded list of common templates, and custom templates that can be added to the dataImportCronTemplates field. This feature gates only control the common templates. It is possible to use custom templates by adding them to the dataImportCronTemplates field.",
-							Default:     true,
-							Type:        []string{"boolean"},
+							Description: "Phase is the deployment phase of the operand",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"deployTektonTaskResources": {
+				},
+				Required: []string{"name", "phase"},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ConfigurationDriftReport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConfigurationDriftReport reports, per operand, if the live object matches the object that HCO would render from the HyperConverged CR.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"trigger": {
 						SchemaProps: spec.SchemaProps{
-							Description: "deploy resources (kubevirt tekton tasks and example pipelines) in SSP operator",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "Trigger is the value of the hco.kubevirt.io/configurationDriftReport annotation that triggered this report.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"deployVmConsoleProxy": {
+					"reportTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "deploy VM console proxy resources in SSP operator",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "ReportTime is the time when the report was generated.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"deployKubeSecondaryDNS": {
+					"operands": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Deploy KubeSecondaryDNS by CNAO",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Operands is the list of the checked operands.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandDrift"),
+									},
+								},
+							},
 						},
 					},
-					"nonRoot": {
+				},
+				Required: []string{"trigger", "reportTime"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandDrift", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_DeploymentProgress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeploymentProgress is the progress of the deployment, or of the upgrade, of the operands",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"readyComponents": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enables rootless virt-launcher.\n\nDeprecated: please use the root FG.",
-							Default:     true,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "ReadyComponents is the number of the operands that are ready",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"disableMDevConfiguration": {
+					"totalComponents": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Disable mediated devices handling on KubeVirt",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "TotalComponents is the number of the operands that are deployed by HCO",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"persistentReservation": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enable persistent reservation of a LUN through the SCSI Persistent Reserve commands on Kubevirt. In order to issue privileged SCSI ioctls, the VM requires activation of the persistent reservation flag. Once this feature gate is enabled, then the additional container with the qemu-pr-helper is deployed inside the virt-handler pod. Enabling (or removing) the feature gate causes the redeployment of the virt-handler pod.",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "Message is a human-readable summary of the progress; e.g. \"4/5 components ready\"",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"enableManagedTenantQuota": {
+					"components": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Enable the Managed Tenant Quota operator (MTQ) on the cluster. MTQ streamlines the VirtualMachines migration process in namespaces where resource quotas are applied. Note: this feature is in Developer Preview.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Components is the phase of each operand",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ComponentProgress"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"readyComponents", "totalComponents"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ComponentProgress"},
 	}
 }
 
-func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedObsoleteCPUs(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ForceResyncStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HyperConvergedObsoleteCPUs allows avoiding scheduling of VMs for obsolete CPU models",
+				Description: "ForceResyncStatus reports the last resync of the operands, that was forced by the hco.kubevirt.io/forceResync annotation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"minCPUModel": {
+					"trigger": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MinCPUModel is the Minimum CPU model that is used for basic CPU features; e.g. Penryn or Haswell. The default value for this field is nil, but in KubeVirt, the default value is \"Penryn\", if nothing else is set. Use this field to override KubeVirt default value.",
+							Description: "Trigger is the value of the hco.kubevirt.io/forceResync annotation that triggered the resync.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"cpuModels": {
+					"resyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResyncTime is the time when the resync was completed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"rewritten": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "set",
+								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "CPUModels is a list of obsolete CPU models. When the node-labeller obtains the list of obsolete CPU models, it eliminates those CPU models and creates labels for valid CPU models. The default values for this field is nil, however, HCO uses opinionated values, and adding values to this list will add them to the opinionated values.",
+							Description: "Rewritten is the list of the objects that were created or updated by the resync, in the \"Kind name\" format.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -308,37 +479,280 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedO
 						},
 					},
 				},
+				Required: []string{"trigger", "resyncTime"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HigherWorkloadDensityConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HyperConvergedSpec defines the desired state of HyperConverged",
+				Description: "HigherWorkloadDensityConfiguration holds the configuration of features that allow running more virtual machines on each node.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"localStorageClassName": {
+					"memoryOvercommitPercentage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Deprecated: LocalStorageClassName the name of the local storage class.",
+							Description: "MemoryOvercommitPercentage is the percentage of memory that the virtual machines get, compared to the memory requested by their virt-launcher pods. For example, 150 means that the pod of a virtual machine with 1.5Gi of memory only requests 1Gi. This field takes precedence over the memory overcommit of the workloadDensityPreset. If it is not set, the memory overcommit of the workloadDensityPreset, or the KubeVirt default, is used.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConverged(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConverged is the Schema for the hyperconvergeds API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tuningPolicy": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TuningPolicy allows to configure the mode in which the RateLimits of kubevirt are set. If TuningPolicy is not present the default kubevirt values are used. It can be set to `annotation` for fine-tuning the kubevirt queryPerSeconds (qps) and burst values. Qps and burst values are taken from the annotation hco.kubevirt.io/tuningPolicy",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedSpec", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedCertConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedCertConfig holds the CertConfig entries for the HCO operands",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ca": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CA configuration - CA certs are kept in the CA bundle as long as they are valid",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigCA"),
+						},
+					},
+					"server": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Server configuration - Certs are rotated and discarded",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigServer"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigCA", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CertRotateConfigServer"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedFeatureGates(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedFeatureGates is a set of optional feature gates to enable or disable new features that are not enabled by default yet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"withHostPassthroughCPU": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allow migrating a virtual machine with CPU host-passthrough mode. This should be enabled only when the Cluster is homogeneous from CPU HW perspective doc here",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"enableCommonBootImageImport": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Opt-in to automatic delivery/updates of the common data import cron templates. There are two sources for the data import cron templates: hard coded list of common templates, and custom templates that can be added to the dataImportCronTemplates field. This feature gates only control the common templates. It is possible to use custom templates by adding them to the dataImportCronTemplates field.",
+							Default:     true,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"deployTektonTaskResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "deploy resources (kubevirt tekton tasks and example pipelines) in SSP operator",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"deployVmConsoleProxy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "deploy VM console proxy resources in SSP operator",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"deployKubeSecondaryDNS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deploy KubeSecondaryDNS by CNAO",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"nonRoot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enables rootless virt-launcher.\n\nDeprecated: please use the root FG.",
+							Default:     true,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"disableMDevConfiguration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disable mediated devices handling on KubeVirt",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"persistentReservation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enable persistent reservation of a LUN through the SCSI Persistent Reserve commands on Kubevirt. In order to issue privileged SCSI ioctls, the VM requires activation of the persistent reservation flag. Once this feature gate is enabled, then the additional container with the qemu-pr-helper is deployed inside the virt-handler pod. Enabling (or removing) the feature gate causes the redeployment of the virt-handler pod.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"enableManagedTenantQuota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enable the Managed Tenant Quota operator (MTQ) on the cluster. MTQ streamlines the VirtualMachines migration process in namespaces where resource quotas are applied. Note: this feature is in Developer Preview.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedObsoleteCPUs(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedObsoleteCPUs allows avoiding scheduling of VMs for obsolete CPU models",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minCPUModel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinCPUModel is the Minimum CPU model that is used for basic CPU features; e.g. Penryn or Haswell. The default value for this field is nil, but in KubeVirt, the default value is \"Penryn\", if nothing else is set. Use this field to override KubeVirt default value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cpuModels": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUModels is a list of obsolete CPU models. When the node-labeller obtains the list of obsolete CPU models, it eliminates those CPU models and creates labels for valid CPU models. The default values for this field is nil, however, HCO uses opinionated values, and adding values to this list will add them to the opinionated values.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HyperConvergedSpec defines the desired state of HyperConverged",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"localStorageClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deprecated: LocalStorageClassName the name of the local storage class.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tuningPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TuningPolicy allows to configure the mode in which the RateLimits of kubevirt are set. If TuningPolicy is not present the default kubevirt values are used. It can be set to `annotation` for fine-tuning the kubevirt queryPerSeconds (qps) and burst values. Qps and burst values are taken from the annotation hco.kubevirt.io/tuningPolicy It can be set to `custom` to take the qps and burst values from the tuningPolicyRates field.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tuningPolicyRates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TuningPolicyRates holds the queryPerSeconds (qps) and burst values of the kubevirt rate limiters. It is only used, and is required, when the tuningPolicy is `custom`.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.TuningPolicyRates"),
+						},
+					},
+					"workloadDensityPreset": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkloadDensityPreset selects a named set of values for the CPU allocation ratio, the memory overcommit, KSM and the default eviction strategy of the virtual machines. - `dense` packs as many VMs as possible on each node: high CPU and memory overcommit, and KSM enabled. - `balanced` uses the KubeVirt default CPU allocation ratio, without memory overcommit. - `performance` allocates a full physical CPU for each virtual CPU, without memory overcommit. Explicitly set fields, like resourceRequirements.vmiCPUAllocationRatio or evictionStrategy, take precedence over the preset values. If WorkloadDensityPreset is not present, the default KubeVirt values are used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"higherWorkloadDensity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HigherWorkloadDensity holds the configuration of features that allow running more virtual machines on each node.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HigherWorkloadDensityConfiguration"),
+						},
+					},
 					"infra": {
 						SchemaProps: spec.SchemaProps{
-							Description: "infra HyperConvergedConfig influences the pod configuration (currently only placement) for all the infra components needed on the virtualization enabled cluster but not necessarily directly on each node running VMs/VMIs.",
+							Description: "infra HyperConvergedInfraConfig influences the pod configuration (currently only placement) for all the infra components needed on the virtualization enabled cluster but not necessarily directly on each node running VMs/VMIs.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedConfig"),
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedInfraConfig"),
 						},
 					},
 					"workloads": {
@@ -362,6 +776,28 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LiveMigrationConfigurations"),
 						},
 					},
+					"migrationPolicies": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MigrationPolicies is a list of KubeVirt MigrationPolicies, that override the live migration configuration for the VMs they select. HCO creates a MigrationPolicy for each item of the list and keeps it aligned with the item, and removes the MigrationPolicies of items that were removed from the list.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MigrationPolicyTemplate"),
+									},
+								},
+							},
+						},
+					},
 					"permittedHostDevices": {
 						SchemaProps: spec.SchemaProps{
 							Description: "PermittedHostDevices holds information about devices allowed for passthrough",
@@ -403,7 +839,7 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 					},
 					"defaultCPUModel": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DefaultCPUModel defines a cluster default for CPU model: default CPU model is set when VMI doesn't have any CPU model. When VMI has CPU model set, then VMI's CPU model is preferred. When default CPU model is not set and VMI's CPU model is not set too, host-model will be set. Default CPU model can be changed when kubevirt is running.",
+							Description: "DefaultCPUModel defines a cluster default for CPU model: default CPU model is set when VMI doesn't have any CPU model. When VMI has CPU model set, then VMI's CPU model is preferred. When default CPU model is not set and VMI's CPU model is not set too, host-model will be set. Default CPU model can be changed when kubevirt is running. The default CPU model must not be an obsolete CPU model.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -415,6 +851,12 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 							Format:      "",
 						},
 					},
+					"vmiSeccompProfile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VMISeccompProfile defines the seccomp profile of the virt-launcher pods. Use `RuntimeDefault` to enforce the default profile of the container runtime, or `Localhost`, with the localhostProfile field, to use a profile that is installed on the nodes. `Unconfined` does not set any seccomp profile. If not set, the KubeVirt seccomp profile (kubevirt/kubevirt.json) is used.",
+							Ref:         ref("k8s.io/api/core/v1.SeccompProfile"),
+						},
+					},
 					"obsoleteCPUs": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ObsoleteCPUs allows avoiding scheduling of VMs for obsolete CPU models",
@@ -423,11 +865,17 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 					},
 					"commonTemplatesNamespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CommonTemplatesNamespace defines namespace in which common templates will be deployed. It overrides the default openshift namespace.",
+							Description: "CommonTemplatesNamespace defines namespace in which common templates will be deployed. It overrides the default openshift namespace. HCO creates the namespace if it does not exist.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"commonInstancetypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CommonInstancetypes configures the deployment of the common cluster-wide instance types and preferences by the SSP operator.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CommonInstancetypesConfig"),
+						},
+					},
 					"storageImport": {
 						SchemaProps: spec.SchemaProps{
 							Description: "StorageImport contains configuration for importing containerized data",
@@ -466,6 +914,20 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 							Ref:         ref("kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.FilesystemOverhead"),
 						},
 					},
+					"dataVolumeTTLSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataVolumeTTLSeconds is the time in seconds after a DataVolume is completed, that it can be garbage collected. Set it to -1 to disable the garbage collection. If not set, the CDI default is used.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"uploadProxyURLOverride": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UploadProxyURLOverride overrides the URL of the CDI upload proxy, that clients like virtctl image-upload use to upload images to DataVolumes. Set it if the upload proxy is exposed by a custom route or ingress, for example on a custom ingress domain. If the URL has no scheme, https is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"uninstallStrategy": {
 						SchemaProps: spec.SchemaProps{
 							Description: "UninstallStrategy defines how to proceed on uninstall when workloads (VirtualMachines, DataVolumes) still exist. BlockUninstallIfWorkloadsExist will prevent the CR from being removed when workloads still exist. BlockUninstallIfWorkloadsExist is the safest choice to protect your workloads from accidental data loss, so it's strongly advised. RemoveWorkloads will cause all the workloads to be cascading deleted on uninstallation. WARNING: please notice that RemoveWorkloads will cause your workloads to be deleted as soon as this CR will be, even accidentally, deleted. Please correctly consider the implications of this option before setting it. BlockUninstallIfWorkloadsExist is the default behaviour.",
@@ -534,17 +996,71 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportCronTemplate", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedCertConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedFeatureGates", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedObsoleteCPUs", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedWorkloadUpdateStrategy", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LiveMigrationConfigurations", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LogVerbosityConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MediatedDevicesConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandResourceRequirements", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.PermittedHostDevices", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.StorageImportConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VirtualMachineOptions", "github.com/openshift/api/config/v1.TLSSecurityProfile", "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.FilesystemOverhead"},
-	}
-}
-
-func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
+					"commonBootImageRegistryOverride": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CommonBootImageRegistryOverride replaces the registry of the common boot images, in order to import them from a mirror registry, e.g. in disconnected environments. The value is the registry host, optionally with a port and a path prefix; e.g. \"mirror.example.com:5000/containerdisks\".\n\nIf set, HCO replaces the registry in the source URL of each common DataImportCronTemplate, but keeps the image repository and tag. Modified common templates and custom templates are not affected. This field is not set by default.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"trustedCABundle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TrustedCABundle is the name of a ConfigMap in the HyperConverged namespace, that contains additional trusted CA certificates in PEM format; e.g. the CA of a mirror registry. HCO copies the ConfigMap to the namespaces of the DataImportCronTemplates, and sets it as the certConfigMap of the registry sources that do not set their own certConfigMap, so the boot images can be imported from registries that are signed by this CA.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tenantQuotaTemplates": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "TenantQuotaTemplates is a list of default virtualization quotas. When the EnableManagedTenantQuota feature gate is enabled, HCO creates a VirtualMachineMigrationResourceQuota in each one of the listed namespaces, and keeps it aligned with the template.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.TenantQuotaTemplate"),
+									},
+								},
+							},
+						},
+					},
+					"maintenanceWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindow defines a recurring time window for the automatic workload updates. When set, HCO defers the automatic workload updates to the maintenance window, while all the other changes are still applied immediately. The certificate rotations are not deferred. If not set, all the changes are applied immediately.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MaintenanceWindow"),
+						},
+					},
+					"monitoring": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Monitoring holds the configuration of the HCO alerts.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MonitoringConfig"),
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metadata holds custom labels and annotations, that HCO adds to all the resources it creates; e.g. the operand CRs, the monitoring resources, the quick starts and the services. The labels and annotations that HCO sets itself take precedence over these values.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ResourcesMetadata"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.CommonInstancetypesConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportCronTemplate", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HigherWorkloadDensityConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedCertConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedFeatureGates", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedInfraConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedObsoleteCPUs", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.HyperConvergedWorkloadUpdateStrategy", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LiveMigrationConfigurations", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.LogVerbosityConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MaintenanceWindow", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MediatedDevicesConfiguration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MigrationPolicyTemplate", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MonitoringConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandResourceRequirements", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.PermittedHostDevices", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ResourcesMetadata", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.StorageImportConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.TenantQuotaTemplate", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.TuningPolicyRates", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.VirtualMachineOptions", "github.com/openshift/api/config/v1.TLSSecurityProfile", "k8s.io/api/core/v1.SeccompProfile", "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.FilesystemOverhead"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Description: "HyperConvergedStatus defines the observed state of HyperConverged",
 				Type:        []string{"object"},
@@ -608,6 +1124,70 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 							},
 						},
 					},
+					"operandVersions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "OperandVersions is a list of the versions of the operands that are deployed by HCO, as name/version pairs. The name is the kind of the operand CR, and the version is the version that the operand reports in the status of its CR. Once an upgrade is completed, the versions of all the operands are the versions that are shipped with the HCO version.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.Version"),
+									},
+								},
+							},
+						},
+					},
+					"operandConditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"component",
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "OperandConditions is a list of the conditions of the operands that are deployed by HCO, as reported in the status of their CRs. The conditions of the HyperConverged resource are aggregated from these conditions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandCondition"),
+									},
+								},
+							},
+						},
+					},
+					"operandObservedGenerations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "OperandObservedGenerations is a list of the HyperConverged resource generations that were last successfully rendered into each operand. If the observedGeneration of an operand is less than the resource generation in metadata, the last change of the HyperConverged resource was not applied to this operand yet.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandObservedGeneration"),
+									},
+								},
+							},
+						},
+					},
 					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ObservedGeneration reflects the HyperConverged resource generation. If the ObservedGeneration is less than the resource generation in metadata, the status is out of date",
@@ -643,11 +1223,85 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_HyperConvergedS
 							Format:      "",
 						},
 					},
+					"componentsHealth": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"component",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentsHealth details the health of each operand that is deployed by HCO, based on the conditions of the operand CR. The systemHealthStatus field aggregates the health of all the operands.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ComponentHealth"),
+									},
+								},
+							},
+						},
+					},
+					"progress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Progress reports the progress of the deployment, or of the upgrade, of the operands.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DeploymentProgress"),
+						},
+					},
+					"clusterTopology": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterTopology is the topology of the cluster, as detected by HCO; e.g. whether the control plane is hosted outside the cluster.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ClusterTopology"),
+						},
+					},
+					"maintenanceWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindow reports the state of the maintenance window, if configured.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MaintenanceWindowStatus"),
+						},
+					},
+					"configurationDrift": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigurationDrift is the configuration drift report, that was requested by the hco.kubevirt.io/configurationDriftReport annotation.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ConfigurationDriftReport"),
+						},
+					},
+					"forceResync": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ForceResync reports the last resync of the operands, that was forced by the hco.kubevirt.io/forceResync annotation.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ForceResyncStatus"),
+						},
+					},
+					"specMigrations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "SpecMigrations is the list of the migrations of deprecated fields to their replacements, that were done by HCO during the upgrades. Only the last run of each migration is kept.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.SpecMigrationStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportCronTemplateStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.Version", "k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ClusterTopology", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ComponentHealth", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ConfigurationDriftReport", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DataImportCronTemplateStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.DeploymentProgress", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ForceResyncStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MaintenanceWindowStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandCondition", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.OperandObservedGeneration", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.SpecMigrationStatus", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.Version", "k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
 	}
 }
 
@@ -798,6 +1452,114 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_LogVerbosityCon
 	}
 }
 
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceWindow defines a weekly recurring time window",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"days": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Days is the list of the days of the week when the maintenance window opens. If not set, the maintenance window opens every day.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is the time of the day when the maintenance window opens, in the 24-hour \"HH:MM\" format.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the length of the maintenance window.",
+							Default:     0,
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the IANA name of the time zone of the startTime field, e.g. \"Europe/Berlin\". Defaults to UTC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"startTime", "duration"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MaintenanceWindowStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceWindowStatus reports the state of the maintenance window",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"open": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Open is true while the maintenance window is open.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"nextTransition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextTransition is the time when the maintenance window is going to be opened, if it's currently closed, or to be closed, if it's currently open.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"deferredChanges": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DeferredChanges is the list of the disruptive changes that are waiting for the maintenance window.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"open"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MediatedDevicesConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -893,58 +1655,377 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MediatedHostDev
 							Format:      "",
 						},
 					},
-					"externalResourceProvider": {
+					"externalResourceProvider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "indicates that this resource is being provided by an external device plugin",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"disabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HCO enforces the existence of several MediatedHostDevice objects. Set disabled field to true instead of remove these objects.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"mdevNameSelector", "resourceName"},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MetricRelabelConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MetricRelabelConfig is a Prometheus relabeling rule of the scraped samples.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sourceLabels": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceLabels is the list of the labels to select the values from. Their values are concatenated using the separator, and matched against the regular expression.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"separator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Separator is the string between the concatenated values of the source labels. Prometheus uses \";\" if not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetLabel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetLabel is the label to write the result to, in a replace action. It is required for the replace action.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"regex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Regex is the regular expression to match the concatenated values against. Prometheus uses \"(.*)\" if not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replacement": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replacement is the value to write to the target label in a replace action. Regex capture groups are available. Prometheus uses \"$1\" if not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action is the relabeling action to perform. Defaults to replace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MigrationPolicyTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MigrationPolicyTemplate defines a KubeVirt MigrationPolicy, to be created by HCO.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the MigrationPolicy.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the spec of the MigrationPolicy. The selectors select the VMs that the policy applies to, by the labels of the VMI and of its namespace.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("kubevirt.io/api/migrations/v1alpha1.MigrationPolicySpec"),
+						},
+					},
+				},
+				Required: []string{"name", "spec"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/migrations/v1alpha1.MigrationPolicySpec"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_MonitoringConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MonitoringConfig holds the configuration of the HCO alerts.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"runbookURLTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunbookURLTemplate is the template of the runbook_url annotation of the HCO alerts. It must contain exactly one \"%s\" placeholder, that is replaced by the alert name. If not set, HCO uses the RUNBOOK_URL_TEMPLATE environment variable of the operator, or \"https://kubevirt.io/monitoring/runbooks/%s\" if the environment variable is not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"alertOverrides": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"alertName",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AlertOverrides overrides the severity and the \"for\" duration of specific HCO alerts. The alert expressions, and so their thresholds, can't be overridden.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertOverride"),
+									},
+								},
+							},
+						},
+					},
+					"disabledAlerts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DisabledAlerts is a list of HCO alerts to remove from the HCO PrometheusRule.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"alertRouting": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AlertRouting routes the HCO alerts to an Alertmanager webhook receiver. When set, HCO creates and manages an AlertmanagerConfig in its namespace, if the AlertmanagerConfig CRD is available.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertRoutingConfig"),
+						},
+					},
+					"serviceMonitor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceMonitor configures how Prometheus scrapes the HCO metrics, using the HCO ServiceMonitor. HCO still manages all the other fields of the ServiceMonitor.",
+							Ref:         ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ServiceMonitorConfig"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertOverride", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.AlertRoutingConfig", "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.ServiceMonitorConfig"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_NodeMediatedDeviceTypesConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeMediatedDeviceTypesConfig holds information about MDEV types to be defined in a specific node that matches the NodeSelector field.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector is a selector which must be true for the vmi to fit on a node. Selector which must match a node's labels for the vmi to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"mediatedDeviceTypes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"mediatedDevicesTypes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Deprecated: please use mediatedDeviceTypes instead.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"nodeSelector"},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_OperandCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperandCondition is a condition of an operand, as reported in the status of the operand CR",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"component": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Component is the kind of the operand CR; e.g. KubeVirt",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the condition",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status of the condition; one of True, False or Unknown",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is the reason of the last transition of the condition",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human-readable message with details about the last transition of the condition",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"component", "type", "status"},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_OperandDrift(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperandDrift reports the configuration drift of a single operand.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the operand.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the operand.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "indicates that this resource is being provided by an external device plugin",
-							Type:        []string{"boolean"},
+							Description: "Namespace is the namespace of the operand; empty for cluster-scoped operands.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"disabled": {
+					"inSync": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HCO enforces the existence of several MediatedHostDevice objects. Set disabled field to true instead of remove these objects.",
+							Description: "InSync is true if the live object matches the object that HCO would render.",
+							Default:     false,
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"mdevNameSelector", "resourceName"},
-			},
-		},
-	}
-}
-
-func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_NodeMediatedDeviceTypesConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "NodeMediatedDeviceTypesConfig holds information about MDEV types to be defined in a specific node that matches the NodeSelector field.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"nodeSelector": {
+					"missing": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeSelector is a selector which must be true for the vmi to fit on a node. Selector which must match a node's labels for the vmi to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Missing is true if the object does not exist.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"mediatedDeviceTypes": {
+					"diffPaths": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "DiffPaths is the list of the paths of the fields with a different value than the one that HCO would render.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -956,28 +2037,38 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_NodeMediatedDev
 							},
 						},
 					},
-					"mediatedDevicesTypes": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
-							},
+				},
+				Required: []string{"kind", "name", "inSync"},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_OperandObservedGeneration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperandObservedGeneration is the HyperConverged resource generation that was last successfully rendered into an operand",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the kind of the operand CR; e.g. KubeVirt",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
+					},
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Deprecated: please use mediatedDeviceTypes instead.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "ObservedGeneration is the HyperConverged resource generation that was last successfully rendered into the operand",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
-				Required: []string{"nodeSelector"},
+				Required: []string{"name", "observedGeneration"},
 			},
 		},
 	}
@@ -1119,6 +2210,143 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_PermittedHostDe
 	}
 }
 
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ResourcesMetadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourcesMetadata defines custom labels and annotations for the resources that HCO creates.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels to add to the resources that HCO creates.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations to add to the resources that HCO creates.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_ServiceMonitorConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceMonitorConfig holds the configurable scrape settings of the HCO ServiceMonitor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval is the interval between the scrapes of the HCO metrics. If not set, the scrape interval of the Prometheus instance is used.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"honorLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HonorLabels keeps the labels of the scraped metrics, when they collide with the labels of the target.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"metricRelabelings": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MetricRelabelings is a list of relabeling rules, that are applied to the scraped samples before ingestion; e.g. to drop high-cardinality series.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MetricRelabelConfig"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1.MetricRelabelConfig", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_SpecMigrationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SpecMigrationStatus reports a migration of a deprecated field of the HyperConverged CR to its replacement, that was done by HCO during an upgrade.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the migration.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes what was migrated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the HCO version that performed the migration.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"migrationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MigrationTime is the time when the migration was done.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name", "migrationTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_StorageImportConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1146,7 +2374,104 @@ func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_StorageImportCo
 							},
 						},
 					},
+					"importProxy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportProxy contains the proxy configuration of the CDI importer pods. The TrustedCAProxy ConfigMap, if set, must be in the namespace of HyperConverged. If not set, CDI uses the OpenShift cluster-wide proxy, if there is one.",
+							Ref:         ref("kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ImportProxy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1.ImportProxy"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_TenantQuotaTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TenantQuotaTemplate defines a VirtualMachineMigrationResourceQuota, to be created by HCO in a set of namespaces.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the VirtualMachineMigrationResourceQuota to create in each one of the namespaces.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespaces": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces is the list of the namespaces to create the quota in.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"additionalMigrationResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdditionalMigrationResources is the amount of resources, on top of the namespace ResourceQuota, that the VM migrations in the namespace are allowed to use.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "namespaces", "additionalMigrationResources"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_kubevirt_hyperconverged_cluster_operator_api_v1beta1_TuningPolicyRates(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TuningPolicyRates holds the rate limiter values of the custom tuning policy",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"qps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QPS is the number of queries per second that the kubevirt components may send to the API server",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the maximum number of queries that the kubevirt components may send to the API server at once",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
+				Required: []string{"qps", "burst"},
 			},
 		},
 	}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"os"
 
@@ -15,12 +16,15 @@ import (
 	csvv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	operatorsapiv2 "github.com/operator-framework/api/pkg/operators/v2"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -34,6 +38,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -44,9 +49,11 @@ import (
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/cmd/cmdcommon"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/hyperconverged"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/nodes"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	mtqv1alpha1 "kubevirt.io/managed-tenant-quota/staging/src/kubevirt.io/managed-tenant-quota-api/pkg/apis/core/v1alpha1"
 	sspv1beta2 "kubevirt.io/ssp-operator/api/v1beta2"
@@ -59,9 +66,11 @@ var (
 	resourcesSchemeFuncs = []func(*apiruntime.Scheme) error{
 		api.AddToScheme,
 		schedulingv1.AddToScheme,
+		storagev1.AddToScheme,
 		corev1.AddToScheme,
 		appsv1.AddToScheme,
 		rbacv1.AddToScheme,
+		networkingv1.AddToScheme,
 		cdiv1beta1.AddToScheme,
 		networkaddonsv1.AddToScheme,
 		sspv1beta2.AddToScheme,
@@ -73,8 +82,10 @@ var (
 		openshiftconfigv1.Install,
 		openshiftroutev1.Install,
 		monitoringv1.AddToScheme,
+		monitoringv1alpha1.AddToScheme,
 		apiextensionsv1.AddToScheme,
 		kubevirtcorev1.AddToScheme,
+		migrationsv1alpha1.AddToScheme,
 		coordinationv1.AddToScheme,
 		operatorsapiv2.AddToScheme,
 		imagev1.Install,
@@ -114,7 +125,7 @@ func main() {
 	needLeaderElection := !ci.IsRunningLocally()
 
 	// Create a new Cmd to provide shared dependencies and start components
-	mgr, err := manager.New(cfg, getManagerOptions(operatorNamespace, needLeaderElection, ci.IsMonitoringAvailable(), ci.IsOpenshift(), scheme))
+	mgr, err := manager.New(cfg, getManagerOptions(operatorNamespace, needLeaderElection, ci.IsMonitoringAvailable(), ci.IsScrapeConfigAvailable(), ci.IsAlertmanagerConfigAvailable(), ci.IsOpenshift(), ci.IsConsoleAvailable(), scheme))
 	cmdHelper.ExitOnError(err, "can't initiate manager")
 
 	// register pprof instrumentation if HCO_PPROF_ADDR is set
@@ -158,13 +169,22 @@ func main() {
 	upgradeableCondition, err = hcoutil.NewOperatorCondition(ci, mgr.GetClient(), operatorsapiv2.Upgradeable)
 	cmdHelper.ExitOnError(err, "Cannot create Upgradeable Operator Condition")
 
+	// the nodes controller triggers the reconciliation of the HyperConverged CR, when the node topology was changed
+	nodeEvents := make(chan event.GenericEvent, 1)
+
 	// Create a new reconciler
-	if err := hyperconverged.RegisterReconciler(mgr, ci, upgradeableCondition); err != nil {
+	if err := hyperconverged.RegisterReconciler(mgr, ci, upgradeableCondition, nodeEvents); err != nil {
 		logger.Error(err, "failed to register the HyperConverged controller")
 		eventEmitter.EmitEvent(nil, corev1.EventTypeWarning, "InitError", "Unable to register HyperConverged controller; "+err.Error())
 		os.Exit(1)
 	}
 
+	if err := nodes.RegisterReconciler(mgr, ci, nodeEvents); err != nil {
+		logger.Error(err, "failed to register the Nodes controller")
+		eventEmitter.EmitEvent(nil, corev1.EventTypeWarning, "InitError", "Unable to register Nodes controller; "+err.Error())
+		os.Exit(1)
+	}
+
 	err = createPriorityClass(ctx, mgr)
 	cmdHelper.ExitOnError(err, "Failed creating PriorityClass")
 
@@ -181,7 +201,7 @@ func main() {
 
 // Restricts the cache's ListWatch to specific fields/labels per GVK at the specified object to control the memory impact
 // this is used to completely overwrite the NewCache function so all the interesting objects should be explicitly listed here
-func getCacheOption(operatorNamespace string, isMonitoringAvailable, isOpenshift bool) cache.Options {
+func getCacheOption(operatorNamespace string, isMonitoringAvailable, isScrapeConfigAvailable, isAlertmanagerConfigAvailable, isOpenshift, isConsoleAvailable bool) cache.Options {
 	namespaceSelector := fields.Set{"metadata.namespace": operatorNamespace}.AsSelector()
 	labelSelector := labels.Set{hcoutil.AppLabel: hcoutil.HyperConvergedName}.AsSelector()
 	labelSelectorForNamespace := labels.Set{hcoutil.KubernetesMetadataName: operatorNamespace}.AsSelector()
@@ -221,7 +241,13 @@ func getCacheOption(operatorNamespace string, isMonitoringAvailable, isOpenshift
 				Label: labelSelector,
 				Field: namespaceSelector,
 			},
+			&networkingv1.NetworkPolicy{}: {
+				Label: labelSelector,
+				Field: namespaceSelector,
+			},
 			&apiextensionsv1.CustomResourceDefinition{}: {},
+			&storagev1.StorageClass{}:                   {},
+			&corev1.Node{}:                              {},
 		},
 	}
 
@@ -234,6 +260,10 @@ func getCacheOption(operatorNamespace string, isMonitoringAvailable, isOpenshift
 			Label: labelSelector,
 			Field: namespaceSelector,
 		},
+		&monitoringv1.PodMonitor{}: {
+			Label: labelSelector,
+			Field: namespaceSelector,
+		},
 	}
 
 	cacheOptionsByOjectForOpenshift := map[client.Object]cache.ByObject{
@@ -244,6 +274,9 @@ func getCacheOption(operatorNamespace string, isMonitoringAvailable, isOpenshift
 			Label: labelSelector,
 		},
 		&openshiftconfigv1.APIServer{}: {},
+	}
+
+	cacheOptionsByOjectForConsole := map[client.Object]cache.ByObject{
 		&consolev1.ConsoleCLIDownload{}: {
 			Label: labelSelector,
 		},
@@ -255,25 +288,51 @@ func getCacheOption(operatorNamespace string, isMonitoringAvailable, isOpenshift
 		},
 	}
 
+	// if the monitoring CRDs are installed after HCO was started, the hyperconverged controller starts watching the
+	// monitoring resources with the default cache configuration
 	if isMonitoringAvailable {
 		for k, v := range cacheOptionsByOjectForMonitoring {
 			cacheOptions.ByObject[k] = v
 		}
+		if isScrapeConfigAvailable {
+			cacheOptions.ByObject[&monitoringv1alpha1.ScrapeConfig{}] = cache.ByObject{
+				Label: labelSelector,
+				Field: namespaceSelector,
+			}
+		}
+		if isAlertmanagerConfigAvailable {
+			cacheOptions.ByObject[&monitoringv1alpha1.AlertmanagerConfig{}] = cache.ByObject{
+				Label: labelSelector,
+				Field: namespaceSelector,
+			}
+		}
 	}
 	if isOpenshift {
 		for k, v := range cacheOptionsByOjectForOpenshift {
 			cacheOptions.ByObject[k] = v
 		}
 	}
+	if isConsoleAvailable {
+		for k, v := range cacheOptionsByOjectForConsole {
+			cacheOptions.ByObject[k] = v
+		}
+	}
 
 	return cacheOptions
 
 }
 
-func getManagerOptions(operatorNamespace string, needLeaderElection, isMonitoringAvailable, isOpenshift bool, scheme *apiruntime.Scheme) manager.Options {
+func getManagerOptions(operatorNamespace string, needLeaderElection, isMonitoringAvailable, isScrapeConfigAvailable, isAlertmanagerConfigAvailable, isOpenshift, isConsoleAvailable bool, scheme *apiruntime.Scheme) manager.Options {
 	return manager.Options{
 		Metrics: server.Options{
-			BindAddress: fmt.Sprintf("%s:%d", hcoutil.MetricsHost, hcoutil.MetricsPort),
+			BindAddress:   fmt.Sprintf("%s:%d", hcoutil.MetricsHost, hcoutil.MetricsPort),
+			SecureServing: true,
+			TLSOpts: []func(*tls.Config){
+				func(cfg *tls.Config) {
+					// reload the certificate on rotation, and use it once the secret is mounted
+					cfg.GetCertificate = hcoutil.NewMetricsCertProvider(hcoutil.MetricsCertDir).GetCertificate
+				},
+			},
 		},
 		HealthProbeBindAddress: fmt.Sprintf("%s:%d", hcoutil.HealthProbeHost, hcoutil.HealthProbePort),
 		ReadinessEndpointName:  hcoutil.ReadinessEndpointName,
@@ -285,7 +344,7 @@ func getManagerOptions(operatorNamespace string, needLeaderElection, isMonitorin
 		// "configmapsleases". Therefore, having only "leases" should be safe now.
 		LeaderElectionResourceLock: resourcelock.LeasesResourceLock,
 		LeaderElectionID:           "hyperconverged-cluster-operator-lock",
-		Cache:                      getCacheOption(operatorNamespace, isMonitoringAvailable, isOpenshift),
+		Cache:                      getCacheOption(operatorNamespace, isMonitoringAvailable, isScrapeConfigAvailable, isAlertmanagerConfigAvailable, isOpenshift, isConsoleAvailable),
 		Scheme:                     scheme,
 	}
 }
@@ -9,11 +9,9 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
-	"github.com/openshift/library-go/pkg/crypto"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	webhookscontrollers "github.com/kubevirt/hyperconverged-cluster-operator/controllers/webhooks"
-	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/webhooks/validator"
 
 	csvv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
@@ -24,8 +22,14 @@ import (
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
@@ -51,10 +55,12 @@ var (
 		api.AddToScheme,
 		corev1.AddToScheme,
 		appsv1.AddToScheme,
+		storagev1.AddToScheme,
 		cdiv1beta1.AddToScheme,
 		networkaddonsv1.AddToScheme,
 		sspv1beta2.AddToScheme,
 		admissionregistrationv1.AddToScheme,
+		apiextensionsv1.AddToScheme,
 		openshiftconfigv1.Install,
 		kubevirtcorev1.AddToScheme,
 		openshiftconfigv1.Install,
@@ -76,20 +82,50 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Make sure the certificates are mounted, this should be handled by the OLM
-	webhookCertDir := webhooks.GetWebhookCertDir()
-	certs := []string{filepath.Join(webhookCertDir, hcoutil.WebhookCertName), filepath.Join(webhookCertDir, hcoutil.WebhookKeyName)}
-	for _, fname := range certs {
-		if _, err := os.Stat(fname); err != nil {
-			logger.Error(err, "CSV certificates were not found, skipping webhook initialization")
-			cmdHelper.ExitOnError(err, "CSV certificates were not found, skipping webhook initialization")
-		}
-	}
-
 	// Setup Scheme for all resources
 	scheme := apiruntime.NewScheme()
 	cmdHelper.AddToScheme(scheme, resourcesSchemeFuncs)
 
+	webhookTLSOpts := []func(*tls.Config){webhooks.MutateTLSConfig}
+	cacheOptions := cache.Options{}
+
+	var certSecretReconciler *webhookscontrollers.ReconcileCertSecret
+	if certSecret := webhooks.GetWebhookCertSecret(); certSecret != "" {
+		// Read the certificate from a user provided Secret, for deployments without the OLM
+		certSecretKey := types.NamespacedName{Namespace: operatorNamespace, Name: certSecret}
+		certSecretReconciler, err = newCertSecretReconciler(cfg, scheme, certSecretKey)
+		cmdHelper.ExitOnError(err, "can't load the webhook certificate", "secret", certSecretKey.String())
+
+		webhookTLSOpts = append(webhookTLSOpts, certSecretReconciler.MutateTLSConfig)
+		cacheOptions.ByObject = map[client.Object]cache.ByObject{
+			// the webhook is only allowed to read the Secrets in its own namespace
+			&corev1.Secret{}: {
+				Namespaces: map[string]cache.Config{
+					certSecretKey.Namespace: {},
+				},
+				Field: fields.Set{"metadata.name": certSecretKey.Name}.AsSelector(),
+			},
+		}
+	} else {
+		// Make sure the certificates are mounted, this should be handled by the OLM
+		webhookCertDir := webhooks.GetWebhookCertDir()
+		certs := []string{filepath.Join(webhookCertDir, hcoutil.WebhookCertName), filepath.Join(webhookCertDir, hcoutil.WebhookKeyName)}
+		for _, fname := range certs {
+			if _, err := os.Stat(fname); err != nil {
+				logger.Error(err, "CSV certificates were not found, skipping webhook initialization")
+				cmdHelper.ExitOnError(err, "CSV certificates were not found, skipping webhook initialization")
+			}
+		}
+	}
+
+	webhookOpts := webhook.Options{
+		CertDir:  webhooks.GetWebhookCertDir(),
+		CertName: hcoutil.WebhookCertName,
+		KeyName:  hcoutil.WebhookKeyName,
+		Port:     hcoutil.WebhookPort,
+		TLSOpts:  webhookTLSOpts,
+	}
+
 	// Create a new Cmd to provide shared dependencies and start components
 	mgr, err := manager.New(cfg, manager.Options{
 		Metrics: server.Options{
@@ -100,13 +136,8 @@ func main() {
 		LivenessEndpointName:   hcoutil.LivenessEndpointName,
 		LeaderElection:         false,
 		Scheme:                 scheme,
-		WebhookServer: webhook.NewServer(webhook.Options{
-			CertDir:  webhooks.GetWebhookCertDir(),
-			CertName: hcoutil.WebhookCertName,
-			KeyName:  hcoutil.WebhookKeyName,
-			Port:     hcoutil.WebhookPort,
-			TLSOpts:  []func(*tls.Config){MutateTLSConfig},
-		}),
+		Cache:                  cacheOptions,
+		WebhookServer:          webhook.NewServer(webhookOpts),
 	})
 	cmdHelper.ExitOnError(err, "failed to create manager")
 
@@ -165,7 +196,12 @@ func main() {
 	err = webhookscontrollers.RegisterReconciler(mgr, ci)
 	cmdHelper.ExitOnError(err, "Cannot register APIServer reconciler")
 
-	if err = webhooks.SetupWebhookWithManager(ctx, mgr, ci.IsOpenshift(), hcoTLSSecurityProfile); err != nil {
+	if certSecretReconciler != nil {
+		err = webhookscontrollers.RegisterCertSecretReconciler(mgr, certSecretReconciler)
+		cmdHelper.ExitOnError(err, "Cannot register the webhook certificate Secret reconciler")
+	}
+
+	if err = webhooks.SetupWebhookWithManager(ctx, mgr, webhookOpts, ci.IsOpenshift(), hcoTLSSecurityProfile); err != nil {
 		logger.Error(err, "unable to create webhook", "webhook", "HyperConverged")
 		eventEmitter.EmitEvent(nil, corev1.EventTypeWarning, "InitError", "Unable to create webhook")
 		os.Exit(1)
@@ -181,14 +217,21 @@ func main() {
 	}
 }
 
-func MutateTLSConfig(cfg *tls.Config) {
-	// This callback executes on each client call returning a new config to be used
-	// please be aware that the APIServer is using http keepalive so this is going to
-	// be executed only after a while for fresh connections and not on existing ones
-	cfg.GetConfigForClient = func(_ *tls.ClientHelloInfo) (*tls.Config, error) {
-		cipherNames, minTypedTLSVersion := validator.SelectCipherSuitesAndMinTLSVersion()
-		cfg.CipherSuites = crypto.CipherSuitesOrDie(crypto.OpenSSLToIANACipherSuites(cipherNames))
-		cfg.MinVersion = crypto.TLSVersionOrDie(string(minTypedTLSVersion))
-		return cfg, nil
+// newCertSecretReconciler loads the webhook certificate from the Secret before starting the manager, so the webhook
+// server never starts without a certificate
+func newCertSecretReconciler(cfg *rest.Config, scheme *apiruntime.Scheme, key types.NamespacedName) (*webhookscontrollers.ReconcileCertSecret, error) {
+	// the manager cache is not started yet, so use a client without cache
+	apiClient, err := client.New(cfg, client.Options{
+		Scheme: scheme,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r := webhookscontrollers.NewCertSecretReconciler(apiClient, key)
+	if err = r.LoadCertificate(context.Background()); err != nil {
+		return nil, err
 	}
+
+	return r, nil
 }
@@ -0,0 +1,124 @@
+package alerts
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	alertmanagerConfigName = hcoutil.HyperConvergedName + "-alertmanager-config"
+	alertReceiverName      = "hco-webhook"
+)
+
+// alertmanagerConfigReconciler manages the AlertmanagerConfig that routes the HCO alerts to the receiver from the
+// HyperConverged CR. The AlertmanagerConfig is only required if the alert routing is configured.
+type alertmanagerConfigReconciler struct {
+	namespace string
+	owner     metav1.OwnerReference
+	theConfig *monitoringv1alpha1.AlertmanagerConfig
+}
+
+func newAlertmanagerConfigReconciler(namespace string, owner metav1.OwnerReference) *alertmanagerConfigReconciler {
+	return &alertmanagerConfigReconciler{
+		namespace: namespace,
+		owner:     owner,
+	}
+}
+
+func (r *alertmanagerConfigReconciler) setHyperConverged(hc *hcov1beta1.HyperConverged, _ logr.Logger) {
+	if hc == nil || hc.Spec.Monitoring == nil || hc.Spec.Monitoring.AlertRouting == nil {
+		r.theConfig = nil
+		return
+	}
+
+	r.theConfig = newAlertmanagerConfig(r.namespace, r.owner, hc.Spec.Monitoring.AlertRouting)
+}
+
+func (r *alertmanagerConfigReconciler) isRequired() bool {
+	return r.theConfig != nil
+}
+
+func (r *alertmanagerConfigReconciler) Kind() string {
+	return monitoringv1alpha1.AlertmanagerConfigKind
+}
+
+func (r *alertmanagerConfigReconciler) ResourceName() string {
+	return alertmanagerConfigName
+}
+
+func (r *alertmanagerConfigReconciler) GetFullResource() client.Object {
+	return r.theConfig.DeepCopy()
+}
+
+func (r *alertmanagerConfigReconciler) EmptyObject() client.Object {
+	return &monitoringv1alpha1.AlertmanagerConfig{}
+}
+
+func (r *alertmanagerConfigReconciler) UpdateExistingResource(ctx context.Context, cl client.Client, resource client.Object, logger logr.Logger) (client.Object, bool, error) {
+	found := resource.(*monitoringv1alpha1.AlertmanagerConfig)
+	modified := false
+	if !reflect.DeepEqual(found.Spec, r.theConfig.Spec) {
+		r.theConfig.Spec.DeepCopyInto(&found.Spec)
+		modified = true
+	}
+
+	modified = updateCommonDetails(&r.theConfig.ObjectMeta, &found.ObjectMeta) || modified
+
+	if modified {
+		err := cl.Update(ctx, found)
+		if err != nil {
+			logger.Error(err, "failed to update the AlertmanagerConfig")
+			return nil, false, err
+		}
+		logger.Info("successfully updated the AlertmanagerConfig")
+	}
+
+	return found, modified, nil
+}
+
+func newAlertmanagerConfig(namespace string, owner metav1.OwnerReference, routing *hcov1beta1.AlertRoutingConfig) *monitoringv1alpha1.AlertmanagerConfig {
+	webhook := monitoringv1alpha1.WebhookConfig{
+		SendResolved: routing.SendResolved,
+		URLSecret:    routing.WebhookURLSecret.DeepCopy(),
+	}
+
+	if routing.WebhookURL != "" {
+		webhook.URL = ptr.To(routing.WebhookURL)
+	}
+
+	return &monitoringv1alpha1.AlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            alertmanagerConfigName,
+			Labels:          hcoutil.GetLabels(hcoutil.HyperConvergedName, hcoutil.AppComponentMonitoring),
+			Namespace:       namespace,
+			OwnerReferences: []metav1.OwnerReference{owner},
+		},
+		Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+			Route: &monitoringv1alpha1.Route{
+				Receiver: alertReceiverName,
+				Matchers: []monitoringv1alpha1.Matcher{
+					{
+						Name:      componentAlertLabelKey,
+						Value:     componentAlertLabelValue,
+						MatchType: monitoringv1alpha1.MatchEqual,
+					},
+				},
+			},
+			Receivers: []monitoringv1alpha1.Receiver{
+				{
+					Name:           alertReceiverName,
+					WebhookConfigs: []monitoringv1alpha1.WebhookConfig{webhook},
+				},
+			},
+		},
+	}
+}
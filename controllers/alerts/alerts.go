@@ -15,10 +15,12 @@ import (
 
 	"github.com/go-logr/logr"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/common/model"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -28,6 +30,10 @@ const (
 	unsafeModificationAlert       = "UnsupportedHCOModification"
 	installationNotCompletedAlert = "HCOInstallationIncomplete"
 	singleStackIPv6Alert          = "SingleStackIPv6Unsupported"
+	notUpgradeableAlert           = "HCONotUpgradeable"
+	goldenImageImportFailingAlert = "HCOGoldenImageImportFailing"
+	operandReconcilePausedAlert   = "HCOOperandReconcilePaused"
+	upgradeTimedOutAlert          = "HCOUpgradeTimedOut"
 	severityAlertLabelKey         = "severity"
 	healthImpactAlertLabelKey     = "operator_health_impact"
 	partOfAlertLabelKey           = "kubernetes_operator_part_of"
@@ -37,25 +43,53 @@ const (
 	ruleName                      = hcoutil.HyperConvergedName + "-prometheus-rule"
 	defaultRunbookURLTemplate     = "https://kubevirt.io/monitoring/runbooks/%s"
 	runbookURLTemplateEnv         = "RUNBOOK_URL_TEMPLATE"
+
+	vmiPhaseCountRecord            = "cluster:vmi_phase_count:sum"
+	vmiMigrationSuccessRatioRecord = "cluster:vmi_migration_success:ratio"
+	vmiVCPUCountRecord             = "cluster:vmi_vcpu:count"
+	vmiMemoryDomainBytesRecord     = "cluster:vmi_memory_domain_bytes:sum"
+	reconcileQueueDepthRecord      = "kubevirt_hco_reconcile_queue_depth"
 )
 
 type runbookCreator struct {
 	template string
 }
 
-func newRunbookCreator() *runbookCreator {
+func newRunbookCreator(runbookURLTemplate string) *runbookCreator {
+	return &runbookCreator{
+		template: runbookURLTemplate,
+	}
+}
+
+// getDefaultRunbookURLTemplate returns the runbook URL template from the RUNBOOK_URL_TEMPLATE environment variable, if
+// set, or the default one.
+func getDefaultRunbookURLTemplate() string {
 	runbookURLTemplate, exists := os.LookupEnv(runbookURLTemplateEnv)
 	if !exists {
 		runbookURLTemplate = defaultRunbookURLTemplate
 	}
 
-	if strings.Count(runbookURLTemplate, "%s") != 1 {
+	if !isValidRunbookURLTemplate(runbookURLTemplate) {
 		panic(errors.New("runbooks URL template must have exactly 1 %s substring"))
 	}
 
-	return &runbookCreator{
-		template: runbookURLTemplate,
+	return runbookURLTemplate
+}
+
+// getRunbookURLTemplate returns the runbook URL template from the HyperConverged CR, if set, or the default one.
+func getRunbookURLTemplate(hc *hcov1beta1.HyperConverged, logger logr.Logger) string {
+	if hc != nil && hc.Spec.Monitoring != nil && hc.Spec.Monitoring.RunbookURLTemplate != "" {
+		if isValidRunbookURLTemplate(hc.Spec.Monitoring.RunbookURLTemplate) {
+			return hc.Spec.Monitoring.RunbookURLTemplate
+		}
+		logger.Error(errors.New("runbooks URL template must have exactly 1 %s substring"), "ignoring the runbook URL template from the HyperConverged CR", "template", hc.Spec.Monitoring.RunbookURLTemplate)
 	}
+
+	return getDefaultRunbookURLTemplate()
+}
+
+func isValidRunbookURLTemplate(runbookURLTemplate string) bool {
+	return strings.Count(runbookURLTemplate, "%s") == 1
 }
 
 func (r runbookCreator) getURL(alertName string) string {
@@ -73,6 +107,16 @@ func newAlertRuleReconciler(namespace string, owner metav1.OwnerReference) *Aler
 	}
 }
 
+// setHyperConverged regenerates the PrometheusRule spec, according to the monitoring configuration in the
+// HyperConverged CR
+func (r *AlertRuleReconciler) setHyperConverged(hc *hcov1beta1.HyperConverged, logger logr.Logger) {
+	var monitoring *hcov1beta1.MonitoringConfig
+	if hc != nil {
+		monitoring = hc.Spec.Monitoring
+	}
+	r.theRule.Spec = *newPrometheusRuleSpec(getRunbookURLTemplate(hc, logger), monitoring)
+}
+
 func (r *AlertRuleReconciler) Kind() string {
 	return monitoringv1.PrometheusRuleKind
 }
@@ -130,7 +174,12 @@ func newPrometheusRule(namespace string, owner metav1.OwnerReference) *monitorin
 
 // NewPrometheusRuleSpec creates PrometheusRuleSpec for alert rules
 func NewPrometheusRuleSpec() *monitoringv1.PrometheusRuleSpec {
-	runbookCreator := newRunbookCreator()
+	return newPrometheusRuleSpec(getDefaultRunbookURLTemplate(), nil)
+}
+
+func newPrometheusRuleSpec(runbookURLTemplate string, monitoring *hcov1beta1.MonitoringConfig) *monitoringv1.PrometheusRuleSpec {
+	runbookCreator := newRunbookCreator(runbookURLTemplate)
+	overrides := getAlertOverrides(monitoring)
 
 	spec := &monitoringv1.PrometheusRuleSpec{
 		Groups: []monitoringv1.RuleGroup{{
@@ -142,27 +191,90 @@ func NewPrometheusRuleSpec() *monitoringv1.PrometheusRuleSpec {
 				createRequestCPUCoresRule(),
 				createOperatorHealthStatusRule(),
 				createSingleStackIPv6AlertRule(),
+				createNotUpgradeableAlertRule(),
+				createGoldenImageImportFailingAlertRule(),
+				createOperandReconcilePausedAlertRule(),
+				createUpgradeTimedOutAlertRule(),
+				createVMIPhaseCountRule(),
+				createVMIMigrationSuccessRatioRule(),
+				createVMIVCPUCountRule(),
+				createVMIMemoryDomainBytesRule(),
+				createReconcileQueueDepthRule(),
 			},
 		}},
 	}
 
-	for _, rule := range spec.Groups[0].Rules {
+	if monitoring != nil && len(monitoring.DisabledAlerts) > 0 {
+		spec.Groups[0].Rules = removeDisabledAlerts(spec.Groups[0].Rules, monitoring.DisabledAlerts)
+	}
+
+	for i := range spec.Groups[0].Rules {
+		rule := &spec.Groups[0].Rules[i]
 		if rule.Alert != "" {
 			rule.Annotations["runbook_url"] = runbookCreator.getURL(rule.Alert)
 			rule.Labels[partOfAlertLabelKey] = partOfAlertLabelValue
 			rule.Labels[componentAlertLabelKey] = componentAlertLabelValue
+
+			if override, ok := overrides[rule.Alert]; ok {
+				applyAlertOverride(rule, override)
+			}
 		}
 	}
 
 	return spec
 }
 
+func removeDisabledAlerts(rules []monitoringv1.Rule, disabledAlerts []hcov1beta1.HCOAlertName) []monitoringv1.Rule {
+	disabled := make(map[string]bool, len(disabledAlerts))
+	for _, alert := range disabledAlerts {
+		disabled[string(alert)] = true
+	}
+
+	enabledRules := make([]monitoringv1.Rule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Alert == "" || !disabled[rule.Alert] {
+			enabledRules = append(enabledRules, rule)
+		}
+	}
+
+	return enabledRules
+}
+
+// getAlertOverrides returns the alert overrides from the monitoring configuration, by alert name
+func getAlertOverrides(monitoring *hcov1beta1.MonitoringConfig) map[string]hcov1beta1.AlertOverride {
+	if monitoring == nil || len(monitoring.AlertOverrides) == 0 {
+		return nil
+	}
+
+	overrides := make(map[string]hcov1beta1.AlertOverride, len(monitoring.AlertOverrides))
+	for _, override := range monitoring.AlertOverrides {
+		overrides[string(override.AlertName)] = override
+	}
+
+	return overrides
+}
+
+func applyAlertOverride(rule *monitoringv1.Rule, override hcov1beta1.AlertOverride) {
+	if override.Severity != "" {
+		rule.Labels[severityAlertLabelKey] = override.Severity
+	}
+
+	if override.For != nil {
+		if override.For.Duration > 0 {
+			forDuration := monitoringv1.Duration(model.Duration(override.For.Duration).String())
+			rule.For = &forDuration
+		} else {
+			rule.For = nil
+		}
+	}
+}
+
 func createOutOfBandUpdateAlertRule() monitoringv1.Rule {
 	return monitoringv1.Rule{
 		Alert: outOfBandUpdateAlert,
-		Expr:  intstr.FromString("sum by(component_name) ((round(increase(kubevirt_hco_out_of_band_modifications_total[10m]))>0 and kubevirt_hco_out_of_band_modifications_total offset 10m) or (kubevirt_hco_out_of_band_modifications_total != 0 unless kubevirt_hco_out_of_band_modifications_total offset 10m))"),
+		Expr:  intstr.FromString("sum by(component_name, kind, resource_namespace, field_manager) ((round(increase(kubevirt_hco_out_of_band_modifications_total[10m]))>0 and kubevirt_hco_out_of_band_modifications_total offset 10m) or (kubevirt_hco_out_of_band_modifications_total != 0 unless kubevirt_hco_out_of_band_modifications_total offset 10m))"),
 		Annotations: map[string]string{
-			"description": "Out-of-band modification for {{ $labels.component_name }}.",
+			"description": "Out-of-band modification for {{ $labels.component_name }} (kind: {{ $labels.kind }}, namespace: {{ $labels.resource_namespace }}), last modified by the {{ $labels.field_manager }} field manager.",
 			"summary":     "{{ $value }} out-of-band CR modifications were detected in the last 10 minutes.",
 		},
 		Labels: map[string]string{
@@ -219,6 +331,117 @@ func createOperatorHealthStatusRule() monitoringv1.Rule {
 	}
 }
 
+// Recording rules that pre-aggregate the KubeVirt metrics of the whole cluster, for the console dashboards
+func createVMIPhaseCountRule() monitoringv1.Rule {
+	return monitoringv1.Rule{
+		Record: vmiPhaseCountRecord,
+		Expr:   intstr.FromString(`sum by (phase) (kubevirt_vmi_phase_count)`),
+	}
+}
+
+// the ratio of the successful migrations out of the completed ones; no value if there are no completed migrations
+func createVMIMigrationSuccessRatioRule() monitoringv1.Rule {
+	return monitoringv1.Rule{
+		Record: vmiMigrationSuccessRatioRecord,
+		Expr:   intstr.FromString(`(sum(kubevirt_vmi_migration_succeeded) or vector(0)) / (((sum(kubevirt_vmi_migration_succeeded) or vector(0)) + (sum(kubevirt_vmi_migration_failed) or vector(0))) > 0)`),
+	}
+}
+
+// virt-handler reports a kubevirt_vmi_vcpu_seconds_total series for each vCPU of each running VMI
+func createVMIVCPUCountRule() monitoringv1.Rule {
+	return monitoringv1.Rule{
+		Record: vmiVCPUCountRecord,
+		Expr:   intstr.FromString(`count(kubevirt_vmi_vcpu_seconds_total) or vector(0)`),
+	}
+}
+
+func createVMIMemoryDomainBytesRule() monitoringv1.Rule {
+	return monitoringv1.Rule{
+		Record: vmiMemoryDomainBytesRecord,
+		Expr:   intstr.FromString(`sum(kubevirt_vmi_memory_domain_bytes) or vector(0)`),
+	}
+}
+
+// controller-runtime reports the depth of the work queue of each controller; only the leader HCO pod runs the controller
+func createReconcileQueueDepthRule() monitoringv1.Rule {
+	return monitoringv1.Rule{
+		Record: reconcileQueueDepthRecord,
+		Expr:   intstr.FromString(`max(workqueue_depth{name="hyperconverged-controller"})`),
+	}
+}
+
+// OLM does not upgrade HCO while it is not upgradeable, so a stuck Upgradeable condition silently blocks the upgrades
+func createNotUpgradeableAlertRule() monitoringv1.Rule {
+	var hour1 monitoringv1.Duration = "1h"
+	return monitoringv1.Rule{
+		Alert: notUpgradeableAlert,
+		Expr:  intstr.FromString("kubevirt_hco_upgradeable == 0 and on() kubevirt_hco_hyperconverged_cr_exists == 1"),
+		Annotations: map[string]string{
+			"description": "The Upgradeable condition of the HyperConverged custom resource is false for a long time, and OLM does not upgrade HCO while it is not upgradeable. Check the reason in the Upgradeable condition of the HyperConverged custom resource.",
+			"summary":     "HCO is not upgradeable.",
+		},
+		For: &hour1,
+		Labels: map[string]string{
+			severityAlertLabelKey:     "warning",
+			healthImpactAlertLabelKey: "none",
+		},
+	}
+}
+
+// The golden images are the default boot sources of the VMs; a golden image that is not imported for a long time is
+// usually caused by a broken source or a storage issue, that otherwise is only visible in the CDI resources
+func createGoldenImageImportFailingAlertRule() monitoringv1.Rule {
+	var hour1 monitoringv1.Duration = "1h"
+	return monitoringv1.Rule{
+		Alert: goldenImageImportFailingAlert,
+		Expr:  intstr.FromString("kubevirt_hco_golden_images_not_up_to_date > 0"),
+		Annotations: map[string]string{
+			"description": "The DataImportCrons of {{ $value }} golden images are not up to date for a long time. Check the import status of the golden images in the dataImportCronTemplates list in the status of the HyperConverged custom resource.",
+			"summary":     "Golden images are not up to date.",
+		},
+		For: &hour1,
+		Labels: map[string]string{
+			severityAlertLabelKey:     "warning",
+			healthImpactAlertLabelKey: "none",
+		},
+	}
+}
+
+// Pausing the reconciliation of an operand is meant to be temporary; e.g. for debugging. While it is paused, HCO does
+// not apply any configuration change to the operand CR, so a forgotten pause-reconcile annotation should be noticed
+func createOperandReconcilePausedAlertRule() monitoringv1.Rule {
+	var hour1 monitoringv1.Duration = "1h"
+	return monitoringv1.Rule{
+		Alert: operandReconcilePausedAlert,
+		Expr:  intstr.FromString("kubevirt_hco_operand_reconcile_paused == 1"),
+		Annotations: map[string]string{
+			"description": "The reconciliation of the {{ $labels.component_name }} CR is paused by the hco.kubevirt.io/pause-reconcile annotation of the HyperConverged custom resource for a long time. Changes in the HyperConverged custom resource are not applied to this CR until the annotation is removed.",
+			"summary":     "The reconciliation of an operand CR is paused.",
+		},
+		For: &hour1,
+		Labels: map[string]string{
+			severityAlertLabelKey:     "warning",
+			healthImpactAlertLabelKey: "none",
+		},
+	}
+}
+
+// HCO already waits for the upgrade timeout before it reports an operand as timed out, so the alert fires right away
+func createUpgradeTimedOutAlertRule() monitoringv1.Rule {
+	return monitoringv1.Rule{
+		Alert: upgradeTimedOutAlert,
+		Expr:  intstr.FromString("kubevirt_hco_operand_upgrade_timed_out == 1"),
+		Annotations: map[string]string{
+			"description": "The {{ $labels.component_name }} operand did not reach its expected version within the HCO upgrade timeout. Check the conditions and the observed version in the status of the {{ $labels.component_name }} CR.",
+			"summary":     "The HCO upgrade is not completed on time.",
+		},
+		Labels: map[string]string{
+			severityAlertLabelKey:     "warning",
+			healthImpactAlertLabelKey: "warning",
+		},
+	}
+}
+
 func createSingleStackIPv6AlertRule() monitoringv1.Rule {
 	return monitoringv1.Rule{
 		Alert: singleStackIPv6Alert,
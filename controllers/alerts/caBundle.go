@@ -0,0 +1,103 @@
+package alerts
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	metricsCABundleName = serviceName + "-ca"
+
+	// injectCABundleAnnotation asks the OpenShift service CA operator to inject the service CA bundle into the
+	// ConfigMap, under the serviceCABundleKey key
+	injectCABundleAnnotation = "service.beta.openshift.io/inject-cabundle"
+	serviceCABundleKey       = "service-ca.crt"
+)
+
+// metricsCABundleReconciler maintains the ConfigMap that the OpenShift service CA operator injects the service CA
+// bundle into. The ScrapeConfig verifies the serving certificate of the metrics service against this bundle. It is
+// only required on OpenShift, when the metrics are scraped using the ScrapeConfig.
+type metricsCABundleReconciler struct {
+	theConfigMap *corev1.ConfigMap
+	required     bool
+}
+
+func newMetricsCABundleReconciler(namespace string, owner metav1.OwnerReference, isOpenshift bool, scrapeResource string) *metricsCABundleReconciler {
+	return &metricsCABundleReconciler{
+		theConfigMap: NewMetricsCABundle(namespace, owner),
+		required:     isOpenshift && scrapeResource == monitoringv1alpha1.ScrapeConfigsKind,
+	}
+}
+
+func (r metricsCABundleReconciler) isRequired() bool {
+	return r.required
+}
+
+func (r metricsCABundleReconciler) Kind() string {
+	return "ConfigMap"
+}
+
+func (r metricsCABundleReconciler) ResourceName() string {
+	return metricsCABundleName
+}
+
+func (r metricsCABundleReconciler) GetFullResource() client.Object {
+	return r.theConfigMap.DeepCopy()
+}
+
+func (r metricsCABundleReconciler) EmptyObject() client.Object {
+	return &corev1.ConfigMap{}
+}
+
+// UpdateExistingResource only reconciles the metadata of the ConfigMap. The data is written by the service CA operator.
+func (r metricsCABundleReconciler) UpdateExistingResource(ctx context.Context, cl client.Client, resource client.Object, logger logr.Logger) (client.Object, bool, error) {
+	found := resource.(*corev1.ConfigMap)
+
+	modified := false
+	if found.Annotations[injectCABundleAnnotation] != "true" {
+		if found.Annotations == nil {
+			found.Annotations = make(map[string]string)
+		}
+		found.Annotations[injectCABundleAnnotation] = "true"
+		modified = true
+	}
+
+	modified = updateCommonDetails(&r.theConfigMap.ObjectMeta, &found.ObjectMeta) || modified
+
+	if modified {
+		err := cl.Update(ctx, found)
+		if err != nil {
+			logger.Error(err, "failed to update the metrics CA bundle ConfigMap")
+			return nil, false, err
+		}
+		logger.Info("successfully updated the metrics CA bundle ConfigMap")
+	}
+
+	return found, modified, nil
+}
+
+// NewMetricsCABundle returns the ConfigMap that the OpenShift service CA operator injects the service CA bundle into
+func NewMetricsCABundle(namespace string, owner metav1.OwnerReference) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      metricsCABundleName,
+			Namespace: namespace,
+			Labels:    hcoutil.GetLabels(hcoutil.HyperConvergedName, hcoutil.AppComponentMonitoring),
+			Annotations: map[string]string{
+				injectCABundleAnnotation: "true",
+			},
+			OwnerReferences: []metav1.OwnerReference{owner},
+		},
+	}
+}
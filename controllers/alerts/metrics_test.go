@@ -6,19 +6,26 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"k8s.io/utils/ptr"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/metrics"
@@ -78,6 +85,11 @@ var _ = Describe("alert tests", func() {
 				Reason:    "Created",
 				Msg:       "Created ServiceMonitor " + serviceName,
 			},
+			{
+				EventType: corev1.EventTypeNormal,
+				Reason:    "Created",
+				Msg:       "Created NetworkPolicy " + networkPolicyName,
+			},
 		}
 
 		It("should create all the resources if missing", func() {
@@ -96,12 +108,14 @@ var _ = Describe("alert tests", func() {
 			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: roleName}, role)).Should(Succeed())
 			rb := &rbacv1.RoleBinding{}
 			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: roleName}, rb)).Should(Succeed())
+			np := &networkingv1.NetworkPolicy{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: networkPolicyName}, np)).Should(Succeed())
 
 			hco := commontestutils.NewHco()
 			req = commontestutils.NewReq(hco)
 			Expect(r.UpdateRelatedObjects(req)).Should(Succeed())
 			Expect(req.StatusDirty).To(BeTrue())
-			Expect(hco.Status.RelatedObjects).To(HaveLen(5))
+			Expect(hco.Status.RelatedObjects).To(HaveLen(6))
 
 			Expect(ee.CheckEvents(expectedEvents)).To(BeTrue())
 		})
@@ -246,6 +260,27 @@ var _ = Describe("alert tests", func() {
 			Expect(metrics.HcoMetrics.GetOverwrittenModificationsCount(monitoringv1.PrometheusRuleKind, ruleName)).Should(BeEquivalentTo(currentMetric + 1))
 		})
 
+		It("should count the overwritten modification by the field manager of the modification", func() {
+			req.HCOTriggered = false
+			existRule := newPrometheusRule(commontestutils.Namespace, getDeploymentReference(ci.GetDeployment()))
+			existRule.Labels = map[string]string{"wrongKey1": "wrongValue1"}
+			existRule.ManagedFields = []metav1.ManagedFieldsEntry{
+				{Manager: "kubectl-edit", Operation: metav1.ManagedFieldsOperationUpdate, Time: &metav1.Time{Time: time.Now()}},
+				{Manager: "hyperconverged-cluster-operator", Operation: metav1.ManagedFieldsOperationUpdate, Time: &metav1.Time{Time: time.Now().Add(-time.Hour)}},
+			}
+
+			managerMetric, err := metrics.HcoMetrics.GetOverwrittenModificationsCountByFieldManager(monitoringv1.PrometheusRuleKind, ruleName, commontestutils.Namespace, "kubectl-edit")
+			Expect(err).ToNot(HaveOccurred())
+
+			cl := commontestutils.InitClient([]client.Object{ns, existRule})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+
+			Expect(metrics.HcoMetrics.GetOverwrittenModificationsCount(monitoringv1.PrometheusRuleKind, ruleName)).Should(BeEquivalentTo(currentMetric + 1))
+			Expect(metrics.HcoMetrics.GetOverwrittenModificationsCountByFieldManager(monitoringv1.PrometheusRuleKind, ruleName, commontestutils.Namespace, "kubectl-edit")).Should(BeEquivalentTo(managerMetric + 1))
+		})
+
 		It("should update the referenceOwner if missing", func() {
 			owner := metav1.OwnerReference{}
 			existRule := newPrometheusRule(commontestutils.Namespace, owner)
@@ -344,6 +379,260 @@ var _ = Describe("alert tests", func() {
 			}
 		})
 
+		It("should use the runbook URL template from the HyperConverged CR, if set", func() {
+			os.Setenv(runbookURLTemplateEnv, "env/runbookURL/template/%s")
+			hcRunbookURLTemplate := "hc/runbookURL/template/%s"
+
+			hco := commontestutils.NewHco()
+			hco.Spec.Monitoring = &v1beta1.MonitoringConfig{RunbookURLTemplate: hcRunbookURLTemplate}
+			req.Instance = hco
+
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			pr := &monitoringv1.PrometheusRule{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).Should(Succeed())
+			Expect(pr.Spec).Should(Equal(*newPrometheusRuleSpec(hcRunbookURLTemplate, hco.Spec.Monitoring)))
+			Expect(pr.Spec.Groups[0].Rules[0].Annotations).To(HaveKeyWithValue("runbook_url", fmt.Sprintf(hcRunbookURLTemplate, outOfBandUpdateAlert)))
+
+			By("use the ENV Variable when the template is removed from the HyperConverged CR")
+			hco.Spec.Monitoring = nil
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).Should(Succeed())
+			Expect(pr.Spec).Should(Equal(*NewPrometheusRuleSpec()))
+			Expect(pr.Spec.Groups[0].Rules[0].Annotations).To(HaveKeyWithValue("runbook_url", fmt.Sprintf("env/runbookURL/template/%s", outOfBandUpdateAlert)))
+		})
+
+		It("should create the not upgradeable alert", func() {
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			pr := &monitoringv1.PrometheusRule{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).Should(Succeed())
+
+			var found *monitoringv1.Rule
+			for i, rule := range pr.Spec.Groups[0].Rules {
+				if rule.Alert == notUpgradeableAlert {
+					found = &pr.Spec.Groups[0].Rules[i]
+				}
+			}
+
+			Expect(found).ToNot(BeNil())
+			Expect(found.Expr.String()).To(ContainSubstring("kubevirt_hco_upgradeable == 0"))
+			Expect(found.For).To(HaveValue(BeEquivalentTo("1h")))
+			Expect(found.Labels).To(HaveKeyWithValue(severityAlertLabelKey, "warning"))
+			Expect(found.Labels).To(HaveKeyWithValue(healthImpactAlertLabelKey, "none"))
+
+			By("override the duration from the HyperConverged CR")
+			hco := commontestutils.NewHco()
+			hco.Spec.Monitoring = &v1beta1.MonitoringConfig{
+				AlertOverrides: []v1beta1.AlertOverride{
+					{
+						AlertName: notUpgradeableAlert,
+						For:       &metav1.Duration{Duration: 3 * time.Hour},
+					},
+				},
+			}
+			req.Instance = hco
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).Should(Succeed())
+			for _, rule := range pr.Spec.Groups[0].Rules {
+				if rule.Alert == notUpgradeableAlert {
+					Expect(rule.For).To(HaveValue(BeEquivalentTo("3h")))
+				}
+			}
+		})
+
+		It("should create the golden image import failing alert", func() {
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			pr := &monitoringv1.PrometheusRule{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).Should(Succeed())
+
+			var found *monitoringv1.Rule
+			for i, rule := range pr.Spec.Groups[0].Rules {
+				if rule.Alert == goldenImageImportFailingAlert {
+					found = &pr.Spec.Groups[0].Rules[i]
+				}
+			}
+
+			Expect(found).ToNot(BeNil())
+			Expect(found.Expr.String()).To(Equal("kubevirt_hco_golden_images_not_up_to_date > 0"))
+			Expect(found.For).To(HaveValue(BeEquivalentTo("1h")))
+			Expect(found.Labels).To(HaveKeyWithValue(severityAlertLabelKey, "warning"))
+			Expect(found.Labels).To(HaveKeyWithValue(healthImpactAlertLabelKey, "none"))
+			Expect(found.Annotations).To(HaveKeyWithValue("runbook_url", fmt.Sprintf(defaultRunbookURLTemplate, goldenImageImportFailingAlert)))
+		})
+
+		It("should create the operand reconcile paused alert", func() {
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			pr := &monitoringv1.PrometheusRule{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).Should(Succeed())
+
+			var found *monitoringv1.Rule
+			for i, rule := range pr.Spec.Groups[0].Rules {
+				if rule.Alert == operandReconcilePausedAlert {
+					found = &pr.Spec.Groups[0].Rules[i]
+				}
+			}
+
+			Expect(found).ToNot(BeNil())
+			Expect(found.Expr.String()).To(Equal("kubevirt_hco_operand_reconcile_paused == 1"))
+			Expect(found.For).To(HaveValue(BeEquivalentTo("1h")))
+			Expect(found.Labels).To(HaveKeyWithValue(severityAlertLabelKey, "warning"))
+			Expect(found.Labels).To(HaveKeyWithValue(healthImpactAlertLabelKey, "none"))
+			Expect(found.Annotations).To(HaveKeyWithValue("runbook_url", fmt.Sprintf(defaultRunbookURLTemplate, operandReconcilePausedAlert)))
+		})
+
+		It("should create the upgrade timed out alert", func() {
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			pr := &monitoringv1.PrometheusRule{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).Should(Succeed())
+
+			var found *monitoringv1.Rule
+			for i, rule := range pr.Spec.Groups[0].Rules {
+				if rule.Alert == upgradeTimedOutAlert {
+					found = &pr.Spec.Groups[0].Rules[i]
+				}
+			}
+
+			Expect(found).ToNot(BeNil())
+			Expect(found.Expr.String()).To(Equal("kubevirt_hco_operand_upgrade_timed_out == 1"))
+			Expect(found.For).To(BeNil())
+			Expect(found.Labels).To(HaveKeyWithValue(severityAlertLabelKey, "warning"))
+			Expect(found.Labels).To(HaveKeyWithValue(healthImpactAlertLabelKey, "warning"))
+			Expect(found.Annotations).To(HaveKeyWithValue("runbook_url", fmt.Sprintf(defaultRunbookURLTemplate, upgradeTimedOutAlert)))
+		})
+
+		It("should apply the alert overrides from the HyperConverged CR", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.Monitoring = &v1beta1.MonitoringConfig{
+				AlertOverrides: []v1beta1.AlertOverride{
+					{
+						AlertName: installationNotCompletedAlert,
+						Severity:  "warning",
+						For:       &metav1.Duration{Duration: 90 * time.Minute},
+					},
+					{
+						AlertName: outOfBandUpdateAlert,
+						For:       &metav1.Duration{Duration: 5 * time.Minute},
+					},
+				},
+			}
+			req.Instance = hco
+
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			pr := &monitoringv1.PrometheusRule{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).Should(Succeed())
+
+			rules := make(map[string]monitoringv1.Rule)
+			for _, rule := range pr.Spec.Groups[0].Rules {
+				rules[rule.Alert] = rule
+			}
+
+			Expect(rules[installationNotCompletedAlert].Labels).To(HaveKeyWithValue(severityAlertLabelKey, "warning"))
+			Expect(rules[installationNotCompletedAlert].For).To(HaveValue(BeEquivalentTo("1h30m")))
+			Expect(rules[outOfBandUpdateAlert].Labels).To(HaveKeyWithValue(severityAlertLabelKey, "warning"))
+			Expect(rules[outOfBandUpdateAlert].For).To(HaveValue(BeEquivalentTo("5m")))
+			Expect(rules[unsafeModificationAlert].Labels).To(HaveKeyWithValue(severityAlertLabelKey, "info"))
+			Expect(rules[unsafeModificationAlert].For).To(BeNil())
+
+			By("remove the threshold if the override duration is zero")
+			hco.Spec.Monitoring.AlertOverrides[0].For = &metav1.Duration{}
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).Should(Succeed())
+			for _, rule := range pr.Spec.Groups[0].Rules {
+				if rule.Alert == installationNotCompletedAlert {
+					Expect(rule.For).To(BeNil())
+					Expect(rule.Labels).To(HaveKeyWithValue(severityAlertLabelKey, "warning"))
+				}
+			}
+
+			By("restore the default values when the overrides are removed")
+			hco.Spec.Monitoring = nil
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).Should(Succeed())
+			Expect(pr.Spec).Should(Equal(*NewPrometheusRuleSpec()))
+		})
+
+		It("should remove the disabled alerts", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.Monitoring = &v1beta1.MonitoringConfig{
+				DisabledAlerts: []v1beta1.HCOAlertName{installationNotCompletedAlert, unsafeModificationAlert},
+			}
+			req.Instance = hco
+
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			pr := &monitoringv1.PrometheusRule{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).Should(Succeed())
+
+			Expect(pr.Spec.Groups[0].Rules).To(HaveLen(len(NewPrometheusRuleSpec().Groups[0].Rules) - 2))
+			Expect(pr.Spec.Groups[0].Rules).ToNot(ContainElement(HaveField("Alert", installationNotCompletedAlert)))
+			Expect(pr.Spec.Groups[0].Rules).ToNot(ContainElement(HaveField("Alert", unsafeModificationAlert)))
+			Expect(pr.Spec.Groups[0].Rules).To(ContainElement(HaveField("Alert", outOfBandUpdateAlert)))
+			Expect(pr.Spec.Groups[0].Rules).To(ContainElement(HaveField("Record", "cluster:vmi_request_cpu_cores:sum")))
+
+			By("restore the alerts when they are enabled again")
+			hco.Spec.Monitoring.DisabledAlerts = nil
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).Should(Succeed())
+			Expect(pr.Spec).Should(Equal(*NewPrometheusRuleSpec()))
+		})
+
+		It("should create the recording rule of the reconcile queue depth", func() {
+			rules := NewPrometheusRuleSpec().Groups[0].Rules
+
+			Expect(rules).To(ContainElement(And(
+				HaveField("Record", reconcileQueueDepthRecord),
+				HaveField("Expr", intstr.FromString(`max(workqueue_depth{name="hyperconverged-controller"})`)),
+			)))
+		})
+
+		It("should create the recording rules that aggregate the KubeVirt metrics", func() {
+			rules := NewPrometheusRuleSpec().Groups[0].Rules
+
+			for _, record := range []string{
+				"cluster:vmi_phase_count:sum",
+				"cluster:vmi_migration_success:ratio",
+				"cluster:vmi_vcpu:count",
+				"cluster:vmi_memory_domain_bytes:sum",
+			} {
+				Expect(rules).To(ContainElement(HaveField("Record", record)))
+			}
+
+			for _, rule := range rules {
+				if rule.Record != "" {
+					Expect(rule.Alert).To(BeEmpty())
+					Expect(rule.Labels).To(BeEmpty())
+					Expect(rule.Annotations).To(BeEmpty())
+				}
+			}
+		})
+
+		It("should ignore a wrong runbook URL template in the HyperConverged CR", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.Monitoring = &v1beta1.MonitoringConfig{RunbookURLTemplate: "wrong/runbookURL/template"}
+
+			Expect(getRunbookURLTemplate(hco, req.Logger)).To(Equal(defaultRunbookURLTemplate))
+		})
+
 		DescribeTable("test the OverwrittenModificationsCount", func(hcoTriggered, upgradeMode, firstLoop bool, expectedCountDelta float64) {
 			req.HCOTriggered = hcoTriggered
 			req.UpgradeMode = upgradeMode
@@ -378,6 +667,138 @@ var _ = Describe("alert tests", func() {
 		)
 	})
 
+	Context("test AlertmanagerConfig", func() {
+		var hco *v1beta1.HyperConverged
+
+		BeforeEach(func() {
+			hco = commontestutils.NewHco()
+			hco.Spec.Monitoring = &v1beta1.MonitoringConfig{
+				AlertRouting: &v1beta1.AlertRoutingConfig{
+					WebhookURL:   "https://alerts.example.com/hook",
+					SendResolved: ptr.To(true),
+				},
+			}
+			req = commontestutils.NewReq(hco)
+		})
+
+		getAlertmanagerConfig := func(cl client.Client) (*monitoringv1alpha1.AlertmanagerConfig, error) {
+			amc := &monitoringv1alpha1.AlertmanagerConfig{}
+			err := cl.Get(context.Background(), client.ObjectKey{Namespace: commontestutils.Namespace, Name: alertmanagerConfigName}, amc)
+			return amc, err
+		}
+
+		It("should not create the AlertmanagerConfig if the alert routing is not configured", func() {
+			hco.Spec.Monitoring = nil
+
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(alertmanagerConfigClusterInfo{}, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			_, err := getAlertmanagerConfig(cl)
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should create the AlertmanagerConfig if the alert routing is configured", func() {
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(alertmanagerConfigClusterInfo{}, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			amc, err := getAlertmanagerConfig(cl)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(amc.Labels).To(HaveKeyWithValue(hcoutil.AppLabelComponent, string(hcoutil.AppComponentMonitoring)))
+			Expect(amc.Spec.Route).ToNot(BeNil())
+			Expect(amc.Spec.Route.Receiver).To(Equal(alertReceiverName))
+			Expect(amc.Spec.Route.Matchers).To(ConsistOf(monitoringv1alpha1.Matcher{
+				Name:      componentAlertLabelKey,
+				Value:     componentAlertLabelValue,
+				MatchType: monitoringv1alpha1.MatchEqual,
+			}))
+			Expect(amc.Spec.Receivers).To(HaveLen(1))
+			Expect(amc.Spec.Receivers[0].Name).To(Equal(alertReceiverName))
+			Expect(amc.Spec.Receivers[0].WebhookConfigs).To(HaveLen(1))
+			Expect(amc.Spec.Receivers[0].WebhookConfigs[0].URL).To(HaveValue(Equal("https://alerts.example.com/hook")))
+			Expect(amc.Spec.Receivers[0].WebhookConfigs[0].URLSecret).To(BeNil())
+			Expect(amc.Spec.Receivers[0].WebhookConfigs[0].SendResolved).To(HaveValue(BeTrue()))
+		})
+
+		It("should update the AlertmanagerConfig if the alert routing was changed", func() {
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(alertmanagerConfigClusterInfo{}, cl, ee, commontestutils.GetScheme())
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+
+			hco.Spec.Monitoring.AlertRouting = &v1beta1.AlertRoutingConfig{
+				WebhookURLSecret: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "alert-receiver"},
+					Key:                  "url",
+				},
+			}
+			req = commontestutils.NewReq(hco)
+			req.HCOTriggered = true
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			amc, err := getAlertmanagerConfig(cl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(amc.Spec.Receivers[0].WebhookConfigs[0].URL).To(BeNil())
+			Expect(amc.Spec.Receivers[0].WebhookConfigs[0].URLSecret).To(HaveValue(HaveField("Key", "url")))
+			Expect(amc.Spec.Receivers[0].WebhookConfigs[0].SendResolved).To(BeNil())
+		})
+
+		It("should remove the AlertmanagerConfig if the alert routing was removed", func() {
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(alertmanagerConfigClusterInfo{}, cl, ee, commontestutils.GetScheme())
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			_, err := getAlertmanagerConfig(cl)
+			Expect(err).ToNot(HaveOccurred())
+
+			hco.Spec.Monitoring = nil
+			ee.Reset()
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			_, err = getAlertmanagerConfig(cl)
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+
+			Expect(ee.CheckEvents([]commontestutils.MockEvent{
+				{
+					EventType: corev1.EventTypeNormal,
+					Reason:    "Killing",
+					Msg:       "Removed AlertmanagerConfig " + alertmanagerConfigName,
+				},
+			})).To(BeTrue())
+		})
+
+		It("should not read the AlertmanagerConfig if its CRD was not available when HCO started", func() {
+			cl := commontestutils.InitClient([]client.Object{ns})
+			cl.InitiateGetErrors(func(key client.ObjectKey) error {
+				if key.Name == alertmanagerConfigName {
+					return errors.New("the AlertmanagerConfig should not be read")
+				}
+				return nil
+			})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			cl.InitiateGetErrors(nil)
+			_, err := getAlertmanagerConfig(cl)
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should do nothing if the AlertmanagerConfig CRD was removed", func() {
+			cl := commontestutils.InitClient([]client.Object{ns})
+			cl.InitiateGetErrors(func(key client.ObjectKey) error {
+				if key.Name == alertmanagerConfigName {
+					return &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: monitoringv1alpha1.SchemeGroupVersion.Group, Kind: monitoringv1alpha1.AlertmanagerConfigKind}}
+				}
+				return nil
+			})
+			r := NewMonitoringReconciler(alertmanagerConfigClusterInfo{}, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			cl.InitiateGetErrors(nil)
+			_, err := getAlertmanagerConfig(cl)
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
 	Context("test Role", func() {
 		BeforeEach(func() {
 			currentMetric, _ = metrics.HcoMetrics.GetOverwrittenModificationsCount("Role", roleName)
@@ -785,7 +1206,7 @@ var _ = Describe("alert tests", func() {
 			Expect(rb.Subjects).Should(HaveLen(1))
 			Expect(rb.Subjects[0].Kind).Should(Equal(rbacv1.ServiceAccountKind))
 			Expect(rb.Subjects[0].Name).Should(Equal("prometheus-k8s"))
-			Expect(rb.Subjects[0].Namespace).Should(Equal(getMonitoringNamespace(ci)))
+			Expect(rb.Subjects[0].Namespace).Should(Equal(ci.GetMonitoringNamespace()))
 
 			Expect(ee.CheckEvents(expectedEvents)).To(BeTrue())
 			Expect(metrics.HcoMetrics.GetOverwrittenModificationsCount("RoleBinding", roleName)).Should(BeEquivalentTo(currentMetric))
@@ -807,11 +1228,29 @@ var _ = Describe("alert tests", func() {
 			Expect(rb.Subjects).Should(HaveLen(1))
 			Expect(rb.Subjects[0].Kind).Should(Equal(rbacv1.ServiceAccountKind))
 			Expect(rb.Subjects[0].Name).Should(Equal("prometheus-k8s"))
-			Expect(rb.Subjects[0].Namespace).Should(Equal(getMonitoringNamespace(ci)))
+			Expect(rb.Subjects[0].Namespace).Should(Equal(ci.GetMonitoringNamespace()))
 
 			Expect(ee.CheckEvents(expectedEvents)).To(BeTrue())
 			Expect(metrics.HcoMetrics.GetOverwrittenModificationsCount("RoleBinding", roleName)).Should(BeEquivalentTo(currentMetric))
 		})
+
+		It("should bind the discovered monitoring ServiceAccount", func() {
+			uwmCI := userWorkloadMonitoringClusterInfo{}
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(uwmCI, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+
+			rb := &rbacv1.RoleBinding{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: roleName}, rb)).Should(Succeed())
+			Expect(rb.Subjects).Should(HaveLen(1))
+			Expect(rb.Subjects[0].Name).Should(Equal("prometheus-user-workload"))
+			Expect(rb.Subjects[0].Namespace).Should(Equal("openshift-user-workload-monitoring"))
+
+			np := &networkingv1.NetworkPolicy{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: networkPolicyName}, np)).Should(Succeed())
+			Expect(np.Spec.Ingress[0].From[0].NamespaceSelector.MatchLabels).Should(Equal(map[string]string{hcoutil.KubernetesMetadataName: "openshift-user-workload-monitoring"}))
+		})
 	})
 
 	Context("test Service", func() {
@@ -829,7 +1268,7 @@ var _ = Describe("alert tests", func() {
 
 		It("should update the labels if modified", func() {
 			owner := getDeploymentReference(ci.GetDeployment())
-			existSM := NewMetricsService(commontestutils.Namespace, owner)
+			existSM := NewMetricsService(commontestutils.Namespace, owner, true)
 			existSM.Labels = map[string]string{
 				"wrongKey1": "wrongValue1",
 				"wrongKey2": "wrongValue2",
@@ -850,7 +1289,7 @@ var _ = Describe("alert tests", func() {
 
 		It("should update the labels if it's missing", func() {
 			owner := getDeploymentReference(ci.GetDeployment())
-			existSM := NewMetricsService(commontestutils.Namespace, owner)
+			existSM := NewMetricsService(commontestutils.Namespace, owner, true)
 			existSM.Labels = nil
 
 			cl := commontestutils.InitClient([]client.Object{ns, existSM})
@@ -874,7 +1313,7 @@ var _ = Describe("alert tests", func() {
 				BlockOwnerDeletion: ptr.To(true),
 				UID:                "0987654321",
 			}
-			existSM := NewMetricsService(commontestutils.Namespace, owner)
+			existSM := NewMetricsService(commontestutils.Namespace, owner, true)
 			cl := commontestutils.InitClient([]client.Object{ns, existSM})
 			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
 
@@ -905,7 +1344,7 @@ var _ = Describe("alert tests", func() {
 				BlockOwnerDeletion: ptr.To(true),
 				UID:                "0987654321",
 			}
-			existSM := NewMetricsService(commontestutils.Namespace, owner)
+			existSM := NewMetricsService(commontestutils.Namespace, owner, true)
 			cl := commontestutils.InitClient([]client.Object{ns, existSM})
 			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
 
@@ -935,7 +1374,7 @@ var _ = Describe("alert tests", func() {
 
 		It("should update the referenceOwner if missing", func() {
 			owner := metav1.OwnerReference{}
-			existSM := NewMetricsService(commontestutils.Namespace, owner)
+			existSM := NewMetricsService(commontestutils.Namespace, owner, true)
 			existSM.OwnerReferences = nil
 			cl := commontestutils.InitClient([]client.Object{ns, existSM})
 			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
@@ -958,7 +1397,7 @@ var _ = Describe("alert tests", func() {
 
 		It("should update the Spec if modified", func() {
 			owner := getDeploymentReference(ci.GetDeployment())
-			existSM := NewMetricsService(commontestutils.Namespace, owner)
+			existSM := NewMetricsService(commontestutils.Namespace, owner, true)
 
 			existSM.Spec = corev1.ServiceSpec{
 				Ports: []corev1.ServicePort{
@@ -996,7 +1435,7 @@ var _ = Describe("alert tests", func() {
 
 		It("should update the Spec if it's missing", func() {
 			owner := getDeploymentReference(ci.GetDeployment())
-			existSM := NewMetricsService(commontestutils.Namespace, owner)
+			existSM := NewMetricsService(commontestutils.Namespace, owner, true)
 
 			existSM.Spec = corev1.ServiceSpec{}
 
@@ -1017,56 +1456,158 @@ var _ = Describe("alert tests", func() {
 		})
 	})
 
-	Context("test ServiceMonitor", func() {
-		BeforeEach(func() {
-			currentMetric, _ = metrics.HcoMetrics.GetOverwrittenModificationsCount("ServiceMonitor", serviceName)
-		})
-
+	Context("test the metrics Service IP families", func() {
 		expectedEvents := []commontestutils.MockEvent{
 			{
 				EventType: corev1.EventTypeNormal,
 				Reason:    "Updated",
-				Msg:       "Updated ServiceMonitor " + serviceName,
+				Msg:       "Updated Service " + serviceName,
 			},
 		}
 
-		It("should update the labels if modified", func() {
-			owner := getDeploymentReference(ci.GetDeployment())
-			existSM := NewServiceMonitor(commontestutils.Namespace, owner)
-			existSM.Labels = map[string]string{
-				"wrongKey1": "wrongValue1",
-				"wrongKey2": "wrongValue2",
-				"wrongKey3": "wrongValue3",
-			}
-
-			cl := commontestutils.InitClient([]client.Object{ns, existSM})
+		It("should prefer dual-stack, and let the API server allocate the IP families", func() {
+			cl := commontestutils.InitClient([]client.Object{ns})
 			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
 
 			Expect(r.Reconcile(req, false)).Should(Succeed())
-			sm := &monitoringv1.ServiceMonitor{}
-			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sm)).Should(Succeed())
-
-			Expect(sm.Labels).Should(Equal(hcoutil.GetLabels(hcoutil.HyperConvergedName, hcoutil.AppComponentMonitoring)))
-			Expect(ee.CheckEvents(expectedEvents)).To(BeTrue())
-			Expect(metrics.HcoMetrics.GetOverwrittenModificationsCount("ServiceMonitor", serviceName)).Should(BeEquivalentTo(currentMetric))
+			svc := &corev1.Service{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, svc)).Should(Succeed())
+			Expect(svc.Spec.IPFamilyPolicy).Should(HaveValue(Equal(corev1.IPFamilyPolicyPreferDualStack)))
+			Expect(svc.Spec.IPFamilies).Should(BeEmpty())
 		})
 
-		It("should update the labels if it's missing", func() {
+		DescribeTable("should keep the cluster IPs and the IP families allocated by the API server", func(clusterIPs []string, ipFamilies []corev1.IPFamily) {
 			owner := getDeploymentReference(ci.GetDeployment())
-			existSM := NewServiceMonitor(commontestutils.Namespace, owner)
-			existSM.Labels = nil
+			existSvc := NewMetricsService(commontestutils.Namespace, owner, true)
+			existSvc.Spec.ClusterIP = clusterIPs[0]
+			existSvc.Spec.ClusterIPs = clusterIPs
+			existSvc.Spec.IPFamilies = ipFamilies
 
-			cl := commontestutils.InitClient([]client.Object{ns, existSM})
+			cl := commontestutils.InitClient([]client.Object{ns, existSvc})
 			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
 
-			Expect(r.Reconcile(req, false)).Should(Succeed())
-			sm := &monitoringv1.ServiceMonitor{}
-			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sm)).Should(Succeed())
+			svc := &corev1.Service{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, svc)).Should(Succeed())
+			resourceVersion := svc.ResourceVersion
 
-			Expect(sm.Labels).Should(Equal(hcoutil.GetLabels(hcoutil.HyperConvergedName, hcoutil.AppComponentMonitoring)))
-			Expect(ee.CheckEvents(expectedEvents)).To(BeTrue())
-			Expect(metrics.HcoMetrics.GetOverwrittenModificationsCount("ServiceMonitor", serviceName)).Should(BeEquivalentTo(currentMetric))
-		})
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, svc)).Should(Succeed())
+			Expect(svc.ResourceVersion).Should(Equal(resourceVersion))
+			Expect(svc.Spec.ClusterIPs).Should(Equal(clusterIPs))
+			Expect(svc.Spec.IPFamilies).Should(Equal(ipFamilies))
+			Expect(ee.CheckEvents(expectedEvents)).To(BeFalse())
+		},
+			Entry("IPv4 single-stack", []string{"10.0.0.10"}, []corev1.IPFamily{corev1.IPv4Protocol}),
+			Entry("IPv6 single-stack", []string{"fd00::10"}, []corev1.IPFamily{corev1.IPv6Protocol}),
+			Entry("dual-stack", []string{"10.0.0.10", "fd00::10"}, []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}),
+			Entry("dual-stack, IPv6 first", []string{"fd00::10", "10.0.0.10"}, []corev1.IPFamily{corev1.IPv6Protocol, corev1.IPv4Protocol}),
+		)
+
+		It("should restore the IP family policy, and keep the allocated IPs", func() {
+			owner := getDeploymentReference(ci.GetDeployment())
+			existSvc := NewMetricsService(commontestutils.Namespace, owner, true)
+			singleStack := corev1.IPFamilyPolicySingleStack
+			existSvc.Spec.IPFamilyPolicy = &singleStack
+			existSvc.Spec.ClusterIP = "fd00::10"
+			existSvc.Spec.ClusterIPs = []string{"fd00::10"}
+			existSvc.Spec.IPFamilies = []corev1.IPFamily{corev1.IPv6Protocol}
+
+			cl := commontestutils.InitClient([]client.Object{ns, existSvc})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			svc := &corev1.Service{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, svc)).Should(Succeed())
+			Expect(svc.Spec.IPFamilyPolicy).Should(HaveValue(Equal(corev1.IPFamilyPolicyPreferDualStack)))
+			Expect(svc.Spec.ClusterIP).Should(Equal("fd00::10"))
+			Expect(svc.Spec.ClusterIPs).Should(Equal([]string{"fd00::10"}))
+			Expect(svc.Spec.IPFamilies).Should(Equal([]corev1.IPFamily{corev1.IPv6Protocol}))
+			Expect(ee.CheckEvents(expectedEvents)).To(BeTrue())
+		})
+	})
+
+	Context("test the metrics Service serving certificate", func() {
+		It("should request the serving certificate on OpenShift", func() {
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			svc := &corev1.Service{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, svc)).Should(Succeed())
+			Expect(svc.Annotations).Should(HaveKeyWithValue(servingCertSecretAnnotation, hcoutil.MetricsServingCertSecretName))
+		})
+
+		It("should restore the serving certificate annotation if missing", func() {
+			owner := getDeploymentReference(ci.GetDeployment())
+			existSvc := NewMetricsService(commontestutils.Namespace, owner, true)
+			existSvc.Annotations = map[string]string{"other": "annotation"}
+
+			cl := commontestutils.InitClient([]client.Object{ns, existSvc})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			svc := &corev1.Service{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, svc)).Should(Succeed())
+			Expect(svc.Annotations).Should(HaveKeyWithValue(servingCertSecretAnnotation, hcoutil.MetricsServingCertSecretName))
+			Expect(svc.Annotations).Should(HaveKeyWithValue("other", "annotation"))
+		})
+
+		It("should not request the serving certificate if not on OpenShift", func() {
+			svc := NewMetricsService(commontestutils.Namespace, getDeploymentReference(ci.GetDeployment()), false)
+			Expect(svc.Annotations).ShouldNot(HaveKey(servingCertSecretAnnotation))
+		})
+	})
+
+	Context("test ServiceMonitor", func() {
+		BeforeEach(func() {
+			currentMetric, _ = metrics.HcoMetrics.GetOverwrittenModificationsCount("ServiceMonitor", serviceName)
+		})
+
+		expectedEvents := []commontestutils.MockEvent{
+			{
+				EventType: corev1.EventTypeNormal,
+				Reason:    "Updated",
+				Msg:       "Updated ServiceMonitor " + serviceName,
+			},
+		}
+
+		It("should update the labels if modified", func() {
+			owner := getDeploymentReference(ci.GetDeployment())
+			existSM := NewServiceMonitor(commontestutils.Namespace, owner, true)
+			existSM.Labels = map[string]string{
+				"wrongKey1": "wrongValue1",
+				"wrongKey2": "wrongValue2",
+				"wrongKey3": "wrongValue3",
+			}
+
+			cl := commontestutils.InitClient([]client.Object{ns, existSM})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			sm := &monitoringv1.ServiceMonitor{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sm)).Should(Succeed())
+
+			Expect(sm.Labels).Should(Equal(hcoutil.GetLabels(hcoutil.HyperConvergedName, hcoutil.AppComponentMonitoring)))
+			Expect(ee.CheckEvents(expectedEvents)).To(BeTrue())
+			Expect(metrics.HcoMetrics.GetOverwrittenModificationsCount("ServiceMonitor", serviceName)).Should(BeEquivalentTo(currentMetric))
+		})
+
+		It("should update the labels if it's missing", func() {
+			owner := getDeploymentReference(ci.GetDeployment())
+			existSM := NewServiceMonitor(commontestutils.Namespace, owner, true)
+			existSM.Labels = nil
+
+			cl := commontestutils.InitClient([]client.Object{ns, existSM})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			sm := &monitoringv1.ServiceMonitor{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sm)).Should(Succeed())
+
+			Expect(sm.Labels).Should(Equal(hcoutil.GetLabels(hcoutil.HyperConvergedName, hcoutil.AppComponentMonitoring)))
+			Expect(ee.CheckEvents(expectedEvents)).To(BeTrue())
+			Expect(metrics.HcoMetrics.GetOverwrittenModificationsCount("ServiceMonitor", serviceName)).Should(BeEquivalentTo(currentMetric))
+		})
 
 		It("should update the referenceOwner if modified", func() {
 			owner := metav1.OwnerReference{
@@ -1077,7 +1618,7 @@ var _ = Describe("alert tests", func() {
 				BlockOwnerDeletion: ptr.To(true),
 				UID:                "0987654321",
 			}
-			existSM := NewServiceMonitor(commontestutils.Namespace, owner)
+			existSM := NewServiceMonitor(commontestutils.Namespace, owner, true)
 			cl := commontestutils.InitClient([]client.Object{ns, existSM})
 			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
 
@@ -1108,7 +1649,7 @@ var _ = Describe("alert tests", func() {
 				BlockOwnerDeletion: ptr.To(true),
 				UID:                "0987654321",
 			}
-			existSM := NewServiceMonitor(commontestutils.Namespace, owner)
+			existSM := NewServiceMonitor(commontestutils.Namespace, owner, true)
 			cl := commontestutils.InitClient([]client.Object{ns, existSM})
 			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
 
@@ -1138,7 +1679,7 @@ var _ = Describe("alert tests", func() {
 
 		It("should update the referenceOwner if missing", func() {
 			owner := metav1.OwnerReference{}
-			existSM := NewServiceMonitor(commontestutils.Namespace, owner)
+			existSM := NewServiceMonitor(commontestutils.Namespace, owner, true)
 			existSM.OwnerReferences = nil
 			cl := commontestutils.InitClient([]client.Object{ns, existSM})
 			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
@@ -1161,7 +1702,7 @@ var _ = Describe("alert tests", func() {
 
 		It("should update the Spec if modified", func() {
 			owner := getDeploymentReference(ci.GetDeployment())
-			existSM := NewServiceMonitor(commontestutils.Namespace, owner)
+			existSM := NewServiceMonitor(commontestutils.Namespace, owner, true)
 
 			existSM.Spec = monitoringv1.ServiceMonitorSpec{
 				Selector: metav1.LabelSelector{
@@ -1188,7 +1729,7 @@ var _ = Describe("alert tests", func() {
 
 		It("should update the Spec if it's missing", func() {
 			owner := getDeploymentReference(ci.GetDeployment())
-			existSM := NewServiceMonitor(commontestutils.Namespace, owner)
+			existSM := NewServiceMonitor(commontestutils.Namespace, owner, true)
 
 			existSM.Spec = monitoringv1.ServiceMonitorSpec{}
 
@@ -1204,6 +1745,657 @@ var _ = Describe("alert tests", func() {
 			Expect(ee.CheckEvents(expectedEvents)).To(BeTrue())
 			Expect(metrics.HcoMetrics.GetOverwrittenModificationsCount("ServiceMonitor", serviceName)).Should(BeEquivalentTo(currentMetric))
 		})
+
+		It("should scrape over HTTPS, and verify the serving certificate on OpenShift", func() {
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			sm := &monitoringv1.ServiceMonitor{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sm)).Should(Succeed())
+			Expect(sm.Spec.Endpoints).Should(HaveLen(1))
+			Expect(sm.Spec.Endpoints[0].Scheme).Should(Equal("https"))
+			Expect(sm.Spec.Endpoints[0].TLSConfig).ShouldNot(BeNil())
+			Expect(sm.Spec.Endpoints[0].TLSConfig.CAFile).Should(Equal(serviceCAFile))
+			Expect(sm.Spec.Endpoints[0].TLSConfig.ServerName).Should(Equal(serviceName + "." + commontestutils.Namespace + ".svc"))
+			Expect(sm.Spec.Endpoints[0].TLSConfig.InsecureSkipVerify).Should(BeFalse())
+		})
+
+		It("should scrape over HTTPS without verifying the self-signed certificate, if not on OpenShift", func() {
+			sm := NewServiceMonitor(commontestutils.Namespace, getDeploymentReference(ci.GetDeployment()), false)
+			Expect(sm.Spec.Endpoints).Should(HaveLen(1))
+			Expect(sm.Spec.Endpoints[0].Scheme).Should(Equal("https"))
+			Expect(sm.Spec.Endpoints[0].TLSConfig).ShouldNot(BeNil())
+			Expect(sm.Spec.Endpoints[0].TLSConfig.CAFile).Should(BeEmpty())
+			Expect(sm.Spec.Endpoints[0].TLSConfig.InsecureSkipVerify).Should(BeTrue())
+		})
+
+		It("should apply the scrape configuration from the HyperConverged CR", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.Monitoring = &v1beta1.MonitoringConfig{
+				ServiceMonitor: &v1beta1.ServiceMonitorConfig{
+					Interval:    &metav1.Duration{Duration: 2 * time.Minute},
+					HonorLabels: true,
+					MetricRelabelings: []v1beta1.MetricRelabelConfig{
+						{
+							SourceLabels: []string{"__name__"},
+							Regex:        "kubevirt_hco_.*_bucket",
+							Action:       "drop",
+						},
+						{
+							SourceLabels: []string{"pod"},
+							TargetLabel:  "instance",
+						},
+					},
+				},
+			}
+			req.Instance = hco
+
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			sm := &monitoringv1.ServiceMonitor{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sm)).Should(Succeed())
+			Expect(sm.Spec.Endpoints).Should(HaveLen(1))
+
+			endpoint := sm.Spec.Endpoints[0]
+			Expect(endpoint.Interval).Should(Equal(monitoringv1.Duration("2m")))
+			Expect(endpoint.HonorLabels).Should(BeTrue())
+			Expect(endpoint.MetricRelabelConfigs).Should(Equal([]*monitoringv1.RelabelConfig{
+				{
+					SourceLabels: []monitoringv1.LabelName{"__name__"},
+					Regex:        "kubevirt_hco_.*_bucket",
+					Action:       "drop",
+				},
+				{
+					SourceLabels: []monitoringv1.LabelName{"pod"},
+					TargetLabel:  "instance",
+					Action:       "replace",
+				},
+			}))
+
+			By("still enforcing the rest of the endpoint")
+			Expect(endpoint.Port).Should(Equal(operatorPortName))
+			Expect(endpoint.Scheme).Should(Equal("https"))
+
+			By("reset the scrape configuration when it is removed from the HyperConverged CR")
+			hco.Spec.Monitoring = nil
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sm)).Should(Succeed())
+			Expect(sm.Spec).Should(Equal(NewServiceMonitor(commontestutils.Namespace, getDeploymentReference(ci.GetDeployment()), true).Spec))
+		})
+
+		It("should not update the ServiceMonitor if the scrape configuration was not changed", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.Monitoring = &v1beta1.MonitoringConfig{
+				ServiceMonitor: &v1beta1.ServiceMonitorConfig{
+					Interval: &metav1.Duration{Duration: time.Minute},
+					MetricRelabelings: []v1beta1.MetricRelabelConfig{
+						{SourceLabels: []string{"pod"}, TargetLabel: "instance"},
+					},
+				},
+			}
+			req.Instance = hco
+
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			sm := &monitoringv1.ServiceMonitor{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sm)).Should(Succeed())
+			resourceVersion := sm.ResourceVersion
+
+			ee.Reset()
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sm)).Should(Succeed())
+			Expect(sm.ResourceVersion).Should(Equal(resourceVersion))
+			Expect(ee.CheckEvents(expectedEvents)).To(BeFalse())
+		})
+
+		It("should override a modified scrape configuration", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.Monitoring = &v1beta1.MonitoringConfig{
+				ServiceMonitor: &v1beta1.ServiceMonitorConfig{
+					Interval: &metav1.Duration{Duration: time.Minute},
+				},
+			}
+			req.Instance = hco
+
+			owner := getDeploymentReference(ci.GetDeployment())
+			existSM := NewServiceMonitor(commontestutils.Namespace, owner, true)
+			existSM.Spec.Endpoints[0].Interval = "5s"
+
+			cl := commontestutils.InitClient([]client.Object{ns, existSM})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			sm := &monitoringv1.ServiceMonitor{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sm)).Should(Succeed())
+			Expect(sm.Spec.Endpoints[0].Interval).Should(Equal(monitoringv1.Duration("1m")))
+			Expect(ee.CheckEvents(expectedEvents)).To(BeTrue())
+		})
+	})
+
+	Context("test ScrapeConfig", func() {
+		var scCI scrapeConfigClusterInfo
+
+		AfterEach(func() {
+			os.Unsetenv(metricsScrapeResourceEnv)
+		})
+
+		It("should not create the ScrapeConfig if the ScrapeConfig CRD is missing", func() {
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			sc := &monitoringv1alpha1.ScrapeConfig{}
+			err := cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sc)
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+
+			sm := &monitoringv1.ServiceMonitor{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sm)).Should(Succeed())
+		})
+
+		It("should keep the ServiceMonitor by default, even if the ScrapeConfig CRD is installed", func() {
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(scCI, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			sm := &monitoringv1.ServiceMonitor{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sm)).Should(Succeed())
+
+			sc := &monitoringv1alpha1.ScrapeConfig{}
+			err := cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sc)
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+
+			cm := &corev1.ConfigMap{}
+			err = cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: metricsCABundleName}, cm)
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should keep the ServiceMonitor if it is selected by the environment variable", func() {
+			os.Setenv(metricsScrapeResourceEnv, monitoringv1.ServiceMonitorsKind)
+
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(scCI, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			sm := &monitoringv1.ServiceMonitor{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sm)).Should(Succeed())
+
+			sc := &monitoringv1alpha1.ScrapeConfig{}
+			err := cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sc)
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		Context("when the ScrapeConfig is selected by the environment variable", func() {
+			BeforeEach(func() {
+				os.Setenv(metricsScrapeResourceEnv, "scrapeconfig")
+			})
+
+			It("should create the ScrapeConfig instead of the ServiceMonitor", func() {
+				cl := commontestutils.InitClient([]client.Object{ns})
+				r := NewMonitoringReconciler(scCI, cl, ee, commontestutils.GetScheme())
+
+				Expect(r.Reconcile(req, false)).Should(Succeed())
+				sc := &monitoringv1alpha1.ScrapeConfig{}
+				Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sc)).Should(Succeed())
+				Expect(sc.Spec.StaticConfigs).Should(HaveLen(1))
+				Expect(sc.Spec.StaticConfigs[0].Targets).Should(ConsistOf(
+					monitoringv1alpha1.Target(fmt.Sprintf("%s.%s.svc:%d", serviceName, r.namespace, hcoutil.MetricsPort)),
+				))
+				Expect(sc.Spec.StaticConfigs[0].Labels).Should(HaveKeyWithValue(monitoringv1.LabelName("namespace"), r.namespace))
+				Expect(sc.Spec.StaticConfigs[0].Labels).Should(HaveKeyWithValue(monitoringv1.LabelName("service"), serviceName))
+				Expect(sc.Spec.Scheme).Should(HaveValue(Equal("HTTPS")))
+
+				sm := &monitoringv1.ServiceMonitor{}
+				err := cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sm)
+				Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+
+				Expect(ee.CheckEvents([]commontestutils.MockEvent{
+					{
+						EventType: corev1.EventTypeNormal,
+						Reason:    "Created",
+						Msg:       "Created ScrapeConfig " + serviceName,
+					},
+				})).To(BeTrue())
+			})
+
+			It("should verify the serving certificate against the service CA on OpenShift", func() {
+				cl := commontestutils.InitClient([]client.Object{ns})
+				r := NewMonitoringReconciler(scCI, cl, ee, commontestutils.GetScheme())
+
+				Expect(r.Reconcile(req, false)).Should(Succeed())
+				sc := &monitoringv1alpha1.ScrapeConfig{}
+				Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sc)).Should(Succeed())
+				Expect(sc.Spec.TLSConfig).ShouldNot(BeNil())
+				Expect(sc.Spec.TLSConfig.InsecureSkipVerify).Should(BeFalse())
+				Expect(sc.Spec.TLSConfig.ServerName).Should(Equal(fmt.Sprintf("%s.%s.svc", serviceName, r.namespace)))
+				Expect(sc.Spec.TLSConfig.CA.ConfigMap).ShouldNot(BeNil())
+				Expect(sc.Spec.TLSConfig.CA.ConfigMap.Name).Should(Equal(metricsCABundleName))
+				Expect(sc.Spec.TLSConfig.CA.ConfigMap.Key).Should(Equal(serviceCABundleKey))
+
+				cm := &corev1.ConfigMap{}
+				Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: metricsCABundleName}, cm)).Should(Succeed())
+				Expect(cm.Annotations).Should(HaveKeyWithValue(injectCABundleAnnotation, "true"))
+
+				Expect(ee.CheckEvents([]commontestutils.MockEvent{
+					{
+						EventType: corev1.EventTypeNormal,
+						Reason:    "Created",
+						Msg:       "Created ConfigMap " + metricsCABundleName,
+					},
+				})).To(BeTrue())
+			})
+
+			It("should not verify the self-signed serving certificate on Kubernetes", func() {
+				cl := commontestutils.InitClient([]client.Object{ns})
+				r := NewMonitoringReconciler(kubernetesScrapeConfigClusterInfo{}, cl, ee, commontestutils.GetScheme())
+
+				Expect(r.Reconcile(req, false)).Should(Succeed())
+				sc := &monitoringv1alpha1.ScrapeConfig{}
+				Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sc)).Should(Succeed())
+				Expect(sc.Spec.TLSConfig).ShouldNot(BeNil())
+				Expect(sc.Spec.TLSConfig.InsecureSkipVerify).Should(BeTrue())
+
+				cm := &corev1.ConfigMap{}
+				err := cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: metricsCABundleName}, cm)
+				Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+			})
+
+			It("should keep the injected CA bundle, and restore the inject annotation", func() {
+				owner := getDeploymentReference(ci.GetDeployment())
+				existCM := NewMetricsCABundle(commontestutils.Namespace, owner)
+				delete(existCM.Annotations, injectCABundleAnnotation)
+				existCM.Data = map[string]string{serviceCABundleKey: "the CA bundle"}
+
+				cl := commontestutils.InitClient([]client.Object{ns, existCM})
+				r := NewMonitoringReconciler(scCI, cl, ee, commontestutils.GetScheme())
+
+				Expect(r.Reconcile(req, false)).Should(Succeed())
+				cm := &corev1.ConfigMap{}
+				Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: metricsCABundleName}, cm)).Should(Succeed())
+				Expect(cm.Annotations).Should(HaveKeyWithValue(injectCABundleAnnotation, "true"))
+				Expect(cm.Data).Should(HaveKeyWithValue(serviceCABundleKey, "the CA bundle"))
+			})
+
+			It("should remove the CA bundle ConfigMap when switching back to the ServiceMonitor", func() {
+				owner := getDeploymentReference(ci.GetDeployment())
+				existCM := NewMetricsCABundle(commontestutils.Namespace, owner)
+				existSC := NewScrapeConfig(commontestutils.Namespace, owner, true)
+
+				os.Unsetenv(metricsScrapeResourceEnv)
+				cl := commontestutils.InitClient([]client.Object{ns, existCM, existSC})
+				r := NewMonitoringReconciler(scCI, cl, ee, commontestutils.GetScheme())
+
+				Expect(r.Reconcile(req, false)).Should(Succeed())
+				cm := &corev1.ConfigMap{}
+				err := cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: metricsCABundleName}, cm)
+				Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+
+				sc := &monitoringv1alpha1.ScrapeConfig{}
+				err = cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sc)
+				Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+			})
+
+			It("should remove the ServiceMonitor when switching to the ScrapeConfig", func() {
+				owner := getDeploymentReference(ci.GetDeployment())
+				existSM := NewServiceMonitor(commontestutils.Namespace, owner, true)
+
+				cl := commontestutils.InitClient([]client.Object{ns, existSM})
+				r := NewMonitoringReconciler(scCI, cl, ee, commontestutils.GetScheme())
+
+				Expect(r.Reconcile(req, false)).Should(Succeed())
+				sm := &monitoringv1.ServiceMonitor{}
+				err := cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sm)
+				Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+
+				Expect(ee.CheckEvents([]commontestutils.MockEvent{
+					{
+						EventType: corev1.EventTypeNormal,
+						Reason:    "Killing",
+						Msg:       "Removed ServiceMonitor " + serviceName,
+					},
+				})).To(BeTrue())
+			})
+
+			It("should apply the scrape configuration from the HyperConverged CR", func() {
+				hco := commontestutils.NewHco()
+				hco.Spec.Monitoring = &v1beta1.MonitoringConfig{
+					ServiceMonitor: &v1beta1.ServiceMonitorConfig{
+						Interval:    &metav1.Duration{Duration: time.Minute},
+						HonorLabels: true,
+						MetricRelabelings: []v1beta1.MetricRelabelConfig{
+							{
+								SourceLabels: []string{"__name__"},
+								Regex:        "kubevirt_hco_.*",
+								Action:       "keep",
+							},
+						},
+					},
+				}
+				req.Instance = hco
+
+				cl := commontestutils.InitClient([]client.Object{ns})
+				r := NewMonitoringReconciler(scCI, cl, ee, commontestutils.GetScheme())
+
+				Expect(r.Reconcile(req, false)).Should(Succeed())
+				sc := &monitoringv1alpha1.ScrapeConfig{}
+				Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sc)).Should(Succeed())
+				Expect(sc.Spec.ScrapeInterval).Should(HaveValue(Equal(monitoringv1.Duration("1m"))))
+				Expect(sc.Spec.HonorLabels).Should(HaveValue(BeTrue()))
+				Expect(sc.Spec.MetricRelabelConfigs).Should(HaveLen(1))
+				Expect(sc.Spec.MetricRelabelConfigs[0].SourceLabels).Should(Equal([]monitoringv1.LabelName{"__name__"}))
+				Expect(sc.Spec.MetricRelabelConfigs[0].Action).Should(Equal("keep"))
+
+				By("reset the fields when the configuration is removed")
+				hco.Spec.Monitoring = nil
+				Expect(r.Reconcile(req, false)).Should(Succeed())
+				Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sc)).Should(Succeed())
+				Expect(sc.Spec.ScrapeInterval).Should(BeNil())
+				Expect(sc.Spec.HonorLabels).Should(BeNil())
+				Expect(sc.Spec.MetricRelabelConfigs).Should(BeNil())
+			})
+
+			It("should update the ScrapeConfig if modified", func() {
+				owner := getDeploymentReference(ci.GetDeployment())
+				existSC := NewScrapeConfig(commontestutils.Namespace, owner, true)
+				existSC.Spec.StaticConfigs[0].Targets = []monitoringv1alpha1.Target{"wrong.target:1234"}
+				existSC.Spec.TLSConfig = &monitoringv1.SafeTLSConfig{InsecureSkipVerify: true}
+
+				cl := commontestutils.InitClient([]client.Object{ns, NewMetricsCABundle(commontestutils.Namespace, owner), existSC})
+				r := NewMonitoringReconciler(scCI, cl, ee, commontestutils.GetScheme())
+
+				req.HCOTriggered = false
+				Expect(r.Reconcile(req, false)).Should(Succeed())
+				sc := &monitoringv1alpha1.ScrapeConfig{}
+				Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sc)).Should(Succeed())
+				Expect(sc.Spec).Should(Equal(NewScrapeConfig(commontestutils.Namespace, owner, true).Spec))
+
+				Expect(ee.CheckEvents([]commontestutils.MockEvent{
+					{
+						EventType: corev1.EventTypeWarning,
+						Reason:    "Overwritten",
+						Msg:       "Overwritten ScrapeConfig " + serviceName,
+					},
+				})).To(BeTrue())
+			})
+
+			It("should create the ScrapeConfig even if the CRD was not detected at start", func() {
+				cl := commontestutils.InitClient([]client.Object{ns})
+				r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+				Expect(r.Reconcile(req, false)).Should(Succeed())
+				sc := &monitoringv1alpha1.ScrapeConfig{}
+				Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sc)).Should(Succeed())
+			})
+		})
+	})
+
+	Context("test PodMonitor", func() {
+		BeforeEach(func() {
+			os.Setenv(metricsScrapeResourceEnv, monitoringv1.PodMonitorsKind)
+			currentMetric, _ = metrics.HcoMetrics.GetOverwrittenModificationsCount(monitoringv1.PodMonitorsKind, serviceName)
+		})
+
+		AfterEach(func() {
+			os.Unsetenv(metricsScrapeResourceEnv)
+		})
+
+		expectedEvents := []commontestutils.MockEvent{
+			{
+				EventType: corev1.EventTypeNormal,
+				Reason:    "Updated",
+				Msg:       "Updated PodMonitor " + serviceName,
+			},
+		}
+
+		It("should not create the PodMonitor by default", func() {
+			os.Unsetenv(metricsScrapeResourceEnv)
+
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			pm := &monitoringv1.PodMonitor{}
+			err := cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, pm)
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should create the PodMonitor instead of the ServiceMonitor", func() {
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			pm := &monitoringv1.PodMonitor{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, pm)).Should(Succeed())
+			Expect(pm.Spec.Selector).Should(Equal(metav1.LabelSelector{MatchLabels: getMetricsPodSelector()}))
+			Expect(pm.Spec.PodMetricsEndpoints).Should(HaveLen(1))
+			Expect(pm.Spec.PodMetricsEndpoints[0].Port).Should(Equal(hcoutil.MetricsPortName))
+			Expect(pm.Spec.PodMetricsEndpoints[0].Scheme).Should(Equal("https"))
+			Expect(pm.Spec.PodMetricsEndpoints[0].TLSConfig).ShouldNot(BeNil())
+			Expect(pm.Spec.PodMetricsEndpoints[0].TLSConfig.InsecureSkipVerify).Should(BeTrue())
+
+			sm := &monitoringv1.ServiceMonitor{}
+			err := cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sm)
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+
+			Expect(ee.CheckEvents([]commontestutils.MockEvent{
+				{
+					EventType: corev1.EventTypeNormal,
+					Reason:    "Created",
+					Msg:       "Created PodMonitor " + serviceName,
+				},
+			})).To(BeTrue())
+		})
+
+		It("should remove the ServiceMonitor when switching to the PodMonitor", func() {
+			owner := getDeploymentReference(ci.GetDeployment())
+			existSM := NewServiceMonitor(commontestutils.Namespace, owner, true)
+
+			cl := commontestutils.InitClient([]client.Object{ns, existSM})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			sm := &monitoringv1.ServiceMonitor{}
+			err := cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, sm)
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+
+			Expect(ee.CheckEvents([]commontestutils.MockEvent{
+				{
+					EventType: corev1.EventTypeNormal,
+					Reason:    "Killing",
+					Msg:       "Removed ServiceMonitor " + serviceName,
+				},
+			})).To(BeTrue())
+		})
+
+		It("should update the labels if modified", func() {
+			owner := getDeploymentReference(ci.GetDeployment())
+			existPM := NewPodMonitor(commontestutils.Namespace, owner)
+			existPM.Labels = map[string]string{
+				"wrongKey1": "wrongValue1",
+				"wrongKey2": "wrongValue2",
+			}
+
+			cl := commontestutils.InitClient([]client.Object{ns, existPM})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			pm := &monitoringv1.PodMonitor{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, pm)).Should(Succeed())
+
+			Expect(pm.Labels).Should(Equal(hcoutil.GetLabels(hcoutil.HyperConvergedName, hcoutil.AppComponentMonitoring)))
+			Expect(ee.CheckEvents(expectedEvents)).To(BeTrue())
+			Expect(metrics.HcoMetrics.GetOverwrittenModificationsCount(monitoringv1.PodMonitorsKind, serviceName)).Should(BeEquivalentTo(currentMetric))
+		})
+
+		It("should update the referenceOwner if modified; no HCO triggered", func() {
+			req.HCOTriggered = false
+
+			owner := metav1.OwnerReference{
+				APIVersion:         "wrongAPIVersion",
+				Kind:               "wrongKind",
+				Name:               "wrongName",
+				Controller:         ptr.To(true),
+				BlockOwnerDeletion: ptr.To(true),
+				UID:                "0987654321",
+			}
+			existPM := NewPodMonitor(commontestutils.Namespace, owner)
+			cl := commontestutils.InitClient([]client.Object{ns, existPM})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			pm := &monitoringv1.PodMonitor{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, pm)).Should(Succeed())
+
+			deployment := ci.GetDeployment()
+
+			Expect(pm.OwnerReferences).Should(HaveLen(1))
+			Expect(pm.OwnerReferences[0].Name).Should(Equal(deployment.Name))
+			Expect(pm.OwnerReferences[0].Kind).Should(Equal("Deployment"))
+			Expect(pm.OwnerReferences[0].UID).Should(Equal(deployment.UID))
+
+			Expect(ee.CheckEvents([]commontestutils.MockEvent{
+				{
+					EventType: corev1.EventTypeWarning,
+					Reason:    "Overwritten",
+					Msg:       "Overwritten PodMonitor " + serviceName,
+				},
+			})).To(BeTrue())
+			Expect(metrics.HcoMetrics.GetOverwrittenModificationsCount(monitoringv1.PodMonitorsKind, serviceName)).Should(BeEquivalentTo(currentMetric + 1))
+		})
+
+		It("should update the Spec if modified", func() {
+			owner := getDeploymentReference(ci.GetDeployment())
+			existPM := NewPodMonitor(commontestutils.Namespace, owner)
+
+			existPM.Spec = monitoringv1.PodMonitorSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"wrongKey1": "wrongValue1",
+					},
+				},
+				PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{{Port: "wrongPort", Scheme: "http"}},
+			}
+
+			cl := commontestutils.InitClient([]client.Object{ns, existPM})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			pm := &monitoringv1.PodMonitor{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: serviceName}, pm)).Should(Succeed())
+			Expect(pm.Spec).Should(Equal(NewPodMonitor(commontestutils.Namespace, owner).Spec))
+
+			Expect(ee.CheckEvents(expectedEvents)).To(BeTrue())
+			Expect(metrics.HcoMetrics.GetOverwrittenModificationsCount(monitoringv1.PodMonitorsKind, serviceName)).Should(BeEquivalentTo(currentMetric))
+		})
+	})
+
+	Context("test NetworkPolicy", func() {
+		BeforeEach(func() {
+			currentMetric, _ = metrics.HcoMetrics.GetOverwrittenModificationsCount("NetworkPolicy", networkPolicyName)
+		})
+
+		expectedEvents := []commontestutils.MockEvent{
+			{
+				EventType: corev1.EventTypeNormal,
+				Reason:    "Updated",
+				Msg:       "Updated NetworkPolicy " + networkPolicyName,
+			},
+		}
+
+		It("should only allow the monitoring namespace to reach the metrics port", func() {
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			np := &networkingv1.NetworkPolicy{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: networkPolicyName}, np)).Should(Succeed())
+
+			Expect(np.Spec.PodSelector.MatchLabels).Should(Equal(map[string]string{"name": defaultOperatorName}))
+			Expect(np.Spec.PolicyTypes).Should(ConsistOf(networkingv1.PolicyTypeIngress))
+			Expect(np.Spec.Ingress).Should(HaveLen(1))
+			Expect(np.Spec.Ingress[0].From).Should(HaveLen(1))
+			Expect(np.Spec.Ingress[0].From[0].NamespaceSelector.MatchLabels).Should(Equal(map[string]string{hcoutil.KubernetesMetadataName: "openshift-monitoring"}))
+			Expect(np.Spec.Ingress[0].Ports).Should(HaveLen(1))
+			Expect(np.Spec.Ingress[0].Ports[0].Port.IntVal).Should(Equal(hcoutil.MetricsPort))
+			Expect(*np.Spec.Ingress[0].Ports[0].Protocol).Should(Equal(corev1.ProtocolTCP))
+		})
+
+		It("should update the labels if modified", func() {
+			owner := getDeploymentReference(ci.GetDeployment())
+			existNP := newMetricsNetworkPolicy(commontestutils.Namespace, owner, ci)
+			existNP.Labels = map[string]string{
+				"wrongKey1": "wrongValue1",
+			}
+
+			cl := commontestutils.InitClient([]client.Object{ns, existNP})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			np := &networkingv1.NetworkPolicy{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: networkPolicyName}, np)).Should(Succeed())
+
+			Expect(np.Labels).Should(Equal(hcoutil.GetLabels(hcoutil.HyperConvergedName, hcoutil.AppComponentMonitoring)))
+			Expect(ee.CheckEvents(expectedEvents)).To(BeTrue())
+			Expect(metrics.HcoMetrics.GetOverwrittenModificationsCount("NetworkPolicy", networkPolicyName)).Should(BeEquivalentTo(currentMetric))
+		})
+
+		It("should update the referenceOwner if modified", func() {
+			owner := metav1.OwnerReference{
+				APIVersion:         "wrongAPIVersion",
+				Kind:               "wrongKind",
+				Name:               "wrongName",
+				Controller:         ptr.To(true),
+				BlockOwnerDeletion: ptr.To(true),
+				UID:                "0987654321",
+			}
+			existNP := newMetricsNetworkPolicy(commontestutils.Namespace, owner, ci)
+			cl := commontestutils.InitClient([]client.Object{ns, existNP})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			np := &networkingv1.NetworkPolicy{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: networkPolicyName}, np)).Should(Succeed())
+
+			deployment := ci.GetDeployment()
+
+			Expect(np.OwnerReferences).Should(HaveLen(1))
+			Expect(np.OwnerReferences[0].Name).Should(Equal(deployment.Name))
+			Expect(np.OwnerReferences[0].Kind).Should(Equal("Deployment"))
+			Expect(np.OwnerReferences[0].UID).Should(Equal(deployment.UID))
+
+			Expect(ee.CheckEvents(expectedEvents)).To(BeTrue())
+		})
+
+		It("should update the Spec if modified; No HCO triggered", func() {
+			req.HCOTriggered = false
+
+			owner := getDeploymentReference(ci.GetDeployment())
+			existNP := newMetricsNetworkPolicy(commontestutils.Namespace, owner, ci)
+			existNP.Spec.Ingress = []networkingv1.NetworkPolicyIngressRule{{}} // allow all
+
+			cl := commontestutils.InitClient([]client.Object{ns, existNP})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).Should(Succeed())
+			np := &networkingv1.NetworkPolicy{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: networkPolicyName}, np)).Should(Succeed())
+
+			Expect(np.Spec).Should(Equal(newMetricsNetworkPolicy(commontestutils.Namespace, owner, ci).Spec))
+
+			overrideExpectedEvents := []commontestutils.MockEvent{
+				{
+					EventType: corev1.EventTypeWarning,
+					Reason:    "Overwritten",
+					Msg:       "Overwritten NetworkPolicy " + networkPolicyName,
+				},
+			}
+			Expect(ee.CheckEvents(overrideExpectedEvents)).To(BeTrue())
+			Expect(metrics.HcoMetrics.GetOverwrittenModificationsCount("NetworkPolicy", networkPolicyName)).Should(BeEquivalentTo(currentMetric + 1))
+		})
 	})
 
 	Context("test Namespace", func() {
@@ -1315,3 +2507,39 @@ var _ = Describe("alert tests", func() {
 		})
 	})
 })
+
+type scrapeConfigClusterInfo struct {
+	commontestutils.ClusterInfoMock
+}
+
+func (scrapeConfigClusterInfo) IsScrapeConfigAvailable() bool {
+	return true
+}
+
+type alertmanagerConfigClusterInfo struct {
+	commontestutils.ClusterInfoMock
+}
+
+func (alertmanagerConfigClusterInfo) IsAlertmanagerConfigAvailable() bool {
+	return true
+}
+
+type kubernetesScrapeConfigClusterInfo struct {
+	scrapeConfigClusterInfo
+}
+
+func (kubernetesScrapeConfigClusterInfo) IsOpenshift() bool {
+	return false
+}
+
+type userWorkloadMonitoringClusterInfo struct {
+	commontestutils.ClusterInfoMock
+}
+
+func (userWorkloadMonitoringClusterInfo) GetMonitoringNamespace() string {
+	return "openshift-user-workload-monitoring"
+}
+
+func (userWorkloadMonitoringClusterInfo) GetMonitoringServiceAccount() string {
+	return "prometheus-user-workload"
+}
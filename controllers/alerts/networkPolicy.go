@@ -0,0 +1,110 @@
+package alerts
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const networkPolicyName = operatorName + "-allow-metrics"
+
+// networkPolicyReconciler maintains a NetworkPolicy that only allows the monitoring namespace to reach the HCO
+// metrics endpoint
+type networkPolicyReconciler struct {
+	theNetworkPolicy *networkingv1.NetworkPolicy
+}
+
+func newNetworkPolicyReconciler(namespace string, owner metav1.OwnerReference, ci hcoutil.ClusterInfo) *networkPolicyReconciler {
+	return &networkPolicyReconciler{
+		theNetworkPolicy: newMetricsNetworkPolicy(namespace, owner, ci),
+	}
+}
+
+func (r *networkPolicyReconciler) Kind() string {
+	return "NetworkPolicy"
+}
+
+func (r *networkPolicyReconciler) ResourceName() string {
+	return networkPolicyName
+}
+
+func (r *networkPolicyReconciler) GetFullResource() client.Object {
+	return r.theNetworkPolicy.DeepCopy()
+}
+
+func (r *networkPolicyReconciler) EmptyObject() client.Object {
+	return &networkingv1.NetworkPolicy{}
+}
+
+func (r *networkPolicyReconciler) UpdateExistingResource(ctx context.Context, cl client.Client, resource client.Object, logger logr.Logger) (client.Object, bool, error) {
+	found := resource.(*networkingv1.NetworkPolicy)
+	modified := false
+	if !reflect.DeepEqual(found.Spec, r.theNetworkPolicy.Spec) {
+		r.theNetworkPolicy.Spec.DeepCopyInto(&found.Spec)
+		modified = true
+	}
+
+	modified = updateCommonDetails(&r.theNetworkPolicy.ObjectMeta, &found.ObjectMeta) || modified
+
+	if modified {
+		err := cl.Update(ctx, found)
+		if err != nil {
+			logger.Error(err, "failed to update the NetworkPolicy")
+			return nil, false, err
+		}
+		logger.Info("successfully updated the NetworkPolicy")
+	}
+
+	return found, modified, nil
+}
+
+func newMetricsNetworkPolicy(namespace string, owner metav1.OwnerReference, ci hcoutil.ClusterInfo) *networkingv1.NetworkPolicy {
+	protocol := corev1.ProtocolTCP
+	port := intstr.FromInt32(hcoutil.MetricsPort)
+
+	return &networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: networkingv1.SchemeGroupVersion.String(),
+			Kind:       "NetworkPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            networkPolicyName,
+			Namespace:       namespace,
+			Labels:          hcoutil.GetLabels(hcoutil.HyperConvergedName, hcoutil.AppComponentMonitoring),
+			OwnerReferences: []metav1.OwnerReference{owner},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: getMetricsPodSelector(),
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{
+				{
+					From: []networkingv1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									hcoutil.KubernetesMetadataName: ci.GetMonitoringNamespace(),
+								},
+							},
+						},
+					},
+					Ports: []networkingv1.NetworkPolicyPort{
+						{
+							Protocol: &protocol,
+							Port:     &port,
+						},
+					},
+				},
+			},
+		},
+	}
+}
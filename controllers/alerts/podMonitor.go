@@ -0,0 +1,103 @@
+package alerts
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// podMonitorReconciler maintains a PodMonitor that scrapes the HCO operator pod directly. It is only used instead of
+// the ServiceMonitor, if selected by the METRICS_SCRAPE_RESOURCE environment variable.
+type podMonitorReconciler struct {
+	thePodMonitor *monitoringv1.PodMonitor
+	required      bool
+}
+
+func newPodMonitorReconciler(namespace string, owner metav1.OwnerReference, scrapeResource string) *podMonitorReconciler {
+	return &podMonitorReconciler{
+		thePodMonitor: NewPodMonitor(namespace, owner),
+		required:      scrapeResource == monitoringv1.PodMonitorsKind,
+	}
+}
+
+func (r podMonitorReconciler) isRequired() bool {
+	return r.required
+}
+
+func (r podMonitorReconciler) Kind() string {
+	return monitoringv1.PodMonitorsKind
+}
+
+func (r podMonitorReconciler) ResourceName() string {
+	return serviceName
+}
+
+func (r podMonitorReconciler) GetFullResource() client.Object {
+	return r.thePodMonitor.DeepCopy()
+}
+
+func (r podMonitorReconciler) EmptyObject() client.Object {
+	return &monitoringv1.PodMonitor{}
+}
+
+func (r podMonitorReconciler) UpdateExistingResource(ctx context.Context, cl client.Client, resource client.Object, logger logr.Logger) (client.Object, bool, error) {
+	found := resource.(*monitoringv1.PodMonitor)
+	modified := false
+	if !reflect.DeepEqual(found.Spec, r.thePodMonitor.Spec) {
+		r.thePodMonitor.Spec.DeepCopyInto(&found.Spec)
+		modified = true
+	}
+
+	modified = updateCommonDetails(&r.thePodMonitor.ObjectMeta, &found.ObjectMeta) || modified
+
+	if modified {
+		err := cl.Update(ctx, found)
+		if err != nil {
+			logger.Error(err, "failed to update the PodMonitor")
+			return nil, false, err
+		}
+		logger.Info("successfully updated the PodMonitor")
+	}
+	return found, modified, nil
+}
+
+// NewPodMonitor returns a PodMonitor that scrapes the metrics port of the HCO operator pod. The serving certificate
+// is not verified, because it is issued for the metrics service, and not for the pod.
+func NewPodMonitor(namespace string, owner metav1.OwnerReference) *monitoringv1.PodMonitor {
+	spec := monitoringv1.PodMonitorSpec{
+		Selector: metav1.LabelSelector{
+			MatchLabels: getMetricsPodSelector(),
+		},
+		PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{
+			{
+				Port:   hcoutil.MetricsPortName,
+				Scheme: "https",
+				TLSConfig: &monitoringv1.PodMetricsEndpointTLSConfig{
+					SafeTLSConfig: monitoringv1.SafeTLSConfig{
+						InsecureSkipVerify: true,
+					},
+				},
+			},
+		},
+	}
+
+	return &monitoringv1.PodMonitor{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1.PodMonitorsKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            serviceName,
+			Labels:          hcoutil.GetLabels(hcoutil.HyperConvergedName, hcoutil.AppComponentMonitoring),
+			Namespace:       namespace,
+			OwnerReferences: []metav1.OwnerReference{owner},
+		},
+		Spec: spec,
+	}
+}
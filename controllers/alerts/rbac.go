@@ -13,10 +13,8 @@ import (
 )
 
 const (
-	operatorName                 = "hyperconverged-cluster-operator"
-	roleName                     = operatorName + "-metrics"
-	defaultMonitoringNamespace   = "monitoring"
-	openshiftMonitoringNamespace = "openshift-monitoring"
+	operatorName = "hyperconverged-cluster-operator"
+	roleName     = operatorName + "-metrics"
 )
 
 // RoleReconciler maintains an RBAC Role to allow Prometheus operator to read from HCO metric
@@ -183,17 +181,9 @@ func newRoleBinding(owner metav1.OwnerReference, namespace string, ci hcoutil.Cl
 		Subjects: []rbacv1.Subject{
 			{
 				Kind:      rbacv1.ServiceAccountKind,
-				Name:      "prometheus-k8s",
-				Namespace: getMonitoringNamespace(ci),
+				Name:      ci.GetMonitoringServiceAccount(),
+				Namespace: ci.GetMonitoringNamespace(),
 			},
 		},
 	}
 }
-
-func getMonitoringNamespace(ci hcoutil.ClusterInfo) string {
-	if ci.IsOpenshift() {
-		return openshiftMonitoringNamespace
-	}
-
-	return defaultMonitoringNamespace
-}
@@ -9,11 +9,13 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/metrics"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
@@ -27,6 +29,18 @@ type MetricReconciler interface {
 	UpdateExistingResource(context.Context, client.Client, client.Object, logr.Logger) (client.Object, bool, error)
 }
 
+// hcAwareReconciler is implemented by the metric reconcilers that are affected by the HyperConverged CR. The
+// HyperConverged CR is nil if it does not exist.
+type hcAwareReconciler interface {
+	setHyperConverged(hc *hcov1beta1.HyperConverged, logger logr.Logger)
+}
+
+// optionalReconciler is implemented by the metric reconcilers of resources that are not always required. The kind of
+// these resources may also be missing from the cluster.
+type optionalReconciler interface {
+	isRequired() bool
+}
+
 type MonitoringReconciler struct {
 	reconcilers   []MetricReconciler
 	scheme        *runtime.Scheme
@@ -40,15 +54,28 @@ func NewMonitoringReconciler(ci hcoutil.ClusterInfo, cl client.Client, ee hcouti
 	deployment := ci.GetDeployment()
 	namespace := deployment.Namespace
 	owner := getDeploymentReference(deployment)
+	scrapeResource := getMetricsScrapeResource()
+
+	reconcilers := []MetricReconciler{
+		newAlertRuleReconciler(namespace, owner),
+		newRoleReconciler(namespace, owner),
+		newRoleBindingReconciler(namespace, owner, ci),
+		newMetricServiceReconciler(namespace, owner, ci.IsOpenshift()),
+		newServiceMonitorReconciler(namespace, owner, ci.IsOpenshift(), scrapeResource),
+		newPodMonitorReconciler(namespace, owner, scrapeResource),
+		newMetricsCABundleReconciler(namespace, owner, ci.IsOpenshift(), scrapeResource),
+		newScrapeConfigReconciler(namespace, owner, ci.IsOpenshift(), scrapeResource),
+		newNetworkPolicyReconciler(namespace, owner, ci),
+	}
+
+	// the AlertmanagerConfig CRD is optional. The AlertmanagerConfigs are only added to the restricted cache if the
+	// CRD is available, so don't read them otherwise; that would start a cluster-wide informer once the CRD is installed
+	if ci.IsAlertmanagerConfigAvailable() {
+		reconcilers = append(reconcilers, newAlertmanagerConfigReconciler(namespace, owner))
+	}
 
 	return &MonitoringReconciler{
-		reconcilers: []MetricReconciler{
-			newAlertRuleReconciler(namespace, owner),
-			newRoleReconciler(namespace, owner),
-			newRoleBindingReconciler(namespace, owner, ci),
-			newMetricServiceReconciler(namespace, owner),
-			newServiceMonitorReconciler(namespace, owner),
-		},
+		reconcilers:  reconcilers,
 		scheme:       scheme,
 		client:       cl,
 		namespace:    namespace,
@@ -65,14 +92,18 @@ func (r *MonitoringReconciler) Reconcile(req *common.HcoRequest, firstLoop bool)
 		return err
 	}
 
-	objects := make([]client.Object, len(r.reconcilers))
+	objects := make([]client.Object, 0, len(r.reconcilers))
+
+	for _, rc := range r.reconcilers {
+		if hcAware, ok := rc.(hcAwareReconciler); ok {
+			hcAware.setHyperConverged(req.Instance, req.Logger)
+		}
 
-	for i, rc := range r.reconcilers {
 		obj, err := r.ReconcileOneResource(req, rc, firstLoop)
 		if err != nil {
 			return err
 		} else if obj != nil {
-			objects[i] = obj
+			objects = append(objects, obj)
 		}
 	}
 
@@ -88,47 +119,90 @@ func (r *MonitoringReconciler) ReconcileOneResource(req *common.HcoRequest, reco
 	req.Logger.V(5).Info(fmt.Sprintf("Reconciling the %s", reconciler.Kind()))
 
 	existing := reconciler.EmptyObject()
+	optional, isOptional := reconciler.(optionalReconciler)
+	needed := !isOptional || optional.isRequired()
 
 	req.Logger.V(5).Info(fmt.Sprintf("Reading the current %s", reconciler.Kind()))
 	err := r.client.Get(req.Ctx, client.ObjectKey{Namespace: r.namespace, Name: reconciler.ResourceName()}, existing)
 
 	if err != nil {
+		if isOptional && meta.IsNoMatchError(err) {
+			if needed {
+				req.Logger.Info(fmt.Sprintf("can't create the %s; the %s kind is not available in the cluster", reconciler.ResourceName(), reconciler.Kind()))
+			}
+			return nil, nil
+		}
+
 		if errors.IsNotFound(err) {
+			if !needed {
+				return nil, nil
+			}
+
 			req.Logger.Info(fmt.Sprintf("Can't find the %s; creating a new one", reconciler.Kind()), "name", reconciler.ResourceName())
 			required := reconciler.GetFullResource()
-			err := r.client.Create(req.Ctx, required)
+			err := common.NewCustomMetadataClient(r.client, req.Instance).Create(req.Ctx, required)
 			if err != nil {
 				req.Logger.Error(err, fmt.Sprintf("failed to create %s", reconciler.Kind()))
-				r.eventEmitter.EmitEvent(nil, corev1.EventTypeWarning, "UnexpectedError", fmt.Sprintf("failed to create the %s %s", reconciler.ResourceName(), reconciler.Kind()))
+				r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, "UnexpectedError", fmt.Sprintf("failed to create the %s %s", reconciler.ResourceName(), reconciler.Kind()))
 				return nil, err
 			}
 			req.Logger.Info(fmt.Sprintf("successfully created the %s", reconciler.Kind()), "name", reconciler.ResourceName())
-			r.eventEmitter.EmitEvent(required, corev1.EventTypeNormal, "Created", fmt.Sprintf("Created %s %s", reconciler.Kind(), reconciler.ResourceName()))
+			r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Created", fmt.Sprintf("Created %s %s", reconciler.Kind(), reconciler.ResourceName()))
 
 			return required, nil
 		}
 
-		req.Logger.Error(err, "unexpected error while reading the PrometheusRule")
+		req.Logger.Error(err, fmt.Sprintf("unexpected error while reading the %s", reconciler.Kind()))
 		return nil, err
 	}
 
-	resource, updated, err := reconciler.UpdateExistingResource(req.Ctx, r.client, existing, req.Logger)
+	if !needed {
+		return nil, r.deleteResource(req, reconciler, existing)
+	}
+
+	// read the field manager before the update, that modifies the managed fields of the existing object
+	fieldManager := hcoutil.GetLastFieldManager(existing)
+
+	// the reconcilers compare their required object with the existing object, that must not contain the custom metadata
+	hasCustomMetadata := common.StripCustomMetadata(req.Instance, existing)
+	cl := common.NewCustomMetadataClient(r.client, req.Instance)
+
+	resource, updated, err := reconciler.UpdateExistingResource(req.Ctx, cl, existing, req.Logger)
+	if err == nil && !updated && !hasCustomMetadata {
+		req.Logger.Info(fmt.Sprintf("adding the custom metadata to the %s", reconciler.Kind()), "name", reconciler.ResourceName())
+		if err = cl.Update(req.Ctx, existing); err == nil {
+			resource, updated = existing, true
+		}
+	}
+
 	if err != nil {
-		r.eventEmitter.EmitEvent(nil, corev1.EventTypeWarning, "UnexpectedError", fmt.Sprintf("failed to update the %s %s", reconciler.ResourceName(), reconciler.Kind()))
+		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, "UnexpectedError", fmt.Sprintf("failed to update the %s %s", reconciler.ResourceName(), reconciler.Kind()))
 	} else if updated {
-		err = r.handleUpdatedResource(req, reconciler, firstLoop)
+		err = r.handleUpdatedResource(req, reconciler, firstLoop, fieldManager)
 	}
 
 	return resource, err
 }
 
-func (r *MonitoringReconciler) handleUpdatedResource(req *common.HcoRequest, reconciler MetricReconciler, firstLoop bool) error {
+func (r *MonitoringReconciler) deleteResource(req *common.HcoRequest, reconciler MetricReconciler, existing client.Object) error {
+	req.Logger.Info(fmt.Sprintf("removing the %s, as it is no longer required", reconciler.Kind()), "name", reconciler.ResourceName())
+	if err := r.client.Delete(req.Ctx, existing); client.IgnoreNotFound(err) != nil {
+		req.Logger.Error(err, fmt.Sprintf("failed to remove the %s", reconciler.Kind()))
+		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, "UnexpectedError", fmt.Sprintf("failed to remove the %s %s", reconciler.ResourceName(), reconciler.Kind()))
+		return err
+	}
+
+	r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Killing", fmt.Sprintf("Removed %s %s", reconciler.Kind(), reconciler.ResourceName()))
+	return nil
+}
+
+func (r *MonitoringReconciler) handleUpdatedResource(req *common.HcoRequest, reconciler MetricReconciler, firstLoop bool, fieldManager string) error {
 	if req.HCOTriggered {
-		r.eventEmitter.EmitEvent(nil, corev1.EventTypeNormal, "Updated", fmt.Sprintf("Updated %s %s", reconciler.Kind(), reconciler.ResourceName()))
+		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Updated", fmt.Sprintf("Updated %s %s", reconciler.Kind(), reconciler.ResourceName()))
 	} else {
-		r.eventEmitter.EmitEvent(nil, corev1.EventTypeWarning, "Overwritten", fmt.Sprintf("Overwritten %s %s", reconciler.Kind(), reconciler.ResourceName()))
+		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, "Overwritten", fmt.Sprintf("Overwritten %s %s", reconciler.Kind(), reconciler.ResourceName()))
 		if !firstLoop && !req.UpgradeMode {
-			err := metrics.HcoMetrics.IncOverwrittenModifications(reconciler.Kind(), reconciler.ResourceName())
+			err := metrics.HcoMetrics.IncOverwrittenModifications(reconciler.Kind(), reconciler.ResourceName(), r.namespace, fieldManager)
 			if err != nil {
 				req.Logger.Error(err, "couldn't update 'OverwrittenModifications' metric")
 				return err
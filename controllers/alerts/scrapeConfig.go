@@ -0,0 +1,184 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/go-logr/logr"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus/common/model"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// metricsScrapeResourceEnv selects the resource that Prometheus uses to scrape the HCO metrics; either ServiceMonitor,
+// PodMonitor or ScrapeConfig
+const metricsScrapeResourceEnv = "METRICS_SCRAPE_RESOURCE"
+
+// getMetricsScrapeResource returns the kind of the resource that Prometheus uses to scrape the HCO metrics. The
+// ServiceMonitor is used, unless the PodMonitor or the ScrapeConfig is selected by the METRICS_SCRAPE_RESOURCE
+// environment variable.
+func getMetricsScrapeResource() string {
+	scrapeResource := os.Getenv(metricsScrapeResourceEnv)
+	for _, kind := range []string{monitoringv1.ServiceMonitorsKind, monitoringv1.PodMonitorsKind, monitoringv1alpha1.ScrapeConfigsKind} {
+		if strings.EqualFold(scrapeResource, kind) {
+			return kind
+		}
+	}
+
+	return monitoringv1.ServiceMonitorsKind
+}
+
+// scrapeConfigReconciler maintains a ScrapeConfig that scrapes the HCO metrics service. It is only used instead of the
+// ServiceMonitor, if selected by the METRICS_SCRAPE_RESOURCE environment variable.
+type scrapeConfigReconciler struct {
+	theScrapeConfig *monitoringv1alpha1.ScrapeConfig
+	required        bool
+}
+
+func newScrapeConfigReconciler(namespace string, owner metav1.OwnerReference, isOpenshift bool, scrapeResource string) *scrapeConfigReconciler {
+	return &scrapeConfigReconciler{
+		theScrapeConfig: NewScrapeConfig(namespace, owner, isOpenshift),
+		required:        scrapeResource == monitoringv1alpha1.ScrapeConfigsKind,
+	}
+}
+
+// setHyperConverged applies the scrape configuration from the HyperConverged CR to the ScrapeConfig, the same as it
+// is applied to the ServiceMonitor
+func (r *scrapeConfigReconciler) setHyperConverged(hc *hcov1beta1.HyperConverged, _ logr.Logger) {
+	var config *hcov1beta1.ServiceMonitorConfig
+	if hc != nil && hc.Spec.Monitoring != nil {
+		config = hc.Spec.Monitoring.ServiceMonitor
+	}
+
+	applyScrapeConfigConfig(&r.theScrapeConfig.Spec, config)
+}
+
+func (r *scrapeConfigReconciler) isRequired() bool {
+	return r.required
+}
+
+func (r *scrapeConfigReconciler) Kind() string {
+	return monitoringv1alpha1.ScrapeConfigsKind
+}
+
+func (r *scrapeConfigReconciler) ResourceName() string {
+	return serviceName
+}
+
+func (r *scrapeConfigReconciler) GetFullResource() client.Object {
+	return r.theScrapeConfig.DeepCopy()
+}
+
+func (r *scrapeConfigReconciler) EmptyObject() client.Object {
+	return &monitoringv1alpha1.ScrapeConfig{}
+}
+
+func (r *scrapeConfigReconciler) UpdateExistingResource(ctx context.Context, cl client.Client, resource client.Object, logger logr.Logger) (client.Object, bool, error) {
+	found := resource.(*monitoringv1alpha1.ScrapeConfig)
+	modified := false
+	if !reflect.DeepEqual(found.Spec, r.theScrapeConfig.Spec) {
+		r.theScrapeConfig.Spec.DeepCopyInto(&found.Spec)
+		modified = true
+	}
+
+	modified = updateCommonDetails(&r.theScrapeConfig.ObjectMeta, &found.ObjectMeta) || modified
+
+	if modified {
+		err := cl.Update(ctx, found)
+		if err != nil {
+			logger.Error(err, "failed to update the ScrapeConfig")
+			return nil, false, err
+		}
+		logger.Info("successfully updated the ScrapeConfig")
+	}
+	return found, modified, nil
+}
+
+// NewScrapeConfig returns a ScrapeConfig that scrapes the HCO metrics service. The target gets the same namespace,
+// service and job labels as the ServiceMonitor targets.
+func NewScrapeConfig(namespace string, owner metav1.OwnerReference, isOpenshift bool) *monitoringv1alpha1.ScrapeConfig {
+	spec := monitoringv1alpha1.ScrapeConfigSpec{
+		StaticConfigs: []monitoringv1alpha1.StaticConfig{
+			{
+				Targets: []monitoringv1alpha1.Target{
+					monitoringv1alpha1.Target(fmt.Sprintf("%s.%s.svc:%d", serviceName, namespace, hcoutil.MetricsPort)),
+				},
+				Labels: map[monitoringv1.LabelName]string{
+					"namespace": namespace,
+					"service":   serviceName,
+					"job":       serviceName,
+				},
+			},
+		},
+		Scheme:    ptr.To("HTTPS"),
+		TLSConfig: getScrapeConfigTLSConfig(namespace, isOpenshift),
+	}
+
+	return &monitoringv1alpha1.ScrapeConfig{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1alpha1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1alpha1.ScrapeConfigsKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            serviceName,
+			Labels:          hcoutil.GetLabels(hcoutil.HyperConvergedName, hcoutil.AppComponentMonitoring),
+			Namespace:       namespace,
+			OwnerReferences: []metav1.OwnerReference{owner},
+		},
+		Spec: spec,
+	}
+}
+
+// getScrapeConfigTLSConfig returns the TLS configuration for scraping the metrics service. On OpenShift, the serving
+// certificate is verified against the service CA bundle, that is injected into the metrics CA bundle ConfigMap, as the
+// ScrapeConfig can't refer to the service CA file that is mounted into the Prometheus pods. Otherwise, the metrics
+// server uses a self-signed certificate that can't be verified, the same as with the ServiceMonitor.
+func getScrapeConfigTLSConfig(namespace string, isOpenshift bool) *monitoringv1.SafeTLSConfig {
+	if !isOpenshift {
+		return &monitoringv1.SafeTLSConfig{
+			InsecureSkipVerify: true,
+		}
+	}
+
+	return &monitoringv1.SafeTLSConfig{
+		CA: monitoringv1.SecretOrConfigMap{
+			ConfigMap: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: metricsCABundleName},
+				Key:                  serviceCABundleKey,
+			},
+		},
+		ServerName: fmt.Sprintf("%s.%s.svc", serviceName, namespace),
+	}
+}
+
+// applyScrapeConfigConfig sets the configurable fields of the ScrapeConfig. The fields are reset if they are not set
+// in the configuration.
+func applyScrapeConfigConfig(spec *monitoringv1alpha1.ScrapeConfigSpec, config *hcov1beta1.ServiceMonitorConfig) {
+	spec.ScrapeInterval = nil
+	spec.HonorLabels = nil
+	spec.MetricRelabelConfigs = nil
+
+	if config == nil {
+		return
+	}
+
+	if config.Interval != nil && config.Interval.Duration > 0 {
+		spec.ScrapeInterval = ptr.To(monitoringv1.Duration(model.Duration(config.Interval.Duration).String()))
+	}
+
+	if config.HonorLabels {
+		spec.HonorLabels = ptr.To(true)
+	}
+
+	spec.MetricRelabelConfigs = getMetricRelabelConfigs(config.MetricRelabelings)
+}
@@ -15,19 +15,23 @@ import (
 )
 
 const (
-	operatorPortName    = "http-metrics"
+	operatorPortName    = hcoutil.MetricsPortName
 	defaultOperatorName = "hyperconverged-cluster-operator"
 	operatorNameEnv     = "OPERATOR_NAME"
 	metricsSuffix       = "-operator-metrics"
 	serviceName         = hcoutil.HyperConvergedName + metricsSuffix
+
+	// servingCertSecretAnnotation asks the OpenShift service CA operator to generate the serving certificate of
+	// the metrics service
+	servingCertSecretAnnotation = "service.beta.openshift.io/serving-cert-secret-name"
 )
 
 type metricServiceReconciler struct {
 	theService *corev1.Service
 }
 
-func newMetricServiceReconciler(namespace string, owner metav1.OwnerReference) *metricServiceReconciler {
-	return &metricServiceReconciler{theService: NewMetricsService(namespace, owner)}
+func newMetricServiceReconciler(namespace string, owner metav1.OwnerReference, isOpenshift bool) *metricServiceReconciler {
+	return &metricServiceReconciler{theService: NewMetricsService(namespace, owner, isOpenshift)}
 }
 
 func (r metricServiceReconciler) Kind() string {
@@ -51,11 +55,23 @@ func (r metricServiceReconciler) UpdateExistingResource(ctx context.Context, cl
 
 	modified := false
 	if !reflect.DeepEqual(found.Spec.Selector, r.theService.Spec.Selector) ||
-		!reflect.DeepEqual(found.Spec.Ports, r.theService.Spec.Ports) {
+		!reflect.DeepEqual(found.Spec.Ports, r.theService.Spec.Ports) ||
+		!reflect.DeepEqual(found.Spec.IPFamilyPolicy, r.theService.Spec.IPFamilyPolicy) {
+
+		// only update the fields that are set by HCO. The cluster IPs and the IP families are allocated by the API
+		// server, according to the IP family policy and to the cluster network configuration.
+		required := r.theService.Spec.DeepCopy()
+		found.Spec.Selector = required.Selector
+		found.Spec.Ports = required.Ports
+		found.Spec.IPFamilyPolicy = required.IPFamilyPolicy
+		modified = true
+	}
 
-		clusterIP := found.Spec.ClusterIP
-		r.theService.Spec.DeepCopyInto(&found.Spec)
-		found.Spec.ClusterIP = clusterIP // restore
+	if secretName, ok := r.theService.Annotations[servingCertSecretAnnotation]; ok && found.Annotations[servingCertSecretAnnotation] != secretName {
+		if found.Annotations == nil {
+			found.Annotations = make(map[string]string)
+		}
+		found.Annotations[servingCertSecretAnnotation] = secretName
 		modified = true
 	}
 
@@ -72,7 +88,7 @@ func (r metricServiceReconciler) UpdateExistingResource(ctx context.Context, cl
 	return found, modified, nil
 }
 
-func NewMetricsService(namespace string, owner metav1.OwnerReference) *corev1.Service {
+func NewMetricsService(namespace string, owner metav1.OwnerReference, isOpenshift bool) *corev1.Service {
 	servicePorts := []corev1.ServicePort{
 		{
 			Port:     hcoutil.MetricsPort,
@@ -84,19 +100,18 @@ func NewMetricsService(namespace string, owner metav1.OwnerReference) *corev1.Se
 		},
 	}
 
-	operatorName := defaultOperatorName
-	val, ok := os.LookupEnv(operatorNameEnv)
-	if ok && val != "" {
-		operatorName = val
-	}
-	labelSelect := map[string]string{"name": operatorName}
+	// PreferDualStack makes the metrics endpoint reachable over both IP families on dual-stack clusters, and over the
+	// single available IP family (IPv4 or IPv6) on single-stack clusters. The IP families are left unset, to be
+	// allocated by the API server.
+	ipFamilyPolicy := corev1.IPFamilyPolicyPreferDualStack
 
 	spec := corev1.ServiceSpec{
-		Ports:    servicePorts,
-		Selector: labelSelect,
+		Ports:          servicePorts,
+		Selector:       getMetricsPodSelector(),
+		IPFamilyPolicy: &ipFamilyPolicy,
 	}
 
-	return &corev1.Service{
+	svc := &corev1.Service{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "Service",
 			APIVersion: "v1",
@@ -109,4 +124,22 @@ func NewMetricsService(namespace string, owner metav1.OwnerReference) *corev1.Se
 		},
 		Spec: spec,
 	}
+
+	if isOpenshift {
+		svc.Annotations = map[string]string{
+			servingCertSecretAnnotation: hcoutil.MetricsServingCertSecretName,
+		}
+	}
+
+	return svc
+}
+
+// getMetricsPodSelector returns the labels of the HCO operator pod, that serves the metrics
+func getMetricsPodSelector() map[string]string {
+	operatorName := defaultOperatorName
+	val, ok := os.LookupEnv(operatorNameEnv)
+	if ok && val != "" {
+		operatorName = val
+	}
+	return map[string]string{"name": operatorName}
 }
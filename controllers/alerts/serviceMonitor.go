@@ -2,22 +2,50 @@ package alerts
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 
 	"github.com/go-logr/logr"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/common/model"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
 type serviceMonitorReconciler struct {
 	theServiceMonitor *monitoringv1.ServiceMonitor
+	required          bool
 }
 
-func newServiceMonitorReconciler(namespace string, owner metav1.OwnerReference) *serviceMonitorReconciler {
-	return &serviceMonitorReconciler{theServiceMonitor: NewServiceMonitor(namespace, owner)}
+// defaultRelabelAction is the default relabeling action, as set by the ServiceMonitor and the ScrapeConfig CRDs
+const defaultRelabelAction = "replace"
+
+// serviceCAFile is the service CA bundle, as mounted into the OpenShift cluster monitoring Prometheus
+const serviceCAFile = "/etc/prometheus/configmaps/serving-certs-ca-bundle/service-ca.crt"
+
+func newServiceMonitorReconciler(namespace string, owner metav1.OwnerReference, isOpenshift bool, scrapeResource string) *serviceMonitorReconciler {
+	return &serviceMonitorReconciler{
+		theServiceMonitor: NewServiceMonitor(namespace, owner, isOpenshift),
+		required:          scrapeResource == monitoringv1.ServiceMonitorsKind,
+	}
+}
+
+// setHyperConverged applies the scrape configuration from the HyperConverged CR to the ServiceMonitor endpoint
+func (r *serviceMonitorReconciler) setHyperConverged(hc *hcov1beta1.HyperConverged, _ logr.Logger) {
+	var config *hcov1beta1.ServiceMonitorConfig
+	if hc != nil && hc.Spec.Monitoring != nil {
+		config = hc.Spec.Monitoring.ServiceMonitor
+	}
+
+	applyServiceMonitorConfig(&r.theServiceMonitor.Spec.Endpoints[0], config)
+}
+
+// the ServiceMonitor is not required if the PodMonitor or the ScrapeConfig is used instead
+func (r serviceMonitorReconciler) isRequired() bool {
+	return r.required
 }
 
 func (r serviceMonitorReconciler) Kind() string {
@@ -57,13 +85,19 @@ func (r serviceMonitorReconciler) UpdateExistingResource(ctx context.Context, cl
 	return found, modified, nil
 }
 
-func NewServiceMonitor(namespace string, owner metav1.OwnerReference) *monitoringv1.ServiceMonitor {
+func NewServiceMonitor(namespace string, owner metav1.OwnerReference, isOpenshift bool) *monitoringv1.ServiceMonitor {
 	labels := hcoutil.GetLabels(hcoutil.HyperConvergedName, hcoutil.AppComponentMonitoring)
 	spec := monitoringv1.ServiceMonitorSpec{
 		Selector: metav1.LabelSelector{
 			MatchLabels: labels,
 		},
-		Endpoints: []monitoringv1.Endpoint{{Port: operatorPortName}},
+		Endpoints: []monitoringv1.Endpoint{
+			{
+				Port:      operatorPortName,
+				Scheme:    "https",
+				TLSConfig: getMetricsTLSConfig(namespace, isOpenshift),
+			},
+		},
 	}
 
 	return &monitoringv1.ServiceMonitor{
@@ -80,3 +114,71 @@ func NewServiceMonitor(namespace string, owner metav1.OwnerReference) *monitorin
 		Spec: spec,
 	}
 }
+
+// getMetricsTLSConfig returns the TLS configuration for scraping the metrics endpoint. On OpenShift, the serving
+// certificate is signed by the service CA and is verified against it. Otherwise, the metrics server uses a self-signed
+// certificate that can't be verified.
+func getMetricsTLSConfig(namespace string, isOpenshift bool) *monitoringv1.TLSConfig {
+	if !isOpenshift {
+		return &monitoringv1.TLSConfig{
+			SafeTLSConfig: monitoringv1.SafeTLSConfig{
+				InsecureSkipVerify: true,
+			},
+		}
+	}
+
+	return &monitoringv1.TLSConfig{
+		CAFile: serviceCAFile,
+		SafeTLSConfig: monitoringv1.SafeTLSConfig{
+			ServerName: fmt.Sprintf("%s.%s.svc", serviceName, namespace),
+		},
+	}
+}
+
+// applyServiceMonitorConfig sets the configurable fields of the metrics endpoint. The fields are reset if they are not
+// set in the configuration.
+func applyServiceMonitorConfig(endpoint *monitoringv1.Endpoint, config *hcov1beta1.ServiceMonitorConfig) {
+	endpoint.Interval = ""
+	endpoint.HonorLabels = false
+	endpoint.MetricRelabelConfigs = nil
+
+	if config == nil {
+		return
+	}
+
+	if config.Interval != nil && config.Interval.Duration > 0 {
+		endpoint.Interval = monitoringv1.Duration(model.Duration(config.Interval.Duration).String())
+	}
+
+	endpoint.HonorLabels = config.HonorLabels
+	endpoint.MetricRelabelConfigs = getMetricRelabelConfigs(config.MetricRelabelings)
+}
+
+// getMetricRelabelConfigs converts the metric relabelings from the HyperConverged CR to the prometheus-operator
+// relabel configurations. Returns nil if there are no relabelings.
+func getMetricRelabelConfigs(relabelings []hcov1beta1.MetricRelabelConfig) []*monitoringv1.RelabelConfig {
+	if len(relabelings) == 0 {
+		return nil
+	}
+
+	relabelConfigs := make([]*monitoringv1.RelabelConfig, 0, len(relabelings))
+	for _, relabeling := range relabelings {
+		relabelConfig := &monitoringv1.RelabelConfig{
+			Separator:   relabeling.Separator,
+			TargetLabel: relabeling.TargetLabel,
+			Regex:       relabeling.Regex,
+			Replacement: relabeling.Replacement,
+			Action:      relabeling.Action,
+		}
+		if relabelConfig.Action == "" {
+			// set the default of the monitoring CRDs, to avoid updating the resource on each reconciliation
+			relabelConfig.Action = defaultRelabelAction
+		}
+		for _, label := range relabeling.SourceLabels {
+			relabelConfig.SourceLabels = append(relabelConfig.SourceLabels, monitoringv1.LabelName(label))
+		}
+		relabelConfigs = append(relabelConfigs, relabelConfig)
+	}
+
+	return relabelConfigs
+}
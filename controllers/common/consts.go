@@ -9,6 +9,32 @@ const (
 	JSONPatchCDIAnnotationName  = "containerizeddataimporter.kubevirt.io/jsonpatch"
 	JSONPatchCNAOAnnotationName = "networkaddonsconfigs.kubevirt.io/jsonpatch"
 	JSONPatchSSPAnnotationName  = "ssp.kubevirt.io/jsonpatch"
+	JSONPatchMTQAnnotationName  = "mtq.kubevirt.io/jsonpatch"
 	// Tuning Policy annotation name
 	TuningPolicyAnnotationName = "hco.kubevirt.io/tuningPolicy"
+	// Pause reconciliation annotation name; a comma separated list of the operands that HCO should not update
+	PauseReconcileAnnotationName = "hco.kubevirt.io/pause-reconcile"
+	// Configuration drift report annotation name; changing its value triggers a new report
+	ConfigurationDriftReportAnnotationName = "hco.kubevirt.io/configurationDriftReport"
+	// Force resync annotation name; changing its value makes HCO re-render and re-apply all the operands
+	ForceResyncAnnotationName = "hco.kubevirt.io/forceResync"
+	// Deletion protection annotation name; the validating webhook denies the deletion of the HyperConverged CR
+	// according to its value
+	DeletionProtectionAnnotationName = "hco.kubevirt.io/deletion-protection"
+)
+
+// the supported values of the deletion protection annotation
+const (
+	// DeletionProtectionAlways denies any deletion of the HyperConverged CR
+	DeletionProtectionAlways = "Always"
+	// DeletionProtectionIfVirtualMachinesExist denies the deletion of the HyperConverged CR, while there are
+	// VirtualMachines in the cluster
+	DeletionProtectionIfVirtualMachinesExist = "IfVirtualMachinesExist"
+)
+
+// the values of the system health status of the HyperConverged CR, and of the health status of each operand
+const (
+	SystemHealthStatusHealthy = "healthy"
+	SystemHealthStatusWarning = "warning"
+	SystemHealthStatusError   = "error"
 )
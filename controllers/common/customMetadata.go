@@ -0,0 +1,160 @@
+package common
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+// The custom labels and annotations, from the metadata field of the HyperConverged CR, are added to the resources
+// when they are written to the cluster, and are never part of the required objects that HCO renders. The handlers
+// compare the required objects with the existing resources, so the custom labels and annotations are removed from
+// the existing resources before the comparison (StripCustomMetadata), and are added back by the client that writes
+// the resources (NewCustomMetadataClient).
+
+func getCustomMetadata(hc *hcov1beta1.HyperConverged) *hcov1beta1.ResourcesMetadata {
+	if hc == nil || hc.Spec.Metadata == nil {
+		return nil
+	}
+	return hc.Spec.Metadata
+}
+
+// AddCustomMetadata adds the custom labels and annotations of the HyperConverged CR to the object. Keys that already
+// exist in the object are not modified, so the labels and annotations that HCO sets take precedence. New maps are set
+// in the object, rather than modifying its current maps, that may be shared with other fields; e.g. a label selector.
+//
+// AddCustomMetadata returns true if the object was modified.
+func AddCustomMetadata(hc *hcov1beta1.HyperConverged, obj client.Object) bool {
+	md := getCustomMetadata(hc)
+	if md == nil {
+		return false
+	}
+
+	labels, labelsModified := mergeMissingKeys(obj.GetLabels(), md.Labels)
+	if labelsModified {
+		obj.SetLabels(labels)
+	}
+
+	annotations, annotationsModified := mergeMissingKeys(obj.GetAnnotations(), md.Annotations)
+	if annotationsModified {
+		obj.SetAnnotations(annotations)
+	}
+
+	return labelsModified || annotationsModified
+}
+
+// StripCustomMetadata removes the custom labels and annotations of the HyperConverged CR from the object, if they
+// have the expected values. StripCustomMetadata returns false if any of the custom labels or annotations is missing,
+// or has a different value.
+func StripCustomMetadata(hc *hcov1beta1.HyperConverged, obj client.Object) bool {
+	md := getCustomMetadata(hc)
+	if md == nil {
+		return true
+	}
+
+	labels, labelsComplete := removeMatchingKeys(obj.GetLabels(), md.Labels)
+	obj.SetLabels(labels)
+
+	annotations, annotationsComplete := removeMatchingKeys(obj.GetAnnotations(), md.Annotations)
+	obj.SetAnnotations(annotations)
+
+	return labelsComplete && annotationsComplete
+}
+
+func mergeMissingKeys(current, custom map[string]string) (map[string]string, bool) {
+	var merged map[string]string
+	for key, value := range custom {
+		if _, exists := current[key]; exists {
+			continue
+		}
+
+		if merged == nil {
+			merged = make(map[string]string, len(current)+len(custom))
+			for k, v := range current {
+				merged[k] = v
+			}
+		}
+		merged[key] = value
+	}
+
+	if merged == nil {
+		return current, false
+	}
+	return merged, true
+}
+
+func removeMatchingKeys(current, custom map[string]string) (map[string]string, bool) {
+	complete := true
+	for key, value := range custom {
+		if currentValue, ok := current[key]; !ok || currentValue != value {
+			complete = false
+		}
+	}
+
+	var stripped map[string]string
+	for key, value := range current {
+		if customValue, ok := custom[key]; ok && customValue == value {
+			if stripped == nil {
+				stripped = make(map[string]string, len(current))
+				for k, v := range current {
+					stripped[k] = v
+				}
+			}
+			delete(stripped, key)
+		}
+	}
+
+	if stripped == nil {
+		return current, complete
+	}
+	return stripped, complete
+}
+
+// customMetadataClient adds the custom labels and annotations of the HyperConverged CR to the resources it creates
+// and updates. The object of the caller is not modified, except for the fields that are set by the API server.
+type customMetadataClient struct {
+	client.Client
+	hc *hcov1beta1.HyperConverged
+}
+
+// NewCustomMetadataClient returns a client that adds the custom labels and annotations of the HyperConverged CR to
+// the resources it creates and updates.
+func NewCustomMetadataClient(cl client.Client, hc *hcov1beta1.HyperConverged) client.Client {
+	if getCustomMetadata(hc) == nil {
+		return cl
+	}
+
+	return &customMetadataClient{Client: cl, hc: hc}
+}
+
+func (c *customMetadataClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	return c.write(obj, func(o client.Object) error {
+		return c.Client.Create(ctx, o, opts...)
+	})
+}
+
+func (c *customMetadataClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return c.write(obj, func(o client.Object) error {
+		return c.Client.Update(ctx, o, opts...)
+	})
+}
+
+func (c *customMetadataClient) write(obj client.Object, writeFunc func(client.Object) error) error {
+	withMetadata, ok := obj.DeepCopyObject().(client.Object)
+	if !ok || !AddCustomMetadata(c.hc, withMetadata) {
+		return writeFunc(obj)
+	}
+
+	if err := writeFunc(withMetadata); err != nil {
+		return err
+	}
+
+	obj.SetUID(withMetadata.GetUID())
+	obj.SetResourceVersion(withMetadata.GetResourceVersion())
+	obj.SetGeneration(withMetadata.GetGeneration())
+	obj.SetCreationTimestamp(withMetadata.GetCreationTimestamp())
+
+	return nil
+}
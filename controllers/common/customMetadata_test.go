@@ -0,0 +1,135 @@
+package common
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+var _ = Describe("Test custom metadata", func() {
+	var hc *hcov1beta1.HyperConverged
+
+	BeforeEach(func() {
+		hc = &hcov1beta1.HyperConverged{
+			Spec: hcov1beta1.HyperConvergedSpec{
+				Metadata: &hcov1beta1.ResourcesMetadata{
+					Labels:      map[string]string{"cost-center": "1234", "app.kubernetes.io/component": "custom"},
+					Annotations: map[string]string{"owner": "virt-team"},
+				},
+			},
+		}
+	})
+
+	newConfigMap := func(labels, annotations map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test",
+				Namespace:   "test-ns",
+				Labels:      labels,
+				Annotations: annotations,
+			},
+		}
+	}
+
+	Context("AddCustomMetadata", func() {
+		It("should not modify the object if the custom metadata is not set", func() {
+			hc.Spec.Metadata = nil
+			cm := newConfigMap(map[string]string{"a": "b"}, nil)
+
+			Expect(AddCustomMetadata(hc, cm)).To(BeFalse())
+			Expect(cm.Labels).To(Equal(map[string]string{"a": "b"}))
+			Expect(cm.Annotations).To(BeNil())
+		})
+
+		It("should add the custom metadata, without overriding the existing keys", func() {
+			labels := map[string]string{"app.kubernetes.io/component": "compute"}
+			cm := newConfigMap(labels, nil)
+
+			Expect(AddCustomMetadata(hc, cm)).To(BeTrue())
+			Expect(cm.Labels).To(Equal(map[string]string{"app.kubernetes.io/component": "compute", "cost-center": "1234"}))
+			Expect(cm.Annotations).To(Equal(map[string]string{"owner": "virt-team"}))
+
+			By("not modifying the original labels map, that may be shared")
+			Expect(labels).To(HaveLen(1))
+		})
+
+		It("should return false if the object already contains the custom metadata", func() {
+			cm := newConfigMap(map[string]string{"cost-center": "1234", "app.kubernetes.io/component": "compute"}, map[string]string{"owner": "other-team"})
+			Expect(AddCustomMetadata(hc, cm)).To(BeFalse())
+			Expect(cm.Annotations).To(HaveKeyWithValue("owner", "other-team"))
+		})
+	})
+
+	Context("StripCustomMetadata", func() {
+		It("should remove the custom metadata with the expected values", func() {
+			cm := newConfigMap(
+				map[string]string{"cost-center": "1234", "app.kubernetes.io/component": "compute"},
+				map[string]string{"owner": "virt-team", "other": "value"},
+			)
+
+			Expect(StripCustomMetadata(hc, cm)).To(BeFalse())
+			Expect(cm.Labels).To(Equal(map[string]string{"app.kubernetes.io/component": "compute"}))
+			Expect(cm.Annotations).To(Equal(map[string]string{"other": "value"}))
+		})
+
+		It("should return true if all the custom metadata exists", func() {
+			hc.Spec.Metadata.Labels = map[string]string{"cost-center": "1234"}
+			cm := newConfigMap(map[string]string{"cost-center": "1234", "a": "b"}, map[string]string{"owner": "virt-team"})
+
+			Expect(StripCustomMetadata(hc, cm)).To(BeTrue())
+			Expect(cm.Labels).To(Equal(map[string]string{"a": "b"}))
+			Expect(cm.Annotations).To(BeEmpty())
+		})
+
+		It("should not remove keys with a different value", func() {
+			hc.Spec.Metadata.Labels = map[string]string{"cost-center": "1234"}
+			cm := newConfigMap(map[string]string{"cost-center": "5678"}, map[string]string{"owner": "virt-team"})
+
+			Expect(StripCustomMetadata(hc, cm)).To(BeFalse())
+			Expect(cm.Labels).To(Equal(map[string]string{"cost-center": "5678"}))
+		})
+	})
+
+	Context("NewCustomMetadataClient", func() {
+		It("should return the original client if the custom metadata is not set", func() {
+			cl := fake.NewClientBuilder().Build()
+			hc.Spec.Metadata = nil
+			Expect(NewCustomMetadataClient(cl, hc)).To(BeIdenticalTo(cl))
+			Expect(NewCustomMetadataClient(cl, nil)).To(BeIdenticalTo(cl))
+		})
+
+		It("should write the custom metadata without modifying the object of the caller", func() {
+			hc.Spec.Metadata.Labels = map[string]string{"cost-center": "1234"}
+			cl := fake.NewClientBuilder().Build()
+			mdClient := NewCustomMetadataClient(cl, hc)
+
+			cm := newConfigMap(map[string]string{"a": "b"}, nil)
+			Expect(mdClient.Create(context.TODO(), cm)).To(Succeed())
+			Expect(cm.Labels).To(Equal(map[string]string{"a": "b"}))
+			Expect(cm.ResourceVersion).ToNot(BeEmpty())
+
+			found := &corev1.ConfigMap{}
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cm), found)).To(Succeed())
+			Expect(found.Labels).To(Equal(map[string]string{"a": "b", "cost-center": "1234"}))
+			Expect(found.Annotations).To(Equal(map[string]string{"owner": "virt-team"}))
+
+			By("updating the object")
+			Expect(StripCustomMetadata(hc, found)).To(BeTrue())
+			found.Data = map[string]string{"key": "value"}
+			Expect(mdClient.Update(context.TODO(), found)).To(Succeed())
+
+			updated := &corev1.ConfigMap{}
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cm), updated)).To(Succeed())
+			Expect(updated.Data).To(HaveKeyWithValue("key", "value"))
+			Expect(updated.Labels).To(Equal(map[string]string{"a": "b", "cost-center": "1234"}))
+			Expect(updated.Annotations).To(Equal(map[string]string{"owner": "virt-team"}))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package common
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-logr/logr"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -18,10 +19,15 @@ type HcoRequest struct {
 	Instance                   *hcov1beta1.HyperConverged // the current state of the CR, as read from K8s
 	UpgradeMode                bool                       // copy of the reconciler upgrade mode
 	ComponentUpgradeInProgress bool                       // if in upgrade mode, accumulate the component upgrade status
+	UpgradePendingComponents   []string                   // if in upgrade mode, the components that did not complete their upgrade yet
 	Dirty                      bool                       // is something was changed in the CR
 	StatusDirty                bool                       // is something was changed in the CR's Status
 	HCOTriggered               bool                       // if the request got triggered by a direct modification on HCO CR
 	Upgradeable                bool                       // if all the operands are upgradeable
+	UpgradeBlockers            []UpgradeBlocker           // the operands that block the upgrade, in the order of their reconciliation
+	ForceResync                bool                       // if the operands are re-rendered and re-applied, ignoring any cached state
+	Rewritten                  []string                   // the objects that were created or updated during a forced resync
+	RequeueAfter               time.Duration              // when to reconcile again, to re-evaluate a time-based condition
 }
 
 func NewHcoRequest(ctx context.Context, request reconcile.Request, log logr.Logger, upgradeMode, hcoTriggered bool) *HcoRequest {
@@ -43,3 +49,36 @@ func (req *HcoRequest) SetUpgradeMode(upgradeMode bool) {
 	req.UpgradeMode = upgradeMode
 	req.ComponentUpgradeInProgress = upgradeMode
 }
+
+// UpgradeBlocker is an operand that blocks the upgrade of HCO, with the reason and the message to report in the
+// Upgradeable condition
+type UpgradeBlocker struct {
+	Component string
+	Reason    string
+	Message   string
+}
+
+// SetUpgradeBlocker marks the request as not upgradeable because of the blocker component. If the component already
+// blocks the upgrade, its reason and message are replaced only if override is true.
+func (req *HcoRequest) SetUpgradeBlocker(blocker UpgradeBlocker, override bool) {
+	req.Upgradeable = false
+
+	for i := range req.UpgradeBlockers {
+		if req.UpgradeBlockers[i].Component == blocker.Component {
+			if override {
+				req.UpgradeBlockers[i] = blocker
+			}
+			return
+		}
+	}
+
+	req.UpgradeBlockers = append(req.UpgradeBlockers, blocker)
+}
+
+// SetRequeueAfter asks to reconcile again after the given duration. If an earlier requeue was already requested, it
+// is kept.
+func (req *HcoRequest) SetRequeueAfter(after time.Duration) {
+	if after > 0 && (req.RequeueAfter == 0 || after < req.RequeueAfter) {
+		req.RequeueAfter = after
+	}
+}
@@ -63,4 +63,25 @@ var _ = Describe("Test HcoRequest", func() {
 		Expect(req.UpgradeMode).To(BeFalse())
 		Expect(req.ComponentUpgradeInProgress).To(BeFalse())
 	})
+
+	It("should collect the upgrade blockers", func() {
+		req := NewHcoRequest(context.TODO(), reconcile.Request{}, logf.Log, false, true)
+		Expect(req.Upgradeable).To(BeTrue())
+		Expect(req.UpgradeBlockers).To(BeEmpty())
+
+		req.SetUpgradeBlocker(UpgradeBlocker{Component: "CDI", Reason: "CDIProgressing", Message: "CDI is progressing"}, false)
+		req.SetUpgradeBlocker(UpgradeBlocker{Component: "SSP", Reason: "SSPProgressing", Message: "SSP is progressing"}, false)
+		Expect(req.Upgradeable).To(BeFalse())
+
+		By("not overriding an existing blocker if not requested")
+		req.SetUpgradeBlocker(UpgradeBlocker{Component: "CDI", Reason: "CDIConditions", Message: "CDI resource has no conditions"}, false)
+
+		By("overriding an existing blocker if requested")
+		req.SetUpgradeBlocker(UpgradeBlocker{Component: "SSP", Reason: "SSPNotUpgradeable", Message: "SSP is not upgradeable"}, true)
+
+		Expect(req.UpgradeBlockers).To(Equal([]UpgradeBlocker{
+			{Component: "CDI", Reason: "CDIProgressing", Message: "CDI is progressing"},
+			{Component: "SSP", Reason: "SSPNotUpgradeable", Message: "SSP is not upgradeable"},
+		}))
+	})
 })
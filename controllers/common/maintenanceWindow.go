@@ -0,0 +1,78 @@
+package common
+
+import (
+	"fmt"
+	"time"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+// Now returns the current time. It is a variable, to allow replacing it in tests.
+var Now = time.Now
+
+// GetMaintenanceWindowState checks if the maintenance window is open at the given time, and returns the time of the
+// next transition; the end of the current window if it is open, or the start of the next window if it is closed.
+//
+// A nil maintenance window means that there are no restrictions, i.e. the window is always open.
+func GetMaintenanceWindowState(mw *hcov1beta1.MaintenanceWindow, now time.Time) (bool, time.Time, error) {
+	if mw == nil {
+		return true, time.Time{}, nil
+	}
+
+	loc := time.UTC
+	if mw.TimeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(mw.TimeZone); err != nil {
+			return false, time.Time{}, fmt.Errorf("wrong maintenance window time zone %q; %w", mw.TimeZone, err)
+		}
+	}
+
+	startTime, err := time.Parse("15:04", mw.StartTime)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("wrong maintenance window start time %q; %w", mw.StartTime, err)
+	}
+
+	if mw.Duration.Duration <= 0 {
+		return false, time.Time{}, fmt.Errorf("the maintenance window duration must be positive")
+	}
+
+	days := make(map[time.Weekday]bool)
+	for _, day := range mw.Days {
+		weekday, err := parseWeekday(day)
+		if err != nil {
+			return false, time.Time{}, err
+		}
+		days[weekday] = true
+	}
+
+	localNow := now.In(loc)
+	// look a week backward, for a long window that is still open, and a week forward, for the next window
+	var nextStart time.Time
+	for offset := -7; offset <= 7; offset++ {
+		start := time.Date(localNow.Year(), localNow.Month(), localNow.Day()+offset, startTime.Hour(), startTime.Minute(), 0, 0, loc)
+		if len(days) > 0 && !days[start.Weekday()] {
+			continue
+		}
+
+		end := start.Add(mw.Duration.Duration)
+		if !now.Before(start) && now.Before(end) {
+			return true, end, nil
+		}
+
+		if start.After(now) && (nextStart.IsZero() || start.Before(nextStart)) {
+			nextStart = start
+		}
+	}
+
+	return false, nextStart, nil
+}
+
+func parseWeekday(day hcov1beta1.MaintenanceWindowDay) (time.Weekday, error) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if string(day) == weekday.String() {
+			return weekday, nil
+		}
+	}
+
+	return time.Sunday, fmt.Errorf("wrong maintenance window day %q", day)
+}
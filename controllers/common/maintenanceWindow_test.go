@@ -0,0 +1,75 @@
+package common
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+var _ = Describe("Test GetMaintenanceWindowState", func() {
+	// Wednesday
+	now := time.Date(2023, time.October, 18, 12, 30, 0, 0, time.UTC)
+
+	It("should be always open if there is no maintenance window", func() {
+		open, transition, err := GetMaintenanceWindowState(nil, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(open).To(BeTrue())
+		Expect(transition.IsZero()).To(BeTrue())
+	})
+
+	DescribeTable("should calculate the maintenance window state",
+		func(mw *hcov1beta1.MaintenanceWindow, expectedOpen bool, expectedTransition time.Time) {
+			open, transition, err := GetMaintenanceWindowState(mw, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(open).To(Equal(expectedOpen))
+			Expect(transition.Equal(expectedTransition)).To(BeTrue(), "expected %v; got %v", expectedTransition, transition)
+		},
+		Entry("daily, open",
+			&hcov1beta1.MaintenanceWindow{StartTime: "12:00", Duration: metav1.Duration{Duration: time.Hour}},
+			true, time.Date(2023, time.October, 18, 13, 0, 0, 0, time.UTC),
+		),
+		Entry("daily, closed, later today",
+			&hcov1beta1.MaintenanceWindow{StartTime: "22:00", Duration: metav1.Duration{Duration: 4 * time.Hour}},
+			false, time.Date(2023, time.October, 18, 22, 0, 0, 0, time.UTC),
+		),
+		Entry("daily, closed, tomorrow",
+			&hcov1beta1.MaintenanceWindow{StartTime: "02:00", Duration: metav1.Duration{Duration: 4 * time.Hour}},
+			false, time.Date(2023, time.October, 19, 2, 0, 0, 0, time.UTC),
+		),
+		Entry("daily, open since yesterday",
+			&hcov1beta1.MaintenanceWindow{StartTime: "22:00", Duration: metav1.Duration{Duration: 16 * time.Hour}},
+			true, time.Date(2023, time.October, 18, 14, 0, 0, 0, time.UTC),
+		),
+		Entry("weekly, closed",
+			&hcov1beta1.MaintenanceWindow{Days: []hcov1beta1.MaintenanceWindowDay{"Saturday"}, StartTime: "01:00", Duration: metav1.Duration{Duration: 6 * time.Hour}},
+			false, time.Date(2023, time.October, 21, 1, 0, 0, 0, time.UTC),
+		),
+		Entry("weekly, open",
+			&hcov1beta1.MaintenanceWindow{Days: []hcov1beta1.MaintenanceWindowDay{"Monday", "Wednesday"}, StartTime: "12:00", Duration: metav1.Duration{Duration: time.Hour}},
+			true, time.Date(2023, time.October, 18, 13, 0, 0, 0, time.UTC),
+		),
+		Entry("weekly, closed today, open next week",
+			&hcov1beta1.MaintenanceWindow{Days: []hcov1beta1.MaintenanceWindowDay{"Wednesday"}, StartTime: "10:00", Duration: metav1.Duration{Duration: time.Hour}},
+			false, time.Date(2023, time.October, 25, 10, 0, 0, 0, time.UTC),
+		),
+		Entry("with time zone",
+			&hcov1beta1.MaintenanceWindow{StartTime: "14:00", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: "Etc/GMT-2"},
+			true, time.Date(2023, time.October, 18, 13, 0, 0, 0, time.UTC),
+		),
+	)
+
+	DescribeTable("should return error for a wrong maintenance window",
+		func(mw *hcov1beta1.MaintenanceWindow) {
+			_, _, err := GetMaintenanceWindowState(mw, now)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("wrong start time", &hcov1beta1.MaintenanceWindow{StartTime: "25:00", Duration: metav1.Duration{Duration: time.Hour}}),
+		Entry("wrong duration", &hcov1beta1.MaintenanceWindow{StartTime: "12:00"}),
+		Entry("wrong day", &hcov1beta1.MaintenanceWindow{Days: []hcov1beta1.MaintenanceWindowDay{"Someday"}, StartTime: "12:00", Duration: metav1.Duration{Duration: time.Hour}}),
+		Entry("wrong time zone", &hcov1beta1.MaintenanceWindow{StartTime: "12:00", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: "Not/AZone"}),
+	)
+})
@@ -0,0 +1,14 @@
+package common
+
+import "github.com/kubevirt/hyperconverged-cluster-operator/pkg/metrics"
+
+// GetNumericalHealthStatus returns the value of a health status, as exposed by the health status metrics
+func GetNumericalHealthStatus(status string) float64 {
+	healthStatusCodes := map[string]float64{
+		SystemHealthStatusHealthy: metrics.SystemHealthStatusHealthy,
+		SystemHealthStatusWarning: metrics.SystemHealthStatusWarning,
+		SystemHealthStatusError:   metrics.SystemHealthStatusError,
+	}
+
+	return healthStatusCodes[status]
+}
@@ -17,6 +17,7 @@ import (
 	routev1 "github.com/openshift/api/route/v1"
 	csvv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -34,7 +35,9 @@ import (
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/components"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
 	sspv1beta2 "kubevirt.io/ssp-operator/api/v1beta2"
@@ -151,10 +154,12 @@ func GetScheme() *runtime.Scheme {
 	for _, f := range []func(*runtime.Scheme) error{
 		api.AddToScheme,
 		kubevirtcorev1.AddToScheme,
+		migrationsv1alpha1.AddToScheme,
 		cdiv1beta1.AddToScheme,
 		networkaddonsv1.AddToScheme,
 		sspv1beta2.AddToScheme,
 		monitoringv1.AddToScheme,
+		monitoringv1alpha1.AddToScheme,
 		apiextensionsv1.AddToScheme,
 		routev1.Install,
 		imagev1.Install,
@@ -291,6 +296,27 @@ func (ClusterInfoMock) IsManagedByOLM() bool {
 func (ClusterInfoMock) IsControlPlaneHighlyAvailable() bool {
 	return true
 }
+func (ClusterInfoMock) GetNodeArchitectures() []string {
+	return []string{"amd64"}
+}
+func (ClusterInfoMock) GetInfraNodeCount() int {
+	return 0
+}
+func (ClusterInfoMock) GetWorkerNodeCount() int {
+	return 3
+}
+func (ClusterInfoMock) GetControlPlaneTopology() openshiftconfigv1.TopologyMode {
+	return openshiftconfigv1.HighlyAvailableTopologyMode
+}
+func (ClusterInfoMock) GetInfrastructureTopology() openshiftconfigv1.TopologyMode {
+	return openshiftconfigv1.HighlyAvailableTopologyMode
+}
+func (ClusterInfoMock) IsConsoleAvailable() bool {
+	return true
+}
+func (ClusterInfoMock) GetManagedService() hcoutil.ManagedService {
+	return hcoutil.ManagedServiceNone
+}
 func (ClusterInfoMock) IsInfrastructureHighlyAvailable() bool {
 	return true
 }
@@ -303,9 +329,24 @@ func (ClusterInfoMock) GetBaseDomain() string {
 func (c ClusterInfoMock) IsConsolePluginImageProvided() bool {
 	return true
 }
+func (ClusterInfoMock) GetMonitoringNamespace() string {
+	return "openshift-monitoring"
+}
+func (ClusterInfoMock) GetMonitoringServiceAccount() string {
+	return "prometheus-k8s"
+}
 func (c ClusterInfoMock) IsMonitoringAvailable() bool {
 	return true
 }
+func (ClusterInfoMock) RefreshMonitoringAvailability(_ context.Context, _ client.Client) bool {
+	return false
+}
+func (ClusterInfoMock) IsScrapeConfigAvailable() bool {
+	return false
+}
+func (ClusterInfoMock) IsAlertmanagerConfigAvailable() bool {
+	return false
+}
 func (c ClusterInfoMock) IsSingleStackIPv6() bool {
 	return true
 }
@@ -329,6 +370,12 @@ func (ClusterInfoMock) GetTLSSecurityProfile(_ *openshiftconfigv1.TLSSecurityPro
 func (ClusterInfoMock) RefreshAPIServerCR(_ context.Context, _ client.Client) error {
 	return nil
 }
+func (ClusterInfoMock) RefreshClusterInfo(_ context.Context, _ client.Reader) (bool, error) {
+	return false, nil
+}
+func (ClusterInfoMock) RefreshNodeTopology(_ context.Context, _ client.Reader) (bool, error) {
+	return false, nil
+}
 
 // ClusterInfoSNOMock mocks Openshift SNO
 type ClusterInfoSNOMock struct{}
@@ -348,6 +395,27 @@ func (ClusterInfoSNOMock) IsManagedByOLM() bool {
 func (ClusterInfoSNOMock) IsControlPlaneHighlyAvailable() bool {
 	return false
 }
+func (ClusterInfoSNOMock) GetNodeArchitectures() []string {
+	return []string{"amd64"}
+}
+func (ClusterInfoSNOMock) GetInfraNodeCount() int {
+	return 0
+}
+func (ClusterInfoSNOMock) GetWorkerNodeCount() int {
+	return 1
+}
+func (ClusterInfoSNOMock) GetControlPlaneTopology() openshiftconfigv1.TopologyMode {
+	return openshiftconfigv1.SingleReplicaTopologyMode
+}
+func (ClusterInfoSNOMock) GetInfrastructureTopology() openshiftconfigv1.TopologyMode {
+	return openshiftconfigv1.SingleReplicaTopologyMode
+}
+func (ClusterInfoSNOMock) IsConsoleAvailable() bool {
+	return true
+}
+func (ClusterInfoSNOMock) GetManagedService() hcoutil.ManagedService {
+	return hcoutil.ManagedServiceNone
+}
 func (ClusterInfoSNOMock) IsInfrastructureHighlyAvailable() bool {
 	return false
 }
@@ -377,12 +445,33 @@ func (ClusterInfoSNOMock) GetTLSSecurityProfile(_ *openshiftconfigv1.TLSSecurity
 func (ClusterInfoSNOMock) RefreshAPIServerCR(_ context.Context, _ client.Client) error {
 	return nil
 }
+func (ClusterInfoSNOMock) RefreshClusterInfo(_ context.Context, _ client.Reader) (bool, error) {
+	return false, nil
+}
+func (ClusterInfoSNOMock) RefreshNodeTopology(_ context.Context, _ client.Reader) (bool, error) {
+	return false, nil
+}
 func (ClusterInfoSNOMock) IsConsolePluginImageProvided() bool {
 	return true
 }
+func (ClusterInfoSNOMock) GetMonitoringNamespace() string {
+	return "openshift-monitoring"
+}
+func (ClusterInfoSNOMock) GetMonitoringServiceAccount() string {
+	return "prometheus-k8s"
+}
 func (c ClusterInfoSNOMock) IsMonitoringAvailable() bool {
 	return true
 }
+func (ClusterInfoSNOMock) RefreshMonitoringAvailability(_ context.Context, _ client.Client) bool {
+	return false
+}
+func (ClusterInfoSNOMock) IsScrapeConfigAvailable() bool {
+	return false
+}
+func (ClusterInfoSNOMock) IsAlertmanagerConfigAvailable() bool {
+	return false
+}
 func (c ClusterInfoSNOMock) IsSingleStackIPv6() bool {
 	return true
 }
@@ -405,6 +494,27 @@ func (ClusterInfoSRCPHAIMock) IsManagedByOLM() bool {
 func (ClusterInfoSRCPHAIMock) IsControlPlaneHighlyAvailable() bool {
 	return false
 }
+func (ClusterInfoSRCPHAIMock) GetNodeArchitectures() []string {
+	return []string{"amd64"}
+}
+func (ClusterInfoSRCPHAIMock) GetInfraNodeCount() int {
+	return 0
+}
+func (ClusterInfoSRCPHAIMock) GetWorkerNodeCount() int {
+	return 3
+}
+func (ClusterInfoSRCPHAIMock) GetControlPlaneTopology() openshiftconfigv1.TopologyMode {
+	return openshiftconfigv1.SingleReplicaTopologyMode
+}
+func (ClusterInfoSRCPHAIMock) GetInfrastructureTopology() openshiftconfigv1.TopologyMode {
+	return openshiftconfigv1.HighlyAvailableTopologyMode
+}
+func (ClusterInfoSRCPHAIMock) IsConsoleAvailable() bool {
+	return true
+}
+func (ClusterInfoSRCPHAIMock) GetManagedService() hcoutil.ManagedService {
+	return hcoutil.ManagedServiceNone
+}
 func (ClusterInfoSRCPHAIMock) IsInfrastructureHighlyAvailable() bool {
 	return true
 }
@@ -428,9 +538,24 @@ func (ClusterInfoSRCPHAIMock) GetBaseDomain() string {
 func (ClusterInfoSRCPHAIMock) IsConsolePluginImageProvided() bool {
 	return true
 }
+func (ClusterInfoSRCPHAIMock) GetMonitoringNamespace() string {
+	return "openshift-monitoring"
+}
+func (ClusterInfoSRCPHAIMock) GetMonitoringServiceAccount() string {
+	return "prometheus-k8s"
+}
 func (ClusterInfoSRCPHAIMock) IsMonitoringAvailable() bool {
 	return true
 }
+func (ClusterInfoSRCPHAIMock) RefreshMonitoringAvailability(_ context.Context, _ client.Client) bool {
+	return false
+}
+func (ClusterInfoSRCPHAIMock) IsScrapeConfigAvailable() bool {
+	return false
+}
+func (ClusterInfoSRCPHAIMock) IsAlertmanagerConfigAvailable() bool {
+	return false
+}
 func (m ClusterInfoSRCPHAIMock) IsSingleStackIPv6() bool {
 	return true
 }
@@ -443,3 +568,9 @@ func (ClusterInfoSRCPHAIMock) GetTLSSecurityProfile(_ *openshiftconfigv1.TLSSecu
 func (ClusterInfoSRCPHAIMock) RefreshAPIServerCR(_ context.Context, _ client.Client) error {
 	return nil
 }
+func (ClusterInfoSRCPHAIMock) RefreshClusterInfo(_ context.Context, _ client.Reader) (bool, error) {
+	return false, nil
+}
+func (ClusterInfoSRCPHAIMock) RefreshNodeTopology(_ context.Context, _ client.Reader) (bool, error) {
+	return false, nil
+}
@@ -0,0 +1,76 @@
+package hyperconverged
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// clusterInfoRefreshInterval is the interval between two refreshes of the cluster information
+const clusterInfoRefreshInterval = 5 * time.Minute
+
+// clusterInfoRefresher periodically reads again the cluster information that ClusterInfo reads when HCO is started,
+// like the cluster topology and domains, and triggers the reconciliation of the HyperConverged CR if it was changed,
+// so the change is applied to the operands without restarting HCO.
+//
+// HCO is not allowed to watch the cluster configuration CRs that this information is read from, so they are polled
+// directly from the API server.
+type clusterInfoRefresher struct {
+	reader   client.Reader
+	hc       types.NamespacedName
+	interval time.Duration
+	events   chan event.GenericEvent
+}
+
+func newClusterInfoRefresher(reader client.Reader, hc types.NamespacedName) *clusterInfoRefresher {
+	return &clusterInfoRefresher{
+		reader:   reader,
+		hc:       hc,
+		interval: clusterInfoRefreshInterval,
+		events:   make(chan event.GenericEvent, 1),
+	}
+}
+
+// Start implements manager.Runnable. It refreshes the cluster information until the context is done.
+func (r *clusterInfoRefresher) Start(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			r.refresh(ctx)
+		}
+	}
+}
+
+func (r *clusterInfoRefresher) refresh(ctx context.Context) {
+	changed, err := hcoutil.GetClusterInfo().RefreshClusterInfo(ctx, r.reader)
+	if err != nil {
+		log.Error(err, "failed to refresh the cluster information")
+		return
+	}
+
+	if !changed {
+		return
+	}
+
+	log.Info("The cluster information was changed; reconciling the HyperConverged CR")
+	hc := &hcov1beta1.HyperConverged{}
+	hc.Name = r.hc.Name
+	hc.Namespace = r.hc.Namespace
+
+	// a reconciliation that is already pending covers this change as well
+	select {
+	case r.events <- event.GenericEvent{Object: hc}:
+	default:
+	}
+}
@@ -0,0 +1,82 @@
+package hyperconverged
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("test the cluster information refresh", func() {
+	var refresher *clusterInfoRefresher
+
+	getClusterInfo := hcoutil.GetClusterInfo
+	hcName := types.NamespacedName{Name: hcoutil.HyperConvergedName, Namespace: commontestutils.Namespace}
+
+	setRefreshResult := func(changed bool, err error) {
+		hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+			return refreshClusterInfo{changed: changed, err: err}
+		}
+	}
+
+	BeforeEach(func() {
+		refresher = newClusterInfoRefresher(commontestutils.InitClient(nil), hcName)
+	})
+
+	AfterEach(func() {
+		hcoutil.GetClusterInfo = getClusterInfo
+	})
+
+	It("should trigger the reconciliation of the HyperConverged CR if the cluster information was changed", func() {
+		setRefreshResult(true, nil)
+
+		refresher.refresh(context.Background())
+
+		Expect(refresher.events).To(HaveLen(1))
+		evt := <-refresher.events
+		Expect(evt.Object.GetName()).To(Equal(hcName.Name))
+		Expect(evt.Object.GetNamespace()).To(Equal(hcName.Namespace))
+	})
+
+	It("should not trigger the reconciliation more than once while the previous one is pending", func() {
+		setRefreshResult(true, nil)
+
+		refresher.refresh(context.Background())
+		refresher.refresh(context.Background())
+
+		Expect(refresher.events).To(HaveLen(1))
+	})
+
+	It("should not trigger the reconciliation if the cluster information was not changed", func() {
+		setRefreshResult(false, nil)
+
+		refresher.refresh(context.Background())
+
+		Expect(refresher.events).To(BeEmpty())
+	})
+
+	It("should not trigger the reconciliation if the cluster information can't be read", func() {
+		setRefreshResult(false, errors.New("fake error"))
+
+		refresher.refresh(context.Background())
+
+		Expect(refresher.events).To(BeEmpty())
+	})
+})
+
+// refreshClusterInfo simulates the refresh of the cluster information
+type refreshClusterInfo struct {
+	commontestutils.ClusterInfoMock
+	changed bool
+	err     error
+}
+
+func (c refreshClusterInfo) RefreshClusterInfo(_ context.Context, _ client.Reader) (bool, error) {
+	return c.changed, c.err
+}
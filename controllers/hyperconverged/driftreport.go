@@ -0,0 +1,42 @@
+package hyperconverged
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+// reportConfigurationDrift generates a new configuration drift report, if the value of the configuration drift report
+// annotation was changed since the last report. The report reflects the state of the operands before HCO reconciles
+// them; it must be called before ensuring the operands.
+//
+// Removing the annotation removes the report.
+func (r *ReconcileHyperConverged) reportConfigurationDrift(req *common.HcoRequest) {
+	trigger, ok := req.Instance.Annotations[common.ConfigurationDriftReportAnnotationName]
+	if !ok {
+		if req.Instance.Status.ConfigurationDrift != nil {
+			req.Instance.Status.ConfigurationDrift = nil
+			req.StatusDirty = true
+		}
+		return
+	}
+
+	if req.Instance.Status.ConfigurationDrift != nil && req.Instance.Status.ConfigurationDrift.Trigger == trigger {
+		return
+	}
+
+	operands, err := r.operandHandler.ReportDrift(req)
+	if err != nil {
+		req.Logger.Error(err, "failed to generate the configuration drift report")
+		return
+	}
+
+	req.Logger.Info("Generated a configuration drift report", "trigger", trigger)
+	req.Instance.Status.ConfigurationDrift = &hcov1beta1.ConfigurationDriftReport{
+		Trigger:    trigger,
+		ReportTime: metav1.NewTime(common.Now()),
+		Operands:   operands,
+	}
+	req.StatusDirty = true
+}
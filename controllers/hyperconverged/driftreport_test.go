@@ -0,0 +1,69 @@
+package hyperconverged
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+)
+
+var _ = Describe("test configuration drift report", func() {
+	It("should not generate a report if the annotation is missing", func() {
+		hco := commontestutils.NewHco()
+		cl := commontestutils.InitClient([]client.Object{hco})
+		r := initReconciler(cl, nil)
+		req := commontestutils.NewReq(hco)
+
+		r.reportConfigurationDrift(req)
+		Expect(hco.Status.ConfigurationDrift).To(BeNil())
+		Expect(req.StatusDirty).To(BeFalse())
+	})
+
+	It("should generate a report once per annotation value", func() {
+		hco := commontestutils.NewHco()
+		hco.Annotations = map[string]string{common.ConfigurationDriftReportAnnotationName: "1"}
+
+		kv, err := operands.NewKubeVirt(hco)
+		Expect(err).ToNot(HaveOccurred())
+		kv.Spec.Configuration.MachineType = "fake-machine-type"
+
+		cl := commontestutils.InitClient([]client.Object{hco, kv})
+		r := initReconciler(cl, nil)
+		req := commontestutils.NewReq(hco)
+
+		r.reportConfigurationDrift(req)
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.ConfigurationDrift).ToNot(BeNil())
+		Expect(hco.Status.ConfigurationDrift.Trigger).To(Equal("1"))
+		Expect(hco.Status.ConfigurationDrift.Operands).To(ContainElement(hcov1beta1.OperandDrift{
+			Kind:      "KubeVirt",
+			Name:      kv.Name,
+			Namespace: kv.Namespace,
+			DiffPaths: []string{"spec.configuration.machineType"},
+		}))
+		Expect(hco.Status.ConfigurationDrift.Operands).To(ContainElement(HaveField("Missing", BeTrue())))
+
+		By("not generating a new report for the same annotation value")
+		req = commontestutils.NewReq(hco)
+		r.reportConfigurationDrift(req)
+		Expect(req.StatusDirty).To(BeFalse())
+
+		By("generating a new report when the annotation value is changed")
+		hco.Annotations[common.ConfigurationDriftReportAnnotationName] = "2"
+		req = commontestutils.NewReq(hco)
+		r.reportConfigurationDrift(req)
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.ConfigurationDrift.Trigger).To(Equal("2"))
+
+		By("removing the report when the annotation is removed")
+		delete(hco.Annotations, common.ConfigurationDriftReportAnnotationName)
+		req = commontestutils.NewReq(hco)
+		r.reportConfigurationDrift(req)
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.ConfigurationDrift).To(BeNil())
+	})
+})
@@ -0,0 +1,63 @@
+package hyperconverged
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+// startForceResync drops the cached state of the operand handlers, if the value of the force resync annotation was
+// changed since the last resync, so the operands are re-rendered and re-applied by the current reconciliation. It must
+// be called before ensuring the operands.
+//
+// Removing the annotation removes the resync status.
+func (r *ReconcileHyperConverged) startForceResync(req *common.HcoRequest) {
+	trigger, ok := req.Instance.Annotations[common.ForceResyncAnnotationName]
+	if !ok {
+		if req.Instance.Status.ForceResync != nil {
+			req.Instance.Status.ForceResync = nil
+			req.StatusDirty = true
+		}
+		return
+	}
+
+	if req.Instance.Status.ForceResync != nil && req.Instance.Status.ForceResync.Trigger == trigger {
+		return
+	}
+
+	req.Logger.Info("Forcing a resync of all the operands", "trigger", trigger)
+	r.operandHandler.Reset()
+	req.ForceResync = true
+	req.Rewritten = nil
+}
+
+// completeForceResync records the forced resync in the HyperConverged status, and emits an event with the objects
+// that were rewritten. It must be called only after all the operands were successfully ensured; otherwise, the resync
+// is retried by the next reconciliation.
+func (r *ReconcileHyperConverged) completeForceResync(req *common.HcoRequest) {
+	if !req.ForceResync {
+		return
+	}
+
+	trigger := req.Instance.Annotations[common.ForceResyncAnnotationName]
+
+	msg := fmt.Sprintf("Forced resync %q: all the operands are up to date", trigger)
+	if len(req.Rewritten) > 0 {
+		msg = fmt.Sprintf("Forced resync %q: rewrote %d objects: %s", trigger, len(req.Rewritten), strings.Join(req.Rewritten, ", "))
+	}
+	req.Logger.Info(msg)
+	r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "ForceResync", msg)
+
+	req.Instance.Status.ForceResync = &hcov1beta1.ForceResyncStatus{
+		Trigger:    trigger,
+		ResyncTime: metav1.NewTime(common.Now()),
+		Rewritten:  req.Rewritten,
+	}
+	req.StatusDirty = true
+	req.ForceResync = false
+}
@@ -0,0 +1,129 @@
+package hyperconverged
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+	"github.com/kubevirt/hyperconverged-cluster-operator/version"
+)
+
+var _ = Describe("test force resync", func() {
+	var (
+		expected *BasicExpected
+		hco      *hcov1beta1.HyperConverged
+		r        *ReconcileHyperConverged
+	)
+
+	getClusterInfo := hcoutil.GetClusterInfo
+
+	BeforeEach(func() {
+		hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+			return commontestutils.ClusterInfoMock{}
+		}
+
+		_ = os.Setenv("VIRTIOWIN_CONTAINER", commontestutils.VirtioWinImage)
+		_ = os.Setenv("OPERATOR_NAMESPACE", namespace)
+		_ = os.Setenv(hcoutil.HcoKvIoVersionName, version.Version)
+
+		expected = getBasicDeployment()
+		hco = expected.hco
+	})
+
+	AfterEach(func() {
+		hcoutil.GetClusterInfo = getClusterInfo
+	})
+
+	resync := func() *common.HcoRequest {
+		req := commontestutils.NewReq(hco)
+		r.startForceResync(req)
+		Expect(r.operandHandler.Ensure(req)).To(Succeed())
+		r.completeForceResync(req)
+		return req
+	}
+
+	It("should not resync if the annotation is missing", func() {
+		r = initReconciler(expected.initClient(), nil)
+
+		req := resync()
+		Expect(req.ForceResync).To(BeFalse())
+		Expect(hco.Status.ForceResync).To(BeNil())
+		Expect(r.eventEmitter.(*commontestutils.EventEmitterMock).CheckEvents([]commontestutils.MockEvent{
+			{EventType: corev1.EventTypeNormal, Reason: "ForceResync"},
+		})).To(BeFalse())
+	})
+
+	It("should resync once per annotation value, and report the rewritten objects", func() {
+		hco.Annotations = map[string]string{common.ForceResyncAnnotationName: "1"}
+
+		kv := expected.kv
+		kv.Spec.Configuration.MachineType = "fake-machine-type"
+
+		r = initReconciler(expected.initClient(), nil)
+
+		req := resync()
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.ForceResync).ToNot(BeNil())
+		Expect(hco.Status.ForceResync.Trigger).To(Equal("1"))
+
+		kvName := fmt.Sprintf("KubeVirt %s", kv.Name)
+		Expect(hco.Status.ForceResync.Rewritten).To(ContainElement(kvName))
+
+		events := r.eventEmitter.(*commontestutils.EventEmitterMock)
+		Expect(events.CheckEvents([]commontestutils.MockEvent{
+			{
+				EventType: corev1.EventTypeNormal,
+				Reason:    "ForceResync",
+				Msg:       fmt.Sprintf("Forced resync %q: rewrote %d objects: %s", "1", len(req.Rewritten), strings.Join(req.Rewritten, ", ")),
+			},
+		})).To(BeTrue())
+
+		By("not resyncing again for the same annotation value")
+		events.Reset()
+		req = resync()
+		Expect(req.ForceResync).To(BeFalse())
+		Expect(hco.Status.ForceResync.Trigger).To(Equal("1"))
+		Expect(events.CheckEvents([]commontestutils.MockEvent{
+			{
+				EventType: corev1.EventTypeNormal,
+				Reason:    "ForceResync",
+				Msg:       fmt.Sprintf("Forced resync %q: all the operands are up to date", "1"),
+			},
+		})).To(BeFalse())
+
+		By("resyncing again when the annotation value is changed")
+		hco.Annotations[common.ForceResyncAnnotationName] = "2"
+		req = resync()
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.ForceResync.Trigger).To(Equal("2"))
+		Expect(hco.Status.ForceResync.Rewritten).ToNot(ContainElement(kvName))
+
+		By("removing the resync status when the annotation is removed")
+		delete(hco.Annotations, common.ForceResyncAnnotationName)
+		req = resync()
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.ForceResync).To(BeNil())
+	})
+
+	It("should retry the resync if the operands were not ensured", func() {
+		hco.Annotations = map[string]string{common.ForceResyncAnnotationName: "1"}
+		r = initReconciler(expected.initClient(), nil)
+
+		req := commontestutils.NewReq(hco)
+		r.startForceResync(req)
+		Expect(req.ForceResync).To(BeTrue())
+		Expect(hco.Status.ForceResync).To(BeNil())
+
+		req = commontestutils.NewReq(hco)
+		r.startForceResync(req)
+		Expect(req.ForceResync).To(BeTrue())
+	})
+})
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"strings"
+	"time"
 
 	"github.com/blang/semver/v4"
 	jsonpatch "github.com/evanphx/json-patch/v5"
@@ -18,20 +20,28 @@ import (
 	operatorhandler "github.com/operator-framework/operator-lib/handler"
 	"github.com/pkg/errors"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimetav1 "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/strings/slices"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -58,6 +68,23 @@ var (
 	randomConstSuffix = ""
 )
 
+// the resources of the monitoring reconciler, that are only watched if the monitoring CRDs are installed
+var monitoringResources = []client.Object{
+	&monitoringv1.ServiceMonitor{},
+	&monitoringv1.PrometheusRule{},
+	&monitoringv1.PodMonitor{},
+}
+
+// getMonitoringResources returns the monitoring resources to watch. The ScrapeConfig is only watched if its CRD is
+// installed.
+func getMonitoringResources(ci hcoutil.ClusterInfo) []client.Object {
+	resources := append([]client.Object{}, monitoringResources...)
+	if ci.IsScrapeConfigAvailable() {
+		resources = append(resources, &monitoringv1alpha1.ScrapeConfig{})
+	}
+	return resources
+}
+
 const (
 	// We cannot set owner reference of cluster-wide resources to namespaced HyperConverged object. Therefore,
 	// use finalizers to manage the cleanup.
@@ -65,19 +92,43 @@ const (
 
 	// OpenshiftNamespace is for resources that belong in the openshift namespace
 
-	reconcileInit               = "Init"
-	reconcileInitMessage        = "Initializing HyperConverged cluster"
-	reconcileCompleted          = "ReconcileCompleted"
-	reconcileCompletedMessage   = "Reconcile completed successfully"
-	invalidRequestReason        = "InvalidRequest"
-	invalidRequestMessageFormat = "Request does not match expected name (%v) and namespace (%v)"
-	commonDegradedReason        = "HCODegraded"
-	commonProgressingReason     = "HCOProgressing"
-	taintedConfigurationReason  = "UnsupportedFeatureAnnotation"
-	taintedConfigurationMessage = "Unsupported feature was activated via an HCO annotation"
-	systemHealthStatusHealthy   = "healthy"
-	systemHealthStatusWarning   = "warning"
-	systemHealthStatusError     = "error"
+	reconcileInit                 = "Init"
+	reconcileInitMessage          = "Initializing HyperConverged cluster"
+	reconcileCompleted            = "ReconcileCompleted"
+	reconcileCompletedMessage     = "Reconcile completed successfully"
+	invalidRequestReason          = "InvalidRequest"
+	invalidRequestMessageFormat   = "Request does not match expected name (%v) and namespace (%v)"
+	commonDegradedReason          = "HCODegraded"
+	commonProgressingReason       = "HCOProgressing"
+	taintedConfigurationReason    = "UnsupportedFeatureAnnotation"
+	taintedConfigurationMessage   = "Unsupported feature was activated via an HCO annotation"
+	goldenImageImportReason       = "GoldenImageImportFailed"
+	goldenImageImportMessageFmt   = "The following golden images are not up to date for more than %v: %s"
+	scratchSpaceSCReason          = "StorageClassNotFound"
+	scratchSpaceSCMessageFmt      = "The %q scratch space storage class does not exist; the default storage class is used instead"
+	migrationNetworkReason        = "NetworkAttachmentDefinitionNotFound"
+	migrationNetworkMessageFmt    = "The %q live migration network does not exist in the %s namespace"
+	unsupportedFeatureGatesReason = "UnsupportedNodeArchitecture"
+	upgradeTimedOutReason         = "UpgradeTimedOut"
+	unsupportedTopologyReason     = "UnsupportedClusterTopology"
+	multipleOperandsReason        = "MultipleOperandsNotUpgradeable"
+	upgradeTimedOutMessageFmt     = "The upgrade to version %s is not completed for more than %v; the following operands did not reach their expected version: %s"
+
+	systemHealthStatusHealthy = common.SystemHealthStatusHealthy
+	systemHealthStatusWarning = common.SystemHealthStatusWarning
+	systemHealthStatusError   = common.SystemHealthStatusError
+
+	// goldenImageImportFailingThreshold is how long a golden image may stay not up to date, before HCO raises the
+	// GoldenImageImportFailing condition
+	goldenImageImportFailingThreshold = time.Hour
+
+	// sspManagedByLabelValue is the value of the app.kubernetes.io/managed-by label, that SSP sets on the
+	// DataImportCrons it creates for the golden images
+	sspManagedByLabelValue = "ssp-operator"
+
+	// defaultUpgradeTimeout is how long the upgrade may take, before HCO raises the UpgradeTimedOut condition. Use the
+	// UPGRADE_TIMEOUT environment variable to override it.
+	defaultUpgradeTimeout = 2 * time.Hour
 
 	hcoVersionName    = "operator"
 	secondaryCRPrefix = "hco-controlled-cr-"
@@ -96,11 +147,13 @@ var JSONPatchAnnotationNames = []string{
 	common.JSONPatchCDIAnnotationName,
 	common.JSONPatchCNAOAnnotationName,
 	common.JSONPatchSSPAnnotationName,
+	common.JSONPatchMTQAnnotationName,
 }
 
-// RegisterReconciler creates a new HyperConverged Reconciler and registers it into manager.
-func RegisterReconciler(mgr manager.Manager, ci hcoutil.ClusterInfo, upgradeableCond hcoutil.Condition) error {
-	return add(mgr, newReconciler(mgr, ci, upgradeableCond), ci)
+// RegisterReconciler creates a new HyperConverged Reconciler and registers it into manager. The HyperConverged CR is
+// also reconciled on the events of the nodeEvents channel, that are sent when the node topology was changed.
+func RegisterReconciler(mgr manager.Manager, ci hcoutil.ClusterInfo, upgradeableCond hcoutil.Condition, nodeEvents <-chan event.GenericEvent) error {
+	return add(mgr, newReconciler(mgr, ci, upgradeableCond), ci, nodeEvents)
 }
 
 // newReconciler returns a new reconcile.Reconciler
@@ -113,10 +166,12 @@ func newReconciler(mgr manager.Manager, ci hcoutil.ClusterInfo, upgradeableCond
 
 	r := &ReconcileHyperConverged{
 		client:               mgr.GetClient(),
+		apiReader:            mgr.GetAPIReader(),
 		scheme:               mgr.GetScheme(),
-		operandHandler:       operands.NewOperandHandler(mgr.GetClient(), mgr.GetScheme(), ci, hcoutil.GetEventEmitter()),
+		operandHandler:       operands.NewOperandHandler(mgr.GetClient(), mgr.GetAPIReader(), mgr.GetScheme(), ci, hcoutil.GetEventEmitter()),
 		upgradeMode:          false,
 		ownVersion:           ownVersion,
+		upgradeTimeout:       getUpgradeTimeout(),
 		eventEmitter:         hcoutil.GetEventEmitter(),
 		firstLoop:            true,
 		upgradeableCondition: upgradeableCond,
@@ -129,6 +184,23 @@ func newReconciler(mgr manager.Manager, ci hcoutil.ClusterInfo, upgradeableCond
 	return r
 }
 
+// getUpgradeTimeout reads the upgrade timeout from the UPGRADE_TIMEOUT environment variable, or returns the default
+// timeout if it is not set or not valid
+func getUpgradeTimeout() time.Duration {
+	value, found := os.LookupEnv(hcoutil.UpgradeTimeoutEnvV)
+	if !found || value == "" {
+		return defaultUpgradeTimeout
+	}
+
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		log.Error(err, "invalid upgrade timeout; using the default timeout", "value", value, "default", defaultUpgradeTimeout.String())
+		return defaultUpgradeTimeout
+	}
+
+	return timeout
+}
+
 // newCRDremover returns a new CRDRemover
 func newCRDremover(client client.Client) *CRDRemover {
 	crdRemover := &CRDRemover{
@@ -150,7 +222,7 @@ func newCRDremover(client client.Client) *CRDRemover {
 }
 
 // add adds a new Controller to mgr with r as the reconcile.Reconciler
-func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo) error {
+func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo, nodeEvents <-chan event.GenericEvent) error {
 	// Create a new controller
 	c, err := controller.New("hyperconverged-controller", mgr, controller.Options{Reconciler: r})
 	if err != nil {
@@ -185,47 +257,75 @@ func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo) er
 		&corev1.Service{},
 		&rbacv1.Role{},
 		&rbacv1.RoleBinding{},
+		&networkingv1.NetworkPolicy{},
 	}
 	if ci.IsMonitoringAvailable() {
-		secondaryResources = append(secondaryResources, []client.Object{
-			&monitoringv1.ServiceMonitor{},
-			&monitoringv1.PrometheusRule{},
-		}...)
+		secondaryResources = append(secondaryResources, getMonitoringResources(ci)...)
 	}
 	if ci.IsOpenshift() {
 		secondaryResources = append(secondaryResources, []client.Object{
 			&sspv1beta2.SSP{},
 			&corev1.Service{},
 			&routev1.Route{},
-			&consolev1.ConsoleCLIDownload{},
-			&consolev1.ConsoleQuickStart{},
-			&consolev1.ConsolePlugin{},
 			&imagev1.ImageStream{},
 			&corev1.Namespace{},
 			&appsv1.Deployment{},
 		}...)
 	}
+	if ci.IsConsoleAvailable() {
+		secondaryResources = append(secondaryResources, []client.Object{
+			&consolev1.ConsoleCLIDownload{},
+			&consolev1.ConsoleQuickStart{},
+			&consolev1.ConsolePlugin{},
+		}...)
+	}
 
 	// Watch secondary resources
 	for _, resource := range secondaryResources {
-		msg := fmt.Sprintf("Reconciling for %T", resource)
-		err = c.Watch(
-			source.Kind(mgr.GetCache(), resource),
-			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
-				// enqueue using a placeholder to be able to discriminate request triggered
-				// by changes on the HyperConverged object from request triggered by changes
-				// on a secondary CR controlled by HCO
-				log.Info(msg)
-				return []reconcile.Request{
-					{NamespacedName: secCRPlaceholder},
-				}
-			}),
-		)
-		if err != nil {
+		if err = watchSecondaryResource(c, mgr, resource, secCRPlaceholder); err != nil {
 			return err
 		}
 	}
 
+	// The monitoring CRDs may be installed or removed while HCO is running. Watch them, to enable or disable the
+	// monitoring reconciler accordingly.
+	err = c.Watch(
+		source.Kind(mgr.GetCache(), &apiextensionsv1.CustomResourceDefinition{}),
+		handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+			log.Info("Reconciling for a monitoring CRD", "name", a.GetName())
+			return []reconcile.Request{
+				{NamespacedName: secCRPlaceholder},
+			}
+		}),
+		predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			return hcoutil.IsMonitoringCRD(obj.GetName())
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	if hcoReconciler, ok := r.(*ReconcileHyperConverged); ok && !ci.IsMonitoringAvailable() {
+		// The monitoring resources are not in the custom cache, because their CRDs were missing when HCO started.
+		// Watching them will create informers of the default cache for them, once their CRDs are installed.
+		hcoReconciler.startMonitoringWatches = func() error {
+			for _, resource := range getMonitoringResources(ci) {
+				if err := watchSecondaryResource(c, mgr, resource, secCRPlaceholder); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	if hcoReconciler, ok := r.(*ReconcileHyperConverged); ok && ci.IsOpenshift() {
+		// The DataImportCron CRD is deployed by CDI, so it may be missing when HCO starts. The DataImportCrons are
+		// watched using a dedicated cache, once the CRD is installed, and only the ones created by SSP are cached.
+		hcoReconciler.startDataImportCronWatch = func() error {
+			return watchDataImportCrons(c, mgr, secCRPlaceholder)
+		}
+	}
+
 	apiServerCRPlaceholder, err := getAPIServerCRPlaceholder()
 	if err != nil {
 		return err
@@ -250,7 +350,125 @@ func add(mgr manager.Manager, r reconcile.Reconciler, ci hcoutil.ClusterInfo) er
 			return err
 		}
 	}
-	return nil
+
+	hcNamespacedName, err := getHyperConvergedNamespacedName()
+	if err != nil {
+		return err
+	}
+
+	if ci.IsOpenshift() {
+		// copy a rotated trusted CA bundle, without waiting for an unrelated reconciliation
+		if err = watchTrustedCABundle(c, mgr, hcNamespacedName); err != nil {
+			return err
+		}
+	}
+
+	// reconcile the HyperConverged CR when the cluster information, that is read when HCO is started, is changed
+	refresher := newClusterInfoRefresher(mgr.GetAPIReader(), hcNamespacedName)
+	if err = mgr.Add(refresher); err != nil {
+		return err
+	}
+
+	if err = c.Watch(&source.Channel{Source: refresher.events}, &handler.EnqueueRequestForObject{}); err != nil {
+		return err
+	}
+
+	// reconcile the HyperConverged CR when nodes are added or removed, and the node topology was changed
+	return c.Watch(&source.Channel{Source: nodeEvents}, &handler.EnqueueRequestForObject{})
+}
+
+func watchSecondaryResource(c controller.Controller, mgr manager.Manager, resource client.Object, secCRPlaceholder types.NamespacedName) error {
+	msg := fmt.Sprintf("Reconciling for %T", resource)
+	return c.Watch(
+		source.Kind(mgr.GetCache(), resource),
+		handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+			// enqueue using a placeholder to be able to discriminate request triggered
+			// by changes on the HyperConverged object from request triggered by changes
+			// on a secondary CR controlled by HCO
+			log.Info(msg)
+			return []reconcile.Request{
+				{NamespacedName: secCRPlaceholder},
+			}
+		}),
+	)
+}
+
+// watchDataImportCrons reconciles the HyperConverged CR when the golden image DataImportCrons are changed, to keep
+// their import status and the GoldenImageImportFailing condition up to date.
+func watchDataImportCrons(c controller.Controller, mgr manager.Manager, secCRPlaceholder types.NamespacedName) error {
+	dicCache, err := cache.New(mgr.GetConfig(), cache.Options{
+		Scheme: mgr.GetScheme(),
+		Mapper: mgr.GetRESTMapper(),
+		ByObject: map[client.Object]cache.ByObject{
+			&cdiv1beta1.DataImportCron{}: {
+				Label: labels.SelectorFromSet(labels.Set{hcoutil.AppLabelManagedBy: sspManagedByLabelValue}),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = mgr.Add(dicCache); err != nil {
+		return err
+	}
+
+	return c.Watch(
+		source.Kind(dicCache, &cdiv1beta1.DataImportCron{}),
+		handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+			log.Info("Reconciling for a DataImportCron", "name", a.GetName(), "namespace", a.GetNamespace())
+			return []reconcile.Request{
+				{NamespacedName: secCRPlaceholder},
+			}
+		}),
+	)
+}
+
+// watchTrustedCABundle reconciles the HyperConverged CR when the trusted CA bundle ConfigMap is changed, to copy it to
+// the namespaces of the DataImportCronTemplates. The ConfigMap is created by the user, without the HCO labels, so it is
+// not in the cache of the manager. It is watched using a dedicated cache, that only caches the ConfigMaps of the HCO
+// namespace.
+func watchTrustedCABundle(c controller.Controller, mgr manager.Manager, hcNamespacedName types.NamespacedName) error {
+	cmCache, err := cache.New(mgr.GetConfig(), cache.Options{
+		Scheme: mgr.GetScheme(),
+		Mapper: mgr.GetRESTMapper(),
+		ByObject: map[client.Object]cache.ByObject{
+			&corev1.ConfigMap{}: {
+				Field: fields.Set{"metadata.namespace": hcNamespacedName.Namespace}.AsSelector(),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = mgr.Add(cmCache); err != nil {
+		return err
+	}
+
+	return c.Watch(
+		source.Kind(cmCache, &corev1.ConfigMap{}),
+		handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, a client.Object) []reconcile.Request {
+			log.Info("Reconciling for the trusted CA bundle ConfigMap", "name", a.GetName())
+			return []reconcile.Request{
+				{NamespacedName: hcNamespacedName},
+			}
+		}),
+		predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			return isTrustedCABundle(mgr.GetClient(), hcNamespacedName, obj)
+		}),
+	)
+}
+
+// isTrustedCABundle returns true if the ConfigMap is the one that is set in the trustedCABundle field of the
+// HyperConverged CR
+func isTrustedCABundle(cl client.Reader, hcNamespacedName types.NamespacedName, obj client.Object) bool {
+	hc := &hcov1beta1.HyperConverged{}
+	if err := cl.Get(context.Background(), hcNamespacedName, hc); err != nil {
+		return false
+	}
+
+	return hc.Spec.TrustedCABundle != nil && *hc.Spec.TrustedCABundle == obj.GetName()
 }
 
 var _ reconcile.Reconciler = &ReconcileHyperConverged{}
@@ -260,6 +478,7 @@ type ReconcileHyperConverged struct {
 	// This client, initialized using mgr.Client() above, is a split client
 	// that reads objects from the cache and writes to the apiserver
 	client               client.Client
+	apiReader            client.Reader
 	scheme               *runtime.Scheme
 	operandHandler       *operands.OperandHandler
 	upgradeMode          bool
@@ -268,6 +487,21 @@ type ReconcileHyperConverged struct {
 	firstLoop            bool
 	upgradeableCondition hcoutil.Condition
 	monitoringReconciler *alerts.MonitoringReconciler
+	// starts watching the monitoring resources, if their CRDs were not available when HCO started
+	startMonitoringWatches func() error
+	// starts watching the DataImportCrons, once their CRD is installed
+	startDataImportCronWatch func() error
+	// when the current upgrade started, and how long it may take before HCO reports it as timed out. The start time is
+	// only kept in memory, so the timeout restarts if the HCO pod is restarted during the upgrade.
+	upgradeStartTime time.Time
+	upgradeTimeout   time.Duration
+	// the operands that did not reach their expected version in the last reconciliation, while in upgrade mode
+	upgradePendingComponents []string
+	// the operands that are currently reported as timed out by the operandUpgradeTimedOut metric
+	upgradeTimedOutComponents []string
+	// the generation of the HyperConverged CR, and the time of the last garbage collection of the related objects
+	relatedObjectsGCGeneration int64
+	relatedObjectsGCTime       time.Time
 }
 
 // Reconcile reads that state of the cluster for a HyperConverged object and makes changes based on the state read
@@ -275,8 +509,11 @@ type ReconcileHyperConverged struct {
 // Note:
 // The Controller will requeue the Request to be processed again if the returned error is non-nil or
 // Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
-func (r *ReconcileHyperConverged) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+func (r *ReconcileHyperConverged) Reconcile(ctx context.Context, request reconcile.Request) (result reconcile.Result, err error) {
 	logger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	defer func() {
+		updateRequeueMetrics(result, err, logger)
+	}()
 
 	resolvedRequest, hcoTriggered, err := r.resolveReconcileRequest(ctx, logger, request)
 	if err != nil {
@@ -288,19 +525,26 @@ func (r *ReconcileHyperConverged) Reconcile(ctx context.Context, request reconci
 		r.operandHandler.Reset()
 	}
 
-	err = r.monitoringReconciler.Reconcile(hcoRequest, r.firstLoop)
-	if err != nil {
-		return reconcile.Result{}, err
-	}
-
 	// Fetch the HyperConverged instance
 	instance, err := r.getHyperConverged(hcoRequest)
 	if err != nil {
+		// the monitoring resources are rendered from the HyperConverged CR, so they are not reconciled if it can't be
+		// read; the default monitoring configuration would revert the configuration of the HyperConverged CR
 		return reconcile.Result{}, err
 	}
 
 	hcoRequest.Instance = instance
 
+	if err = r.refreshMonitoringAvailability(hcoRequest); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// the monitoring resources are reconciled even if the HyperConverged CR does not exist
+	err = r.monitoringReconciler.Reconcile(hcoRequest, r.firstLoop)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	if instance == nil {
 		// if the HyperConverged CR was deleted during an upgrade process, then this is not an upgrade anymore
 		r.upgradeMode = false
@@ -320,12 +564,16 @@ func (r *ReconcileHyperConverged) Reconcile(ctx context.Context, request reconci
 		}
 	}
 
+	if err = r.startDataImportCronWatchIfAvailable(hcoRequest); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	if err = r.monitoringReconciler.UpdateRelatedObjects(hcoRequest); err != nil {
 		logger.Error(err, "Failed to update the PrometheusRule as a related object")
 		return reconcile.Result{}, err
 	}
 
-	result, err := r.doReconcile(hcoRequest)
+	result, err = r.doReconcile(hcoRequest)
 	if err != nil {
 		r.eventEmitter.EmitEvent(hcoRequest.Instance, corev1.EventTypeWarning, "ReconcileError", err.Error())
 		return result, err
@@ -343,6 +591,58 @@ func (r *ReconcileHyperConverged) Reconcile(ctx context.Context, request reconci
 	return result, err
 }
 
+// refreshMonitoringAvailability enables the monitoring reconciler if the monitoring CRDs were installed after HCO was
+// started, and disables it if they were removed.
+func (r *ReconcileHyperConverged) refreshMonitoringAvailability(req *common.HcoRequest) error {
+	ci := hcoutil.GetClusterInfo()
+	if !ci.RefreshMonitoringAvailability(req.Ctx, r.client) {
+		return nil
+	}
+
+	if !ci.IsMonitoringAvailable() {
+		req.Logger.Info("The monitoring CRDs were removed; stop reconciling the monitoring resources")
+		r.monitoringReconciler = nil
+		return nil
+	}
+
+	req.Logger.Info("The monitoring CRDs were installed; start reconciling the monitoring resources")
+	if r.startMonitoringWatches != nil {
+		if err := r.startMonitoringWatches(); err != nil {
+			return err
+		}
+		// the watches are kept if the CRDs are removed, and resume when they are installed again
+		r.startMonitoringWatches = nil
+	}
+
+	r.monitoringReconciler = alerts.NewMonitoringReconciler(ci, r.client, r.eventEmitter, r.scheme)
+	return nil
+}
+
+// startDataImportCronWatchIfAvailable starts watching the DataImportCrons, if it was not started yet and their CRD is
+// already installed.
+func (r *ReconcileHyperConverged) startDataImportCronWatchIfAvailable(req *common.HcoRequest) error {
+	if r.startDataImportCronWatch == nil {
+		return nil
+	}
+
+	gvk := cdiv1beta1.SchemeGroupVersion.WithKind("DataImportCron")
+	if _, err := r.client.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version); err != nil {
+		if apimetav1.IsNoMatchError(err) {
+			// CDI did not install the CRD yet; try again in the next reconciliation
+			return nil
+		}
+		return err
+	}
+
+	req.Logger.Info("The DataImportCron CRD is installed; start watching the DataImportCrons")
+	if err := r.startDataImportCronWatch(); err != nil {
+		return err
+	}
+	r.startDataImportCronWatch = nil
+
+	return nil
+}
+
 // resolveReconcileRequest returns a reconcile.Request to be used throughout the reconciliation cycle,
 // regardless of which resource has triggered it.
 func (r *ReconcileHyperConverged) resolveReconcileRequest(ctx context.Context, logger logr.Logger, originalRequest reconcile.Request) (reconcile.Request, bool, error) {
@@ -422,6 +722,9 @@ func (r *ReconcileHyperConverged) doReconcile(req *common.HcoRequest) (reconcile
 	r.setLabels(req)
 
 	updateStatusGeneration(req)
+	if updateClusterTopology(req) {
+		r.warnOnSingleWorkerConfiguration(req)
+	}
 
 	// in-memory conditions should start off empty. It will only ever hold
 	// negative conditions (!Available, Degraded, Progressing)
@@ -448,7 +751,9 @@ func (r *ReconcileHyperConverged) doReconcile(req *common.HcoRequest) (reconcile
 		// get into upgrade mode
 
 		r.upgradeMode = true
-		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "UpgradeHCO", "Upgrading the HyperConverged to version "+r.ownVersion)
+		r.upgradeStartTime = time.Now()
+		r.upgradePendingComponents = nil
+		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "UpgradeHCO", fmt.Sprintf("Upgrading the HyperConverged from version %s to version %s", knownHcoVersion, r.ownVersion))
 		req.Logger.Info(fmt.Sprintf("Start upgrading from version %s to version %s", knownHcoVersion, r.ownVersion))
 	}
 
@@ -511,11 +816,17 @@ func (r *ReconcileHyperConverged) handleUpgrade(req *common.HcoRequest, init boo
 }
 
 func (r *ReconcileHyperConverged) EnsureOperandAndComplete(req *common.HcoRequest, init bool) (reconcile.Result, error) {
+	r.reportConfigurationDrift(req)
+	r.startForceResync(req)
+
 	if err := r.operandHandler.Ensure(req); err != nil {
 		r.updateConditions(req)
 		return reconcile.Result{Requeue: init}, nil
 	}
 
+	r.completeForceResync(req)
+	r.pruneRelatedObjects(req)
+
 	req.Logger.Info("Reconcile complete")
 
 	// Requeue if we just created everything
@@ -524,8 +835,9 @@ func (r *ReconcileHyperConverged) EnsureOperandAndComplete(req *common.HcoReques
 	}
 
 	r.completeReconciliation(req)
+	req.SetRequeueAfter(applyMaintenanceWindow(req))
 
-	return reconcile.Result{}, nil
+	return reconcile.Result{RequeueAfter: req.RequeueAfter}, nil
 }
 
 func updateStatusGeneration(req *common.HcoRequest) {
@@ -535,6 +847,50 @@ func updateStatusGeneration(req *common.HcoRequest) {
 	}
 }
 
+// updateClusterTopology reports the cluster topology, as detected by HCO, in the HyperConverged status, for the
+// benefit of the other components that need to adjust to it. Returns true if the topology was changed.
+func updateClusterTopology(req *common.HcoRequest) bool {
+	ci := hcoutil.GetClusterInfo()
+	topology := &hcov1beta1.ClusterTopology{
+		ControlPlane:     string(ci.GetControlPlaneTopology()),
+		Infrastructure:   string(ci.GetInfrastructureTopology()),
+		ConsoleAvailable: ci.IsConsoleAvailable(),
+		ManagedService:   string(ci.GetManagedService()),
+	}
+
+	if reflect.DeepEqual(req.Instance.Status.ClusterTopology, topology) {
+		return false
+	}
+
+	req.Instance.Status.ClusterTopology = topology
+	req.StatusDirty = true
+	return true
+}
+
+// warnOnSingleWorkerConfiguration emits warning events for the configuration that was accepted while the cluster was
+// highly available, but is not supported since nodes were removed, and the cluster has a single worker node.
+func (r *ReconcileHyperConverged) warnOnSingleWorkerConfiguration(req *common.HcoRequest) {
+	if hcoutil.GetClusterInfo().IsInfrastructureHighlyAvailable() {
+		return
+	}
+
+	if es := req.Instance.Spec.EvictionStrategy; es != nil && *es == kubevirtcorev1.EvictionStrategyLiveMigrate {
+		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, unsupportedTopologyReason,
+			fmt.Sprintf("the %s eviction strategy is not supported on single node clusters, and will block the node drain; use %s, %s or %s instead",
+				kubevirtcorev1.EvictionStrategyLiveMigrate,
+				kubevirtcorev1.EvictionStrategyNone,
+				kubevirtcorev1.EvictionStrategyLiveMigrateIfPossible,
+				kubevirtcorev1.EvictionStrategyExternal,
+			),
+		)
+	}
+
+	if mtq := req.Instance.Spec.FeatureGates.EnableManagedTenantQuota; mtq != nil && *mtq {
+		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, unsupportedTopologyReason,
+			"the EnableManagedTenantQuota feature gate is not supported on single node clusters; MTQ is removed")
+	}
+}
+
 // getHyperConverged gets the HyperConverged resource from the Kubernetes API.
 func (r *ReconcileHyperConverged) getHyperConverged(req *common.HcoRequest) (*hcov1beta1.HyperConverged, error) {
 	instance := &hcov1beta1.HyperConverged{}
@@ -554,6 +910,9 @@ func (r *ReconcileHyperConverged) getHyperConverged(req *common.HcoRequest) (*hc
 		if metricErr := metrics.HcoMetrics.SetHCOMetricHyperConvergedNotExists(); metricErr != nil {
 			req.Logger.Error(metricErr, "failed to update the HyperConvergedCRExists metric")
 		}
+		if metricErr := metrics.HcoMetrics.SetHCOMetricClusterReady(false); metricErr != nil {
+			req.Logger.Error(metricErr, "failed to update the clusterReady metric")
+		}
 
 		// Request object not found, could have been deleted after reconcile request.
 		// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
@@ -832,6 +1191,11 @@ func (r *ReconcileHyperConverged) aggregateComponentConditions(req *common.HcoRe
 		    you will only see CDI as it updates last).
 	*/
 	allComponentsAreUp := req.Conditions.IsEmpty()
+
+	if len(req.UpgradeBlockers) > 0 {
+		req.Conditions.SetStatusCondition(getUpgradeBlockersCondition(req))
+	}
+
 	req.Conditions.SetStatusCondition(metav1.Condition{
 		Type:               hcov1beta1.ConditionReconcileComplete,
 		Status:             metav1.ConditionTrue,
@@ -927,6 +1291,29 @@ func (r *ReconcileHyperConverged) aggregateComponentConditions(req *common.HcoRe
 	return allComponentsAreUp
 }
 
+// getUpgradeBlockersCondition returns the Upgradeable condition, with the reasons of all the operands that block the
+// upgrade. The Upgradeable condition of HCO is also propagated to the OperatorCondition, so OLM won't start an upgrade
+// while any operand blocks it.
+func getUpgradeBlockersCondition(req *common.HcoRequest) metav1.Condition {
+	reason := req.UpgradeBlockers[0].Reason
+	if len(req.UpgradeBlockers) > 1 {
+		reason = multipleOperandsReason
+	}
+
+	messages := make([]string, 0, len(req.UpgradeBlockers))
+	for _, blocker := range req.UpgradeBlockers {
+		messages = append(messages, blocker.Message)
+	}
+
+	return metav1.Condition{
+		Type:               hcov1beta1.ConditionUpgradeable,
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            strings.Join(messages, "; "),
+		ObservedGeneration: req.Instance.Generation,
+	}
+}
+
 func (r *ReconcileHyperConverged) completeReconciliation(req *common.HcoRequest) {
 	allComponentsAreUp := r.aggregateComponentConditions(req)
 
@@ -942,6 +1329,7 @@ func (r *ReconcileHyperConverged) completeReconciliation(req *common.HcoRequest)
 			req.StatusDirty = true
 
 			r.upgradeMode = false
+			r.upgradePendingComponents = nil
 			req.ComponentUpgradeInProgress = false
 			req.Logger.Info(fmt.Sprintf("Successfully upgraded to version %s", r.ownVersion))
 			r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "UpgradeHCO", fmt.Sprintf("Successfully upgraded to version %s", r.ownVersion))
@@ -953,6 +1341,8 @@ func (r *ReconcileHyperConverged) completeReconciliation(req *common.HcoRequest)
 	}
 
 	if r.upgradeMode {
+		r.upgradePendingComponents = req.UpgradePendingComponents
+
 		// override the Progressing condition during upgrade
 		req.Conditions.SetStatusCondition(metav1.Condition{
 			Type:               hcov1beta1.ConditionProgressing,
@@ -1002,6 +1392,18 @@ func (r *ReconcileHyperConverged) updateConditions(req *common.HcoRequest) {
 	// Detect a "TaintedConfiguration" state, and raise a corresponding event
 	r.detectTaintedConfiguration(req, &conditions)
 
+	r.detectPausedReconciliation(req)
+
+	r.detectGoldenImageImportFailure(req, &conditions)
+
+	r.detectMissingScratchSpaceStorageClass(req, &conditions)
+
+	r.detectMissingMigrationNetwork(req, &conditions)
+
+	r.detectUnsupportedFeatureGates(req, &conditions)
+
+	r.detectUpgradeTimeout(req, &conditions)
+
 	if !reflect.DeepEqual(conditions, req.Instance.Status.Conditions) {
 		req.Instance.Status.Conditions = conditions
 		req.StatusDirty = true
@@ -1014,9 +1416,30 @@ func (r *ReconcileHyperConverged) updateConditions(req *common.HcoRequest) {
 		req.StatusDirty = true
 	}
 
-	if metricErr := metrics.HcoMetrics.SetHCOMetricSystemHealthStatus(getNumericalHealthStatus(systemHealthStatus)); metricErr != nil {
+	if metricErr := metrics.HcoMetrics.SetHCOMetricSystemHealthStatus(common.GetNumericalHealthStatus(systemHealthStatus)); metricErr != nil {
 		req.Logger.Error(metricErr, "failed to update the systemHealthStatus metric")
 	}
+
+	if upgradeable, found := req.Conditions.GetCondition(hcov1beta1.ConditionUpgradeable); found && upgradeable.Status != metav1.ConditionUnknown {
+		if metricErr := metrics.HcoMetrics.SetHCOMetricUpgradeable(upgradeable.Status == metav1.ConditionTrue); metricErr != nil {
+			req.Logger.Error(metricErr, "failed to update the upgradeable metric")
+		}
+	}
+
+	if metricErr := metrics.HcoMetrics.SetHCOMetricClusterReady(isClusterReady(req.Conditions)); metricErr != nil {
+		req.Logger.Error(metricErr, "failed to update the clusterReady metric")
+	}
+}
+
+// isClusterReady returns true if the HyperConverged CR is fully reconciled and available
+func isClusterReady(conditions common.HcoConditions) bool {
+	reconcileComplete, found := conditions.GetCondition(hcov1beta1.ConditionReconcileComplete)
+	if !found || reconcileComplete.Status != metav1.ConditionTrue {
+		return false
+	}
+
+	available, found := conditions.GetCondition(hcov1beta1.ConditionAvailable)
+	return found && available.Status == metav1.ConditionTrue
 }
 
 func (r *ReconcileHyperConverged) setLabels(req *common.HcoRequest) {
@@ -1074,6 +1497,211 @@ func (r *ReconcileHyperConverged) detectTaintedConfiguration(req *common.HcoRequ
 	}
 }
 
+// detectPausedReconciliation updates the reconcile paused metric of each operand, according to the pause-reconcile
+// annotation, and emits an event when the reconciliation of an operand is paused or resumed
+func (r *ReconcileHyperConverged) detectPausedReconciliation(req *common.HcoRequest) {
+	paused, _ := operands.GetPausedOperands(req.Instance)
+
+	for _, operand := range operands.PausableOperands {
+		isPaused := slices.Contains(paused, operand)
+
+		wasPaused, err := metrics.HcoMetrics.IsOperandReconcilePaused(operand)
+		if err != nil {
+			req.Logger.Error(err, "couldn't read the 'OperandReconcilePaused' metric")
+		}
+
+		if isPaused != wasPaused {
+			if isPaused {
+				req.Logger.Info("The reconciliation of an operand is paused", "operand", operand)
+				r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, "ReconcilePaused",
+					fmt.Sprintf("HCO does not update the %s CR, because of the %s annotation", operand, common.PauseReconcileAnnotationName))
+			} else {
+				req.Logger.Info("The reconciliation of an operand is resumed", "operand", operand)
+				r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "ReconcileResumed",
+					fmt.Sprintf("HCO updates the %s CR again", operand))
+			}
+		}
+
+		if err = metrics.HcoMetrics.SetOperandReconcilePaused(operand, isPaused); err != nil {
+			req.Logger.Error(err, "couldn't update the 'OperandReconcilePaused' metric")
+		}
+	}
+}
+
+// detectGoldenImageImportFailure raises the GoldenImageImportFailing condition if the DataImportCron of any golden
+// image is not up to date for longer than goldenImageImportFailingThreshold, and updates the count of the golden
+// images that are not up to date.
+func (r *ReconcileHyperConverged) detectGoldenImageImportFailure(req *common.HcoRequest, conditions *[]metav1.Condition) {
+	notUpToDate := 0
+	var failing []string
+	for _, dict := range req.Instance.Status.DataImportCronTemplates {
+		importStatus := dict.Status.Import
+		if importStatus == nil || importStatus.UpToDate != metav1.ConditionFalse {
+			continue
+		}
+
+		notUpToDate++
+		if importStatus.LastTransitionTime == nil {
+			continue
+		}
+
+		if notUpToDateFor := time.Since(importStatus.LastTransitionTime.Time); notUpToDateFor > goldenImageImportFailingThreshold {
+			failing = append(failing, dict.Name)
+		} else {
+			// nothing else triggers a reconciliation when the threshold is passed
+			req.SetRequeueAfter(goldenImageImportFailingThreshold - notUpToDateFor)
+		}
+	}
+
+	if err := metrics.HcoMetrics.SetGoldenImagesNotUpToDateCount(notUpToDate); err != nil {
+		req.Logger.Error(err, "couldn't update the 'GoldenImagesNotUpToDate' metric")
+	}
+
+	if len(failing) > 0 {
+		apimetav1.SetStatusCondition(conditions, metav1.Condition{
+			Type:               hcov1beta1.ConditionGoldenImageImportFailing,
+			Status:             metav1.ConditionTrue,
+			Reason:             goldenImageImportReason,
+			Message:            fmt.Sprintf(goldenImageImportMessageFmt, goldenImageImportFailingThreshold, strings.Join(failing, ", ")),
+			ObservedGeneration: req.Instance.ObjectMeta.Generation,
+		})
+	} else {
+		apimetav1.RemoveStatusCondition(conditions, hcov1beta1.ConditionGoldenImageImportFailing)
+	}
+}
+
+// detectMissingScratchSpaceStorageClass raises the ScratchSpaceStorageClassNotFound condition if the storage class set
+// in spec.scratchSpaceStorageClass does not exist. In this case, CDI silently uses the default storage class for the
+// scratch space instead.
+func (r *ReconcileHyperConverged) detectMissingScratchSpaceStorageClass(req *common.HcoRequest, conditions *[]metav1.Condition) {
+	scName := req.Instance.Spec.ScratchSpaceStorageClass
+	if scName == nil || len(*scName) == 0 {
+		apimetav1.RemoveStatusCondition(conditions, hcov1beta1.ConditionScratchSpaceStorageClassNotFound)
+		return
+	}
+
+	err := r.client.Get(req.Ctx, client.ObjectKey{Name: *scName}, &storagev1.StorageClass{})
+	if err == nil {
+		apimetav1.RemoveStatusCondition(conditions, hcov1beta1.ConditionScratchSpaceStorageClassNotFound)
+		return
+	}
+
+	if !apierrors.IsNotFound(err) {
+		// keep the current state of the condition; it will be checked again in the next reconciliation
+		req.Logger.Error(err, "failed to read the scratch space storage class", "name", *scName)
+		return
+	}
+
+	apimetav1.SetStatusCondition(conditions, metav1.Condition{
+		Type:               hcov1beta1.ConditionScratchSpaceStorageClassNotFound,
+		Status:             metav1.ConditionTrue,
+		Reason:             scratchSpaceSCReason,
+		Message:            fmt.Sprintf(scratchSpaceSCMessageFmt, *scName),
+		ObservedGeneration: req.Instance.ObjectMeta.Generation,
+	})
+}
+
+// detectMissingMigrationNetwork raises the MigrationNetworkNotFound condition if the NetworkAttachmentDefinition set in
+// spec.liveMigrationConfig.network does not exist in the HCO namespace, for example if it was removed after it was
+// validated by the webhook.
+func (r *ReconcileHyperConverged) detectMissingMigrationNetwork(req *common.HcoRequest, conditions *[]metav1.Condition) {
+	network := req.Instance.Spec.LiveMigrationConfig.Network
+	if network == nil || len(*network) == 0 {
+		apimetav1.RemoveStatusCondition(conditions, hcov1beta1.ConditionMigrationNetworkNotFound)
+		return
+	}
+
+	exists, err := hcoutil.NetworkAttachmentDefinitionExists(req.Ctx, r.client, req.Instance.Namespace, *network)
+	if err != nil {
+		// keep the current state of the condition; it will be checked again in the next reconciliation
+		req.Logger.Error(err, "failed to read the live migration network", "name", *network)
+		return
+	}
+
+	if exists {
+		apimetav1.RemoveStatusCondition(conditions, hcov1beta1.ConditionMigrationNetworkNotFound)
+		return
+	}
+
+	apimetav1.SetStatusCondition(conditions, metav1.Condition{
+		Type:               hcov1beta1.ConditionMigrationNetworkNotFound,
+		Status:             metav1.ConditionTrue,
+		Reason:             migrationNetworkReason,
+		Message:            fmt.Sprintf(migrationNetworkMessageFmt, *network, req.Instance.Namespace),
+		ObservedGeneration: req.Instance.ObjectMeta.Generation,
+	})
+}
+
+// detectUnsupportedFeatureGates raises the UnsupportedFeatureGates condition if some of the enabled feature gates are
+// not supported on the architectures of the cluster nodes; HCO does not deploy the operands of these feature gates.
+func (r *ReconcileHyperConverged) detectUnsupportedFeatureGates(req *common.HcoRequest, conditions *[]metav1.Condition) {
+	unsupported := operands.GetUnsupportedFeatureGates(req.Instance)
+	if len(unsupported) == 0 {
+		apimetav1.RemoveStatusCondition(conditions, hcov1beta1.ConditionUnsupportedFeatureGates)
+		return
+	}
+
+	msgs := make([]string, 0, len(unsupported))
+	for _, fg := range unsupported {
+		msgs = append(msgs, fmt.Sprintf("the %s feature gate is not supported on the %s node architectures", fg.Name, strings.Join(fg.Architectures, ", ")))
+	}
+
+	apimetav1.SetStatusCondition(conditions, metav1.Condition{
+		Type:               hcov1beta1.ConditionUnsupportedFeatureGates,
+		Status:             metav1.ConditionTrue,
+		Reason:             unsupportedFeatureGatesReason,
+		Message:            strings.Join(msgs, "; "),
+		ObservedGeneration: req.Instance.ObjectMeta.Generation,
+	})
+}
+
+// detectUpgradeTimeout raises the UpgradeTimedOut condition if the upgrade is not completed within the upgrade timeout,
+// and updates the operandUpgradeTimedOut metric of the operands that did not reach their expected version.
+func (r *ReconcileHyperConverged) detectUpgradeTimeout(req *common.HcoRequest, conditions *[]metav1.Condition) {
+	var timedOut []string
+	if r.upgradeMode && !r.upgradeStartTime.IsZero() {
+		if upgradeDuration := time.Since(r.upgradeStartTime); upgradeDuration > r.upgradeTimeout {
+			timedOut = r.upgradePendingComponents
+		} else {
+			// a stalled upgrade may not trigger any reconciliation; make sure to check again once the timeout expires
+			req.SetRequeueAfter(r.upgradeTimeout - upgradeDuration)
+		}
+	}
+
+	if len(timedOut) > 0 && len(r.upgradeTimedOutComponents) == 0 {
+		req.Logger.Info("The upgrade is not completed on time", "timeout", r.upgradeTimeout.String(), "operands", timedOut)
+		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, upgradeTimedOutReason,
+			fmt.Sprintf("The upgrade to version %s is not completed on time; waiting for %s", r.ownVersion, strings.Join(timedOut, ", ")))
+	}
+
+	for _, operand := range r.upgradeTimedOutComponents {
+		if !slices.Contains(timedOut, operand) {
+			if err := metrics.HcoMetrics.SetOperandUpgradeTimedOut(operand, false); err != nil {
+				req.Logger.Error(err, "couldn't update the 'OperandUpgradeTimedOut' metric")
+			}
+		}
+	}
+
+	for _, operand := range timedOut {
+		if err := metrics.HcoMetrics.SetOperandUpgradeTimedOut(operand, true); err != nil {
+			req.Logger.Error(err, "couldn't update the 'OperandUpgradeTimedOut' metric")
+		}
+	}
+	r.upgradeTimedOutComponents = timedOut
+
+	if len(timedOut) > 0 {
+		apimetav1.SetStatusCondition(conditions, metav1.Condition{
+			Type:               hcov1beta1.ConditionUpgradeTimedOut,
+			Status:             metav1.ConditionTrue,
+			Reason:             upgradeTimedOutReason,
+			Message:            fmt.Sprintf(upgradeTimedOutMessageFmt, r.ownVersion, r.upgradeTimeout, strings.Join(timedOut, ", ")),
+			ObservedGeneration: req.Instance.ObjectMeta.Generation,
+		})
+	} else {
+		apimetav1.RemoveStatusCondition(conditions, hcov1beta1.ConditionUpgradeTimedOut)
+	}
+}
+
 func (r *ReconcileHyperConverged) getSystemHealthStatus(conditions common.HcoConditions) string {
 	if isSystemHealthStatusError(conditions) {
 		return systemHealthStatusError
@@ -1094,16 +1722,6 @@ func isSystemHealthStatusWarning(conditions common.HcoConditions) bool {
 	return !conditions.IsStatusConditionTrue(hcov1beta1.ConditionReconcileComplete) || conditions.IsStatusConditionTrue(hcov1beta1.ConditionProgressing)
 }
 
-func getNumericalHealthStatus(status string) float64 {
-	healthStatusCodes := map[string]float64{
-		systemHealthStatusHealthy: metrics.SystemHealthStatusHealthy,
-		systemHealthStatusWarning: metrics.SystemHealthStatusWarning,
-		systemHealthStatusError:   metrics.SystemHealthStatusError,
-	}
-
-	return healthStatusCodes[status]
-}
-
 func getNumOfChangesJSONPatch(jsonPatch string) int {
 	patches, err := jsonpatch.DecodePatch([]byte(jsonPatch))
 	if err != nil {
@@ -1200,14 +1818,19 @@ func (r *ReconcileHyperConverged) migrateBeforeUpgrade(req *common.HcoRequest) (
 		return false, err
 	}
 
+	// the spec migrations run after the upgrade patches, in case a patch sets a deprecated field
+	specMigrated := r.applySpecMigrations(req)
+
 	err = r.removeOldMetricsObjs(req)
 	if err != nil {
 		return false, err
 	}
 
-	removeOldQuickStartGuides(req, r.client, r.operandHandler.GetQuickStartNames())
+	if hcoutil.GetClusterInfo().IsConsoleAvailable() {
+		removeOldQuickStartGuides(req, r.client, r.operandHandler.GetQuickStartNames())
+	}
 
-	return upgradePatched, nil
+	return upgradePatched || specMigrated, nil
 }
 
 func (r *ReconcileHyperConverged) applyUpgradePatches(req *common.HcoRequest) (bool, error) {
@@ -1235,6 +1858,19 @@ func (r *ReconcileHyperConverged) applyUpgradePatches(req *common.HcoRequest) (b
 		}
 	}
 
+	// the hotfix patches are applied after the patches of the HCO image
+	hotfixPatches, err := readHotfixUpgradePatches(req, r.apiReader)
+	if err != nil {
+		return false, err
+	}
+
+	for _, p := range hotfixPatches {
+		hcoJSON, err = r.applyUpgradePatch(req, hcoJSON, knownHcoSV, p)
+		if err != nil {
+			return false, err
+		}
+	}
+
 	for _, p := range hcoUpgradeChanges.ObjectsToBeRemoved {
 		removed, err := r.removeLeftover(req, knownHcoSV, p)
 		if err != nil {
@@ -19,6 +19,7 @@ import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimetav1 "k8s.io/apimachinery/pkg/api/meta"
@@ -29,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/reference"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -93,6 +95,154 @@ var _ = Describe("HyperconvergedController", func() {
 				Expect(res).Should(Equal(reconcile.Result{}))
 				validateOperatorCondition(r, metav1.ConditionTrue, hcoutil.UpgradeableAllowReason, hcoutil.UpgradeableAllowMessage)
 				verifyHyperConvergedCRExistsMetricFalse()
+				verifyClusterReadyMetric(false)
+			})
+
+			It("should start reconciling the monitoring resources once the monitoring CRDs are installed", func() {
+				ci := &monitoringAvailabilityClusterInfo{available: true, changed: true}
+				hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+					return ci
+				}
+
+				cl := commontestutils.InitClient([]client.Object{hcoNamespace})
+				r := initReconciler(cl, nil)
+				watchesStarted := 0
+				r.startMonitoringWatches = func() error {
+					watchesStarted++
+					return nil
+				}
+				Expect(r.monitoringReconciler).To(BeNil())
+
+				_, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(r.monitoringReconciler).ToNot(BeNil())
+				Expect(watchesStarted).To(Equal(1))
+
+				pr := &monitoringv1.PrometheusRule{}
+				Expect(cl.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: hcoutil.HyperConvergedName + "-prometheus-rule"}, pr)).To(Succeed())
+
+				_, err = r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(watchesStarted).To(Equal(1))
+			})
+
+			It("should fail if it can't watch the monitoring resources", func() {
+				ci := &monitoringAvailabilityClusterInfo{available: true, changed: true}
+				hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+					return ci
+				}
+
+				cl := commontestutils.InitClient([]client.Object{hcoNamespace})
+				r := initReconciler(cl, nil)
+				r.startMonitoringWatches = func() error {
+					return errors.New("fake watch error")
+				}
+
+				_, err := r.Reconcile(context.TODO(), request)
+				Expect(err).To(MatchError("fake watch error"))
+				Expect(r.monitoringReconciler).To(BeNil())
+			})
+
+			It("should not reconcile the monitoring resources if the HyperConverged CR can't be read", func() {
+				hco := commontestutils.NewHco()
+				hco.Spec.Monitoring = &hcov1beta1.MonitoringConfig{RunbookURLTemplate: "https://runbooks.example.com/%s"}
+				cl := commontestutils.InitClient([]client.Object{hcoNamespace, hco})
+				r := initReconciler(cl, nil)
+				r.monitoringReconciler = alerts.NewMonitoringReconciler(commontestutils.ClusterInfoMock{}, cl, commontestutils.NewEventEmitterMock(), commontestutils.GetScheme())
+
+				_, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+
+				prKey := client.ObjectKey{Namespace: namespace, Name: hcoutil.HyperConvergedName + "-prometheus-rule"}
+				pr := &monitoringv1.PrometheusRule{}
+				Expect(cl.Get(context.TODO(), prKey, pr)).To(Succeed())
+				Expect(cl.Delete(context.TODO(), pr)).To(Succeed())
+
+				// without the HyperConverged CR, the PrometheusRule would be rendered with the default runbook URL
+				// template, reverting the template of the HyperConverged CR until the next successful reconciliation
+				cl.InitiateGetErrors(func(key client.ObjectKey) error {
+					if key == request.NamespacedName {
+						return errors.New("fake get error")
+					}
+					return nil
+				})
+
+				_, err = r.Reconcile(context.TODO(), request)
+				Expect(err).To(MatchError("fake get error"))
+
+				err = cl.Get(context.TODO(), prKey, &monitoringv1.PrometheusRule{})
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			})
+
+			It("should not watch the DataImportCrons before their CRD is installed", func() {
+				cl := commontestutils.InitClient([]client.Object{hcoNamespace})
+				r := initReconciler(cl, nil)
+				watchStarted := false
+				r.startDataImportCronWatch = func() error {
+					watchStarted = true
+					return nil
+				}
+
+				Expect(r.startDataImportCronWatchIfAvailable(commontestutils.NewReq(commontestutils.NewHco()))).To(Succeed())
+				Expect(watchStarted).To(BeFalse())
+				Expect(r.startDataImportCronWatch).ToNot(BeNil())
+			})
+
+			It("should watch the DataImportCrons once their CRD is installed", func() {
+				gvk := cdiv1beta1.SchemeGroupVersion.WithKind("DataImportCron")
+				mapper := apimetav1.NewDefaultRESTMapper([]schema.GroupVersion{gvk.GroupVersion()})
+				mapper.Add(gvk, apimetav1.RESTScopeNamespace)
+				cl := fake.NewClientBuilder().WithScheme(commontestutils.GetScheme()).WithRESTMapper(mapper).Build()
+
+				r := initReconciler(cl, nil)
+				watchesStarted := 0
+				r.startDataImportCronWatch = func() error {
+					watchesStarted++
+					return nil
+				}
+
+				req := commontestutils.NewReq(commontestutils.NewHco())
+				Expect(r.startDataImportCronWatchIfAvailable(req)).To(Succeed())
+				Expect(r.startDataImportCronWatchIfAvailable(req)).To(Succeed())
+				Expect(watchesStarted).To(Equal(1))
+			})
+
+			It("should only reconcile for the trusted CA bundle ConfigMap", func() {
+				hco := commontestutils.NewHco()
+				hco.Spec.TrustedCABundle = ptr.To("my-ca-bundle")
+				cl := commontestutils.InitClient([]client.Object{hcoNamespace, hco})
+				hcoKey := client.ObjectKeyFromObject(hco)
+
+				newConfigMap := func(name string) *corev1.ConfigMap {
+					return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+				}
+
+				Expect(isTrustedCABundle(cl, hcoKey, newConfigMap("my-ca-bundle"))).To(BeTrue())
+				Expect(isTrustedCABundle(cl, hcoKey, newConfigMap("other"))).To(BeFalse())
+
+				hco.Spec.TrustedCABundle = nil
+				Expect(cl.Update(context.TODO(), hco)).To(Succeed())
+				Expect(isTrustedCABundle(cl, hcoKey, newConfigMap("my-ca-bundle"))).To(BeFalse())
+			})
+
+			It("should stop reconciling the monitoring resources if the monitoring CRDs are removed", func() {
+				ci := &monitoringAvailabilityClusterInfo{available: false, changed: true}
+				hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+					return ci
+				}
+
+				cl := commontestutils.InitClient([]client.Object{hcoNamespace})
+				r := initReconciler(cl, nil)
+				r.monitoringReconciler = alerts.NewMonitoringReconciler(ci, cl, commontestutils.NewEventEmitterMock(), commontestutils.GetScheme())
+
+				_, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(r.monitoringReconciler).To(BeNil())
+
+				pr := &monitoringv1.PrometheusRule{}
+				err = cl.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: hcoutil.HyperConvergedName + "-prometheus-rule"}, pr)
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
 			})
 
 			It("should ignore invalid requests", func() {
@@ -198,6 +348,7 @@ var _ = Describe("HyperconvergedController", func() {
 				})))
 
 				verifySystemHealthStatusError(foundResource)
+				verifyClusterReadyMetric(false)
 
 				expectedFeatureGates := []string{
 					"DataVolumes",
@@ -239,7 +390,8 @@ var _ = Describe("HyperconvergedController", func() {
 				res, err = r.Reconcile(context.TODO(), request)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(res).Should(Equal(reconcile.Result{Requeue: false}))
-				validateOperatorCondition(r, metav1.ConditionTrue, hcoutil.UpgradeableAllowReason, hcoutil.UpgradeableAllowMessage)
+				// the operands did not report their conditions yet
+				validateOperatorCondition(r, metav1.ConditionFalse, multipleOperandsReason, "resource has no conditions")
 				verifyHyperConvergedCRExistsMetricTrue()
 
 				// Get the HCO
@@ -250,7 +402,7 @@ var _ = Describe("HyperconvergedController", func() {
 						foundResource),
 				).ToNot(HaveOccurred())
 				// Check conditions
-				Expect(foundResource.Status.RelatedObjects).To(HaveLen(22))
+				Expect(foundResource.Status.RelatedObjects).To(HaveLen(23))
 				expectedRef := corev1.ObjectReference{
 					Kind:            "PrometheusRule",
 					Namespace:       namespace,
@@ -327,7 +479,8 @@ var _ = Describe("HyperconvergedController", func() {
 				res, err = r.Reconcile(context.TODO(), request)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(res).Should(Equal(reconcile.Result{Requeue: false}))
-				validateOperatorCondition(r, metav1.ConditionTrue, hcoutil.UpgradeableAllowReason, hcoutil.UpgradeableAllowMessage)
+				// the operands did not report their conditions yet
+				validateOperatorCondition(r, metav1.ConditionFalse, multipleOperandsReason, "resource has no conditions")
 
 				// Get the HCO
 				foundResource = &hcov1beta1.HyperConverged{}
@@ -337,7 +490,7 @@ var _ = Describe("HyperconvergedController", func() {
 						foundResource),
 				).ToNot(HaveOccurred())
 				// Check conditions
-				Expect(foundResource.Status.RelatedObjects).To(HaveLen(23))
+				Expect(foundResource.Status.RelatedObjects).To(HaveLen(24))
 				expectedRef := corev1.ObjectReference{
 					Kind:            "MTQ",
 					Name:            "mtq-kubevirt-hyperconverged",
@@ -401,7 +554,7 @@ var _ = Describe("HyperconvergedController", func() {
 
 				verifySystemHealthStatusError(foundResource)
 
-				Expect(foundResource.Status.RelatedObjects).To(HaveLen(21))
+				Expect(foundResource.Status.RelatedObjects).To(HaveLen(22))
 				expectedRef := corev1.ObjectReference{
 					Kind:            "PrometheusRule",
 					Namespace:       namespace,
@@ -711,11 +864,13 @@ var _ = Describe("HyperconvergedController", func() {
 				})))
 
 				verifySystemHealthStatusHealthy(foundResource)
+				verifyUpgradeableMetric(true)
+				verifyClusterReadyMetric(true)
 			})
 
 			It("should increment counter when out-of-band change overwritten", func() {
 				hco := commontestutils.NewHco()
-				hco.Spec.Infra = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
+				hco.Spec.Infra = hcov1beta1.HyperConvergedInfraConfig{HyperConvergedConfig: hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}}
 				hco.Spec.Workloads = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
 				existingResource, err := operands.NewKubeVirt(hco, namespace)
 				Expect(err).ToNot(HaveOccurred())
@@ -732,6 +887,11 @@ var _ = Describe("HyperconvergedController", func() {
 
 				existingResource.Spec.Infra.NodePlacement.NodeSelector["key1"] = "BADvalue1"
 				existingResource.Spec.Workloads.NodePlacement.NodeSelector["key2"] = "BADvalue2"
+				existingResource.ManagedFields = []metav1.ManagedFieldsEntry{
+					{Manager: "hyperconverged-cluster-operator", Operation: metav1.ManagedFieldsOperationUpdate, Time: &metav1.Time{Time: time.Now().Add(-time.Hour)}},
+					{Manager: "kubectl-edit", Operation: metav1.ManagedFieldsOperationUpdate, Time: &metav1.Time{Time: time.Now().Add(-time.Minute)}},
+					{Manager: "virt-operator", Operation: metav1.ManagedFieldsOperationUpdate, Time: &metav1.Time{Time: time.Now()}, Subresource: "status"},
+				}
 
 				cl := commontestutils.InitClient([]client.Object{hcoNamespace, hco, existingResource})
 				r := initReconciler(cl, nil)
@@ -744,6 +904,8 @@ var _ = Describe("HyperconvergedController", func() {
 
 				counterValueBefore, err := metrics.HcoMetrics.GetOverwrittenModificationsCount(existingResource.Kind, existingResource.Name)
 				Expect(err).ToNot(HaveOccurred())
+				managerCounterValueBefore, err := metrics.HcoMetrics.GetOverwrittenModificationsCountByFieldManager(existingResource.Kind, existingResource.Name, existingResource.Namespace, "kubectl-edit")
+				Expect(err).ToNot(HaveOccurred())
 
 				// Do the reconcile
 				res, err := r.Reconcile(context.TODO(), rq)
@@ -771,11 +933,15 @@ var _ = Describe("HyperconvergedController", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(counterValueAfter).To(Equal(counterValueBefore + 1))
 
+				managerCounterValueAfter, err := metrics.HcoMetrics.GetOverwrittenModificationsCountByFieldManager(foundResource.Kind, foundResource.Name, foundResource.Namespace, "kubectl-edit")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(managerCounterValueAfter).To(Equal(managerCounterValueBefore + 1))
+
 			})
 
 			It("should not increment counter when CR was changed by HCO", func() {
 				hco := commontestutils.NewHco()
-				hco.Spec.Infra = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
+				hco.Spec.Infra = hcov1beta1.HyperConvergedInfraConfig{HyperConvergedConfig: hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}}
 				hco.Spec.Workloads = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
 				existingResource, err := operands.NewKubeVirt(hco, namespace)
 				Expect(err).ToNot(HaveOccurred())
@@ -914,7 +1080,7 @@ var _ = Describe("HyperconvergedController", func() {
 				).To(Succeed())
 
 				Expect(foundResource.Status.RelatedObjects).ToNot(BeNil())
-				Expect(foundResource.Status.RelatedObjects).Should(HaveLen(21))
+				Expect(foundResource.Status.RelatedObjects).Should(HaveLen(22))
 				Expect(foundResource.ObjectMeta.Finalizers).Should(Equal([]string{FinalizerName}))
 
 				// Now, delete HCO
@@ -1425,6 +1591,13 @@ var _ = Describe("HyperconvergedController", func() {
 				foundResource, reconciler, requeue := doReconcile(cl, expected.hco, nil)
 				Expect(requeue).To(BeTrue())
 				checkAvailability(foundResource, metav1.ConditionFalse)
+				Expect(reconciler.eventEmitter.(*commontestutils.EventEmitterMock).CheckEvents([]commontestutils.MockEvent{
+					{
+						EventType: corev1.EventTypeNormal,
+						Reason:    "UpgradeHCO",
+						Msg:       fmt.Sprintf("Upgrading the HyperConverged from version %s to version %s", oldVersion, newVersion),
+					},
+				})).To(BeTrue())
 				// check that the HCO version is not set, because upgrade is not completed
 				ver, ok := GetVersion(&foundResource.Status, hcoVersionName)
 				Expect(ok).To(BeTrue())
@@ -1454,6 +1627,13 @@ var _ = Describe("HyperconvergedController", func() {
 				foundResource, reconciler, requeue = doReconcile(cl, expected.hco, reconciler)
 				Expect(requeue).To(BeFalse())
 				checkAvailability(foundResource, metav1.ConditionTrue)
+				Expect(reconciler.eventEmitter.(*commontestutils.EventEmitterMock).CheckEvents([]commontestutils.MockEvent{
+					{
+						EventType: corev1.EventTypeNormal,
+						Reason:    "UpgradeHCO",
+						Msg:       "Successfully upgraded to version " + newVersion,
+					},
+				})).To(BeTrue())
 
 				ver, ok = GetVersion(&foundResource.Status, hcoVersionName)
 				Expect(ok).To(BeTrue())
@@ -1469,6 +1649,42 @@ var _ = Describe("HyperconvergedController", func() {
 				validateOperatorCondition(reconciler, metav1.ConditionTrue, hcoutil.UpgradeableAllowReason, hcoutil.UpgradeableAllowMessage)
 			})
 
+			It("should report the operands that are not upgraded within the upgrade timeout", func() {
+				// old HCO Version is set
+				UpdateVersion(&expected.hco.Status, hcoVersionName, oldVersion)
+
+				// CDI is not upgraded yet
+				expected.cdi.Status.ObservedVersion = oldComponentVersion
+
+				cl := expected.initClient()
+				foundResource, reconciler, _ := doReconcile(cl, expected.hco, nil)
+				Expect(apimetav1.FindStatusCondition(foundResource.Status.Conditions, hcov1beta1.ConditionUpgradeTimedOut)).To(BeNil())
+				Expect(reconciler.upgradePendingComponents).To(ConsistOf("CDI"))
+
+				// the upgrade started long ago
+				reconciler.upgradeStartTime = time.Now().Add(-3 * time.Hour)
+				foundResource, reconciler, _ = doReconcile(cl, foundResource, reconciler)
+
+				cond := apimetav1.FindStatusCondition(foundResource.Status.Conditions, hcov1beta1.ConditionUpgradeTimedOut)
+				Expect(cond).ToNot(BeNil())
+				Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+				Expect(cond.Message).To(HaveSuffix("the following operands did not reach their expected version: CDI"))
+				Expect(metrics.HcoMetrics.IsOperandUpgradeTimedOut("cdi")).To(BeTrue())
+
+				// now, complete the upgrade
+				expected.cdi.Status.ObservedVersion = newComponentVersion
+				expected.hco = foundResource
+				cl = expected.initClient()
+				foundResource, reconciler, _ = doReconcile(cl, expected.hco, reconciler)
+				foundResource, _, _ = doReconcile(cl, foundResource, reconciler)
+
+				ver, ok := GetVersion(&foundResource.Status, hcoVersionName)
+				Expect(ok).To(BeTrue())
+				Expect(ver).To(Equal(newVersion))
+				Expect(apimetav1.FindStatusCondition(foundResource.Status.Conditions, hcov1beta1.ConditionUpgradeTimedOut)).To(BeNil())
+				Expect(metrics.HcoMetrics.IsOperandUpgradeTimedOut("cdi")).To(BeFalse())
+			})
+
 			It("don't increase the overwrittenModifications metric during upgrade", func() {
 				// old HCO Version is set
 				UpdateVersion(&expected.hco.Status, hcoVersionName, oldVersion)
@@ -1888,6 +2104,7 @@ var _ = Describe("HyperconvergedController", func() {
 					oldCRDs              []*apiextensionsv1.CustomResourceDefinition
 					oldCRDRelatedObjects []corev1.ObjectReference
 					otherRelatedObjects  []corev1.ObjectReference
+					prometheusRule       *monitoringv1.PrometheusRule
 				)
 
 				BeforeEach(func() {
@@ -1971,6 +2188,12 @@ var _ = Describe("HyperconvergedController", func() {
 							ResourceVersion: "999",
 						},
 					}
+					prometheusRule = &monitoringv1.PrometheusRule{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "kubevirt-hyperconverged-prometheus-rule",
+							Namespace: "kubevirt-hyperconverged",
+						},
+					}
 				})
 
 				It("should remove v2v CRDs during upgrades", func() {
@@ -2050,7 +2273,7 @@ var _ = Describe("HyperconvergedController", func() {
 						Expect(v1.SetObjectReference(&expected.hco.Status.RelatedObjects, objRef)).ToNot(HaveOccurred())
 					}
 
-					resources := expected.toArray()
+					resources := append(expected.toArray(), prometheusRule)
 					for _, r := range currentCRDs {
 						resources = append(resources, r)
 					}
@@ -2090,7 +2313,7 @@ var _ = Describe("HyperconvergedController", func() {
 						Expect(v1.SetObjectReference(&expected.hco.Status.RelatedObjects, objRef)).ToNot(HaveOccurred())
 					}
 
-					resources := expected.toArray()
+					resources := append(expected.toArray(), prometheusRule)
 					for _, r := range currentCRDs {
 						resources = append(resources, r)
 					}
@@ -2101,8 +2324,10 @@ var _ = Describe("HyperconvergedController", func() {
 					foundResource, _, requeue := doReconcile(cl, expected.hco, nil)
 					Expect(requeue).To(BeFalse())
 
+					// the v2v CRDs are not removed, but the related objects that reference the missing v2v CRs are
+					// removed by the related objects garbage collection
 					for _, objRef := range oldCRDRelatedObjects {
-						Expect(foundResource.Status.RelatedObjects).To(ContainElement(objRef))
+						Expect(foundResource.Status.RelatedObjects).ToNot(ContainElement(objRef))
 					}
 					for _, objRef := range otherRelatedObjects {
 						Expect(foundResource.Status.RelatedObjects).To(ContainElement(objRef))
@@ -2157,6 +2382,78 @@ var _ = Describe("HyperconvergedController", func() {
 				})
 			})
 
+			Context("Hotfix upgrade patches", func() {
+				newHotfixConfigMap := func(data string) *corev1.ConfigMap {
+					return &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      hotfixUpgradePatchesConfigMapName,
+							Namespace: namespace,
+						},
+						Data: map[string]string{hotfixUpgradePatchesConfigMapKey: data},
+					}
+				}
+
+				It("should apply the hotfix upgrade patches from the ConfigMap", func() {
+					UpdateVersion(&expected.hco.Status, hcoVersionName, oldVersion)
+					cm := newHotfixConfigMap(`{"hcoCRPatchList": [{"semverRange": "<1.7.0", "jsonPatch": [{"op": "replace", "path": "/spec/liveMigrationConfig/progressTimeout", "value": 1000}]}]}`)
+
+					cl := commontestutils.InitClient(append(expected.toArray(), cm))
+					_, reconciler, requeue := doReconcile(cl, expected.hco, nil)
+					Expect(requeue).To(BeTrue())
+					foundResource, _, _ := doReconcile(cl, expected.hco, reconciler)
+					Expect(foundResource.Spec.LiveMigrationConfig.ProgressTimeout).To(HaveValue(Equal(int64(1000))))
+				})
+
+				It("should not apply the hotfix upgrade patches if the known version is not in the range", func() {
+					UpdateVersion(&expected.hco.Status, hcoVersionName, oldVersion)
+					cm := newHotfixConfigMap(`{"hcoCRPatchList": [{"semverRange": "<1.6.0", "jsonPatch": [{"op": "replace", "path": "/spec/liveMigrationConfig/progressTimeout", "value": 1000}]}]}`)
+
+					cl := commontestutils.InitClient(append(expected.toArray(), cm))
+					foundResource, _, _ := doReconcile(cl, expected.hco, nil)
+					Expect(foundResource.Spec.LiveMigrationConfig.ProgressTimeout).To(HaveValue(Equal(int64(150))))
+				})
+
+				It("should fail the upgrade if the hotfix upgrade patches are not valid", func() {
+					UpdateVersion(&expected.hco.Status, hcoVersionName, oldVersion)
+					cm := newHotfixConfigMap(`{"hcoCRPatchList": [{"semverRange": "<1.7.0", "jsonPatch": [{"op": "replace", "path": "/metadata/name", "value": "other"}]}]}`)
+
+					cl := commontestutils.InitClient(append(expected.toArray(), cm))
+					r := initReconciler(cl, nil)
+					r.firstLoop = false
+					r.ownVersion = newVersion
+
+					_, err := r.Reconcile(context.TODO(), request)
+					Expect(err).To(MatchError(ContainSubstring("can only modify spec fields")))
+
+					foundResource := &hcov1beta1.HyperConverged{}
+					Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(expected.hco), foundResource)).To(Succeed())
+					ver, ok := GetVersion(&foundResource.Status, hcoVersionName)
+					Expect(ok).To(BeTrue())
+					Expect(ver).To(Equal(oldVersion))
+				})
+			})
+
+			Context("Spec migrations", func() {
+				It("should migrate the deprecated fields during the upgrade", func() {
+					UpdateVersion(&expected.hco.Status, hcoVersionName, oldVersion)
+					expected.hco.Spec.MediatedDevicesConfiguration = &hcov1beta1.MediatedDevicesConfiguration{
+						MediatedDevicesTypes: []string{"nvidia-222"}, //nolint SA1019
+					}
+
+					cl := expected.initClient()
+					_, reconciler, requeue := doReconcile(cl, expected.hco, nil)
+					Expect(requeue).To(BeTrue())
+					foundResource, _, _ := doReconcile(cl, expected.hco, reconciler)
+
+					Expect(foundResource.Spec.MediatedDevicesConfiguration.MediatedDevicesTypes).To(BeEmpty()) //nolint SA1019
+					Expect(foundResource.Spec.MediatedDevicesConfiguration.MediatedDeviceTypes).To(Equal([]string{"nvidia-222"}))
+
+					Expect(foundResource.Status.SpecMigrations).To(HaveLen(1))
+					Expect(foundResource.Status.SpecMigrations[0].Name).To(Equal("mediatedDevicesTypes"))
+					Expect(foundResource.Status.SpecMigrations[0].Version).To(Equal(newVersion))
+				})
+			})
+
 			Context("remove old quickstart guides", func() {
 				It("should drop old quickstart guide", func() {
 					const oldQSName = "old-quickstart-guide"
@@ -2804,6 +3101,8 @@ var _ = Describe("HyperconvergedController", func() {
 				Expect(cd.Status).Should(BeEquivalentTo(metav1.ConditionFalse))
 				Expect(cd.Reason).Should(Equal(commonDegradedReason))
 				Expect(cd.Message).Should(Equal("HCO is not Upgradeable due to degraded components"))
+				verifyUpgradeableMetric(false)
+				verifyClusterReadyMetric(false)
 
 				By("operator condition should be true even the upgradeable is false")
 				validateOperatorCondition(r, metav1.ConditionTrue, hcoutil.UpgradeableAllowReason, hcoutil.UpgradeableAllowMessage)
@@ -2848,8 +3147,8 @@ var _ = Describe("HyperconvergedController", func() {
 				Expect(cd.Status).Should(BeEquivalentTo(metav1.ConditionFalse))
 				Expect(cd.Reason).Should(Equal("CDIProgressing"))
 
-				By("operator condition should be true even the upgradeable is false")
-				validateOperatorCondition(r, metav1.ConditionTrue, hcoutil.UpgradeableAllowReason, hcoutil.UpgradeableAllowMessage)
+				By("operator condition should be false, because CDI is progressing")
+				validateOperatorCondition(r, metav1.ConditionFalse, "CDIProgressing", "CDI is progressing: CDI Test Error message")
 			})
 
 			It("should be degraded when a component is degraded + !Available", func() {
@@ -2928,8 +3227,8 @@ var _ = Describe("HyperconvergedController", func() {
 				Expect(cd.Status).Should(BeEquivalentTo(metav1.ConditionFalse))
 				Expect(cd.Reason).Should(Equal("CDIProgressing"))
 
-				By("operator condition should be true even the upgradeable is false")
-				validateOperatorCondition(r, metav1.ConditionTrue, hcoutil.UpgradeableAllowReason, hcoutil.UpgradeableAllowMessage)
+				By("operator condition should be false, because CDI is progressing")
+				validateOperatorCondition(r, metav1.ConditionFalse, "CDIProgressing", "CDI is progressing: CDI Test Error message")
 			})
 
 			It("should be Progressing when a component is Progressing + !Available", func() {
@@ -2971,8 +3270,8 @@ var _ = Describe("HyperconvergedController", func() {
 				Expect(cd.Status).Should(BeEquivalentTo(metav1.ConditionFalse))
 				Expect(cd.Reason).Should(Equal("CDIProgressing"))
 
-				By("operator condition should be true even the upgradeable is false")
-				validateOperatorCondition(r, metav1.ConditionTrue, hcoutil.UpgradeableAllowReason, hcoutil.UpgradeableAllowMessage)
+				By("operator condition should be false, because CDI is progressing")
+				validateOperatorCondition(r, metav1.ConditionFalse, "CDIProgressing", "CDI is progressing: CDI Test Error message")
 			})
 
 			It("should be not Available when a component is not Available", func() {
@@ -3217,6 +3516,41 @@ var _ = Describe("HyperconvergedController", func() {
 				By("operator condition should be false")
 				validateOperatorCondition(r, metav1.ConditionFalse, "CDINotUpgradeable", "is not upgradeable:")
 			})
+
+			It("should report all the operands that block the upgrade", func() {
+				expected := getBasicDeployment()
+				conditionsv1.SetStatusCondition(&expected.cdi.Status.Conditions, conditionsv1.Condition{
+					Type:    conditionsv1.ConditionUpgradeable,
+					Status:  corev1.ConditionFalse,
+					Reason:  errorReason,
+					Message: "CDI Upgrade Error message",
+				})
+				conditionsv1.SetStatusCondition(&expected.ssp.Status.Conditions, conditionsv1.Condition{
+					Type:    conditionsv1.ConditionProgressing,
+					Status:  corev1.ConditionTrue,
+					Reason:  errorReason,
+					Message: "SSP Test Error message",
+				})
+
+				cl := expected.initClient()
+				foundResource, r, _ := doReconcile(cl, expected.hco, nil)
+
+				cd := apimetav1.FindStatusCondition(foundResource.Status.Conditions, hcov1beta1.ConditionUpgradeable)
+				Expect(cd.Status).Should(BeEquivalentTo(metav1.ConditionFalse))
+				Expect(cd.Reason).Should(Equal(multipleOperandsReason))
+				Expect(cd.Message).Should(Equal("CDI is not upgradeable: CDI Upgrade Error message; SSP is progressing: SSP Test Error message"))
+
+				By("operator condition should be false, with all the operands")
+				validateOperatorCondition(r, metav1.ConditionFalse, multipleOperandsReason, "CDI is not upgradeable: CDI Upgrade Error message; SSP is progressing: SSP Test Error message")
+
+				By("operator condition should be true again, when the operands are upgradeable")
+				expected.cdi.Status.Conditions = getGenericCompletedConditions()
+				expected.ssp.Status.Conditions = getGenericCompletedConditions()
+				expected.hco = foundResource
+				cl = expected.initClient()
+				_, r, _ = doReconcile(cl, expected.hco, r)
+				validateOperatorCondition(r, metav1.ConditionTrue, hcoutil.UpgradeableAllowReason, hcoutil.UpgradeableAllowMessage)
+			})
 		})
 
 		Context("Update Conflict Error", func() {
@@ -3267,6 +3601,593 @@ var _ = Describe("HyperconvergedController", func() {
 			})
 		})
 
+		Context("Detection of golden image import failures", func() {
+			var (
+				r   *ReconcileHyperConverged
+				req *common.HcoRequest
+			)
+
+			newDictStatus := func(name string, importStatus *hcov1beta1.DataImportCronImportStatus) hcov1beta1.DataImportCronTemplateStatus {
+				return hcov1beta1.DataImportCronTemplateStatus{
+					DataImportCronTemplate: hcov1beta1.DataImportCronTemplate{
+						ObjectMeta: metav1.ObjectMeta{Name: name},
+					},
+					Status: hcov1beta1.DataImportCronStatus{
+						CommonTemplate: true,
+						Import:         importStatus,
+					},
+				}
+			}
+
+			notUpToDateSince := func(since time.Duration) *hcov1beta1.DataImportCronImportStatus {
+				return &hcov1beta1.DataImportCronImportStatus{
+					UpToDate:           metav1.ConditionFalse,
+					Reason:             "ImportProgressing",
+					LastTransitionTime: ptr.To(metav1.NewTime(time.Now().Add(-since))),
+				}
+			}
+
+			BeforeEach(func() {
+				r = &ReconcileHyperConverged{}
+				req = commontestutils.NewReq(commontestutils.NewHco())
+			})
+
+			It("should not raise the condition if all the golden images are up to date", func() {
+				req.Instance.Status.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplateStatus{
+					newDictStatus("image1", &hcov1beta1.DataImportCronImportStatus{UpToDate: metav1.ConditionTrue}),
+					newDictStatus("image2", nil),
+				}
+
+				var conditions []metav1.Condition
+				r.detectGoldenImageImportFailure(req, &conditions)
+
+				Expect(conditions).To(BeEmpty())
+				verifyGoldenImagesNotUpToDateMetric(0)
+				Expect(req.RequeueAfter).To(BeZero())
+			})
+
+			It("should count the golden images that are not up to date, but not raise the condition before the threshold", func() {
+				req.Instance.Status.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplateStatus{
+					newDictStatus("image1", &hcov1beta1.DataImportCronImportStatus{UpToDate: metav1.ConditionTrue}),
+					newDictStatus("image2", notUpToDateSince(10*time.Minute)),
+					newDictStatus("image3", notUpToDateSince(time.Minute)),
+				}
+
+				var conditions []metav1.Condition
+				r.detectGoldenImageImportFailure(req, &conditions)
+
+				Expect(conditions).To(BeEmpty())
+				verifyGoldenImagesNotUpToDateMetric(2)
+			})
+
+			It("should requeue when the first golden image that is not up to date passes the threshold", func() {
+				req.Instance.Status.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplateStatus{
+					newDictStatus("image1", notUpToDateSince(10*time.Minute)),
+					newDictStatus("image2", notUpToDateSince(30*time.Minute)),
+					newDictStatus("image3", notUpToDateSince(2*time.Hour)),
+				}
+
+				var conditions []metav1.Condition
+				r.detectGoldenImageImportFailure(req, &conditions)
+
+				Expect(req.RequeueAfter).To(BeNumerically("~", 30*time.Minute, time.Minute))
+			})
+
+			It("should raise the condition if golden images are not up to date for longer than the threshold", func() {
+				req.Instance.Status.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplateStatus{
+					newDictStatus("image1", notUpToDateSince(2*time.Hour)),
+					newDictStatus("image2", notUpToDateSince(10*time.Minute)),
+					newDictStatus("image3", notUpToDateSince(3*time.Hour)),
+				}
+
+				var conditions []metav1.Condition
+				r.detectGoldenImageImportFailure(req, &conditions)
+
+				Expect(conditions).To(ContainElement(commontestutils.RepresentCondition(metav1.Condition{
+					Type:    hcov1beta1.ConditionGoldenImageImportFailing,
+					Status:  metav1.ConditionTrue,
+					Reason:  goldenImageImportReason,
+					Message: fmt.Sprintf(goldenImageImportMessageFmt, goldenImageImportFailingThreshold, "image1, image3"),
+				})))
+				verifyGoldenImagesNotUpToDateMetric(3)
+			})
+
+			It("should remove the condition once the golden images are up to date", func() {
+				conditions := []metav1.Condition{
+					{
+						Type:    hcov1beta1.ConditionGoldenImageImportFailing,
+						Status:  metav1.ConditionTrue,
+						Reason:  goldenImageImportReason,
+						Message: fmt.Sprintf(goldenImageImportMessageFmt, goldenImageImportFailingThreshold, "image1"),
+					},
+				}
+				req.Instance.Status.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplateStatus{
+					newDictStatus("image1", &hcov1beta1.DataImportCronImportStatus{UpToDate: metav1.ConditionTrue}),
+				}
+
+				r.detectGoldenImageImportFailure(req, &conditions)
+
+				Expect(conditions).To(BeEmpty())
+				verifyGoldenImagesNotUpToDateMetric(0)
+			})
+		})
+
+		Context("Detection of paused operand reconciliation", func() {
+			var (
+				r      *ReconcileHyperConverged
+				req    *common.HcoRequest
+				events *commontestutils.EventEmitterMock
+			)
+
+			BeforeEach(func() {
+				events = commontestutils.NewEventEmitterMock()
+				r = &ReconcileHyperConverged{eventEmitter: events}
+				req = commontestutils.NewReq(commontestutils.NewHco())
+			})
+
+			AfterEach(func() {
+				for _, operand := range operands.PausableOperands {
+					Expect(metrics.HcoMetrics.SetOperandReconcilePaused(operand, false)).To(Succeed())
+				}
+			})
+
+			It("should not emit events if no reconciliation is paused", func() {
+				r.detectPausedReconciliation(req)
+
+				Expect(events.CheckNoEventEmitted()).To(BeTrue())
+				for _, operand := range operands.PausableOperands {
+					Expect(metrics.HcoMetrics.IsOperandReconcilePaused(operand)).To(BeFalse())
+				}
+			})
+
+			It("should set the metric and emit an event when the reconciliation is paused and resumed", func() {
+				req.Instance.Annotations = map[string]string{common.PauseReconcileAnnotationName: "kubevirt,ssp"}
+				r.detectPausedReconciliation(req)
+
+				Expect(events.CheckEvents([]commontestutils.MockEvent{
+					{
+						EventType: corev1.EventTypeWarning,
+						Reason:    "ReconcilePaused",
+						Msg:       fmt.Sprintf("HCO does not update the kubevirt CR, because of the %s annotation", common.PauseReconcileAnnotationName),
+					},
+					{
+						EventType: corev1.EventTypeWarning,
+						Reason:    "ReconcilePaused",
+						Msg:       fmt.Sprintf("HCO does not update the ssp CR, because of the %s annotation", common.PauseReconcileAnnotationName),
+					},
+				})).To(BeTrue())
+				Expect(metrics.HcoMetrics.IsOperandReconcilePaused("kubevirt")).To(BeTrue())
+				Expect(metrics.HcoMetrics.IsOperandReconcilePaused("ssp")).To(BeTrue())
+				Expect(metrics.HcoMetrics.IsOperandReconcilePaused("cdi")).To(BeFalse())
+
+				By("should not emit the events again, if nothing was changed")
+				events.Reset()
+				r.detectPausedReconciliation(req)
+				Expect(events.CheckNoEventEmitted()).To(BeTrue())
+
+				By("should emit an event when the reconciliation is resumed")
+				req.Instance.Annotations[common.PauseReconcileAnnotationName] = "ssp"
+				r.detectPausedReconciliation(req)
+
+				Expect(events.CheckEvents([]commontestutils.MockEvent{
+					{
+						EventType: corev1.EventTypeNormal,
+						Reason:    "ReconcileResumed",
+						Msg:       "HCO updates the kubevirt CR again",
+					},
+				})).To(BeTrue())
+				Expect(metrics.HcoMetrics.IsOperandReconcilePaused("kubevirt")).To(BeFalse())
+				Expect(metrics.HcoMetrics.IsOperandReconcilePaused("ssp")).To(BeTrue())
+			})
+		})
+
+		Context("Detection of an upgrade timeout", func() {
+			var (
+				r          *ReconcileHyperConverged
+				req        *common.HcoRequest
+				events     *commontestutils.EventEmitterMock
+				conditions []metav1.Condition
+			)
+
+			BeforeEach(func() {
+				events = commontestutils.NewEventEmitterMock()
+				r = &ReconcileHyperConverged{
+					eventEmitter:   events,
+					ownVersion:     "1.11.0",
+					upgradeMode:    true,
+					upgradeTimeout: time.Hour,
+				}
+				req = commontestutils.NewReq(commontestutils.NewHco())
+				conditions = nil
+			})
+
+			AfterEach(func() {
+				for _, operand := range []string{"kubevirt", "cdi", "ssp"} {
+					Expect(metrics.HcoMetrics.SetOperandUpgradeTimedOut(operand, false)).To(Succeed())
+				}
+			})
+
+			It("should not raise the condition before the timeout", func() {
+				r.upgradeStartTime = time.Now().Add(-30 * time.Minute)
+				r.upgradePendingComponents = []string{"CDI"}
+
+				r.detectUpgradeTimeout(req, &conditions)
+
+				Expect(apimetav1.FindStatusCondition(conditions, hcov1beta1.ConditionUpgradeTimedOut)).To(BeNil())
+				Expect(metrics.HcoMetrics.IsOperandUpgradeTimedOut("cdi")).To(BeFalse())
+				Expect(events.CheckNoEventEmitted()).To(BeTrue())
+			})
+
+			It("should requeue the request for when the timeout expires, while the upgrade is in progress", func() {
+				r.upgradeStartTime = time.Now().Add(-45 * time.Minute)
+				r.upgradePendingComponents = []string{"CDI"}
+
+				r.detectUpgradeTimeout(req, &conditions)
+
+				Expect(req.RequeueAfter).To(BeNumerically("~", 15*time.Minute, time.Minute))
+			})
+
+			It("should not requeue the request after the timeout, or when not upgrading", func() {
+				r.upgradeStartTime = time.Now().Add(-2 * time.Hour)
+				r.upgradePendingComponents = []string{"CDI"}
+
+				r.detectUpgradeTimeout(req, &conditions)
+				Expect(req.RequeueAfter).To(BeZero())
+
+				r.upgradeMode = false
+				r.upgradeStartTime = time.Now()
+				r.detectUpgradeTimeout(req, &conditions)
+				Expect(req.RequeueAfter).To(BeZero())
+			})
+
+			It("should raise the condition, set the metric and emit an event after the timeout", func() {
+				r.upgradeStartTime = time.Now().Add(-2 * time.Hour)
+				r.upgradePendingComponents = []string{"CDI", "SSP"}
+
+				r.detectUpgradeTimeout(req, &conditions)
+
+				cond := apimetav1.FindStatusCondition(conditions, hcov1beta1.ConditionUpgradeTimedOut)
+				Expect(cond).ToNot(BeNil())
+				Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+				Expect(cond.Reason).To(Equal(upgradeTimedOutReason))
+				Expect(cond.Message).To(Equal("The upgrade to version 1.11.0 is not completed for more than 1h0m0s; the following operands did not reach their expected version: CDI, SSP"))
+
+				Expect(metrics.HcoMetrics.IsOperandUpgradeTimedOut("cdi")).To(BeTrue())
+				Expect(metrics.HcoMetrics.IsOperandUpgradeTimedOut("ssp")).To(BeTrue())
+				Expect(metrics.HcoMetrics.IsOperandUpgradeTimedOut("kubevirt")).To(BeFalse())
+
+				Expect(events.CheckEvents([]commontestutils.MockEvent{
+					{
+						EventType: corev1.EventTypeWarning,
+						Reason:    upgradeTimedOutReason,
+						Msg:       "The upgrade to version 1.11.0 is not completed on time; waiting for CDI, SSP",
+					},
+				})).To(BeTrue())
+
+				By("should not emit the event again")
+				events.Reset()
+				r.upgradePendingComponents = []string{"SSP"}
+				r.detectUpgradeTimeout(req, &conditions)
+				Expect(events.CheckNoEventEmitted()).To(BeTrue())
+
+				By("should reset the metric of an operand that reached its expected version")
+				Expect(metrics.HcoMetrics.IsOperandUpgradeTimedOut("cdi")).To(BeFalse())
+				Expect(metrics.HcoMetrics.IsOperandUpgradeTimedOut("ssp")).To(BeTrue())
+				cond = apimetav1.FindStatusCondition(conditions, hcov1beta1.ConditionUpgradeTimedOut)
+				Expect(cond).ToNot(BeNil())
+				Expect(cond.Message).To(HaveSuffix(": SSP"))
+
+				By("should remove the condition and reset the metric when the upgrade is completed")
+				r.upgradeMode = false
+				r.upgradePendingComponents = nil
+				r.detectUpgradeTimeout(req, &conditions)
+				Expect(apimetav1.FindStatusCondition(conditions, hcov1beta1.ConditionUpgradeTimedOut)).To(BeNil())
+				Expect(metrics.HcoMetrics.IsOperandUpgradeTimedOut("ssp")).To(BeFalse())
+			})
+
+			It("should not raise the condition when not upgrading", func() {
+				r.upgradeMode = false
+				r.upgradeStartTime = time.Now().Add(-2 * time.Hour)
+				r.upgradePendingComponents = []string{"CDI"}
+
+				r.detectUpgradeTimeout(req, &conditions)
+
+				Expect(apimetav1.FindStatusCondition(conditions, hcov1beta1.ConditionUpgradeTimedOut)).To(BeNil())
+				Expect(metrics.HcoMetrics.IsOperandUpgradeTimedOut("cdi")).To(BeFalse())
+			})
+
+			DescribeTable("should read the upgrade timeout from the environment", func(value string, expected time.Duration) {
+				if value == "" {
+					Expect(os.Unsetenv(hcoutil.UpgradeTimeoutEnvV)).To(Succeed())
+				} else {
+					Expect(os.Setenv(hcoutil.UpgradeTimeoutEnvV, value)).To(Succeed())
+				}
+				defer func() { _ = os.Unsetenv(hcoutil.UpgradeTimeoutEnvV) }()
+
+				Expect(getUpgradeTimeout()).To(Equal(expected))
+			},
+				Entry("not set", "", defaultUpgradeTimeout),
+				Entry("valid duration", "45m", 45*time.Minute),
+				Entry("invalid duration", "two hours", defaultUpgradeTimeout),
+				Entry("negative duration", "-1h", defaultUpgradeTimeout),
+			)
+		})
+
+		Context("Detection of a missing scratch space storage class", func() {
+			var req *common.HcoRequest
+
+			BeforeEach(func() {
+				req = commontestutils.NewReq(commontestutils.NewHco())
+			})
+
+			It("should not raise the condition if the scratch space storage class is not set", func() {
+				r := &ReconcileHyperConverged{client: commontestutils.InitClient([]client.Object{})}
+
+				var conditions []metav1.Condition
+				r.detectMissingScratchSpaceStorageClass(req, &conditions)
+
+				Expect(conditions).To(BeEmpty())
+			})
+
+			It("should not raise the condition if the scratch space storage class exists", func() {
+				sc := &storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "rook-cephfs"}}
+				r := &ReconcileHyperConverged{client: commontestutils.InitClient([]client.Object{sc})}
+				req.Instance.Spec.ScratchSpaceStorageClass = ptr.To("rook-cephfs")
+
+				var conditions []metav1.Condition
+				r.detectMissingScratchSpaceStorageClass(req, &conditions)
+
+				Expect(conditions).To(BeEmpty())
+			})
+
+			It("should raise the condition if the scratch space storage class does not exist", func() {
+				r := &ReconcileHyperConverged{client: commontestutils.InitClient([]client.Object{})}
+				req.Instance.Spec.ScratchSpaceStorageClass = ptr.To("not-exists")
+
+				var conditions []metav1.Condition
+				r.detectMissingScratchSpaceStorageClass(req, &conditions)
+
+				Expect(conditions).To(ContainElement(commontestutils.RepresentCondition(metav1.Condition{
+					Type:    hcov1beta1.ConditionScratchSpaceStorageClassNotFound,
+					Status:  metav1.ConditionTrue,
+					Reason:  scratchSpaceSCReason,
+					Message: fmt.Sprintf(scratchSpaceSCMessageFmt, "not-exists"),
+				})))
+			})
+
+			It("should remove the condition once the scratch space storage class is created", func() {
+				sc := &storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "rook-cephfs"}}
+				r := &ReconcileHyperConverged{client: commontestutils.InitClient([]client.Object{sc})}
+				req.Instance.Spec.ScratchSpaceStorageClass = ptr.To("rook-cephfs")
+
+				conditions := []metav1.Condition{
+					{
+						Type:    hcov1beta1.ConditionScratchSpaceStorageClassNotFound,
+						Status:  metav1.ConditionTrue,
+						Reason:  scratchSpaceSCReason,
+						Message: fmt.Sprintf(scratchSpaceSCMessageFmt, "rook-cephfs"),
+					},
+				}
+				r.detectMissingScratchSpaceStorageClass(req, &conditions)
+
+				Expect(conditions).To(BeEmpty())
+			})
+		})
+
+		Context("Detection of a missing live migration network", func() {
+			var req *common.HcoRequest
+
+			newNAD := func(name string) *unstructured.Unstructured {
+				nad := &unstructured.Unstructured{}
+				nad.SetGroupVersionKind(hcoutil.NetworkAttachmentDefinitionGVK)
+				nad.SetNamespace(req.Instance.Namespace)
+				nad.SetName(name)
+				return nad
+			}
+
+			BeforeEach(func() {
+				req = commontestutils.NewReq(commontestutils.NewHco())
+			})
+
+			It("should not raise the condition if the live migration network is not set", func() {
+				r := &ReconcileHyperConverged{client: commontestutils.InitClient([]client.Object{})}
+
+				var conditions []metav1.Condition
+				r.detectMissingMigrationNetwork(req, &conditions)
+
+				Expect(conditions).To(BeEmpty())
+			})
+
+			It("should not raise the condition if the live migration network exists", func() {
+				r := &ReconcileHyperConverged{client: commontestutils.InitClient([]client.Object{newNAD("migration-network")})}
+				req.Instance.Spec.LiveMigrationConfig.Network = ptr.To("migration-network")
+
+				var conditions []metav1.Condition
+				r.detectMissingMigrationNetwork(req, &conditions)
+
+				Expect(conditions).To(BeEmpty())
+			})
+
+			It("should raise the condition if the live migration network does not exist", func() {
+				r := &ReconcileHyperConverged{client: commontestutils.InitClient([]client.Object{})}
+				req.Instance.Spec.LiveMigrationConfig.Network = ptr.To("migration-network")
+
+				var conditions []metav1.Condition
+				r.detectMissingMigrationNetwork(req, &conditions)
+
+				Expect(conditions).To(ContainElement(commontestutils.RepresentCondition(metav1.Condition{
+					Type:    hcov1beta1.ConditionMigrationNetworkNotFound,
+					Status:  metav1.ConditionTrue,
+					Reason:  migrationNetworkReason,
+					Message: fmt.Sprintf(migrationNetworkMessageFmt, "migration-network", req.Instance.Namespace),
+				})))
+			})
+
+			It("should remove the condition once the live migration network is created", func() {
+				r := &ReconcileHyperConverged{client: commontestutils.InitClient([]client.Object{newNAD("migration-network")})}
+				req.Instance.Spec.LiveMigrationConfig.Network = ptr.To("migration-network")
+
+				conditions := []metav1.Condition{
+					{
+						Type:    hcov1beta1.ConditionMigrationNetworkNotFound,
+						Status:  metav1.ConditionTrue,
+						Reason:  migrationNetworkReason,
+						Message: fmt.Sprintf(migrationNetworkMessageFmt, "migration-network", req.Instance.Namespace),
+					},
+				}
+				r.detectMissingMigrationNetwork(req, &conditions)
+
+				Expect(conditions).To(BeEmpty())
+			})
+		})
+
+		Context("Detection of unsupported feature gates", func() {
+			var req *common.HcoRequest
+
+			getClusterInfo := hcoutil.GetClusterInfo
+
+			BeforeEach(func() {
+				req = commontestutils.NewReq(commontestutils.NewHco())
+				req.Instance.Spec.FeatureGates.DeployKubeSecondaryDNS = ptr.To(true)
+				req.Instance.Spec.FeatureGates.EnableManagedTenantQuota = ptr.To(true)
+			})
+
+			AfterEach(func() {
+				hcoutil.GetClusterInfo = getClusterInfo
+			})
+
+			It("should not raise the condition if all the feature gates are supported", func() {
+				r := &ReconcileHyperConverged{}
+
+				var conditions []metav1.Condition
+				r.detectUnsupportedFeatureGates(req, &conditions)
+
+				Expect(conditions).To(BeEmpty())
+			})
+
+			It("should raise the condition if some of the feature gates are not supported", func() {
+				hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+					return archClusterInfo{archs: []string{hcoutil.ArchAMD64, hcoutil.ArchS390X}}
+				}
+				r := &ReconcileHyperConverged{}
+
+				var conditions []metav1.Condition
+				r.detectUnsupportedFeatureGates(req, &conditions)
+
+				Expect(conditions).To(ContainElement(commontestutils.RepresentCondition(metav1.Condition{
+					Type:    hcov1beta1.ConditionUnsupportedFeatureGates,
+					Status:  metav1.ConditionTrue,
+					Reason:  unsupportedFeatureGatesReason,
+					Message: "the deployKubeSecondaryDNS feature gate is not supported on the s390x node architectures; the enableManagedTenantQuota feature gate is not supported on the s390x node architectures",
+				})))
+
+				By("removing the condition once the feature gates are disabled")
+				req.Instance.Spec.FeatureGates.DeployKubeSecondaryDNS = ptr.To(false)
+				req.Instance.Spec.FeatureGates.EnableManagedTenantQuota = nil
+				r.detectUnsupportedFeatureGates(req, &conditions)
+
+				Expect(conditions).To(BeEmpty())
+			})
+		})
+
+		Context("Cluster topology", func() {
+			getClusterInfo := hcoutil.GetClusterInfo
+
+			AfterEach(func() {
+				hcoutil.GetClusterInfo = getClusterInfo
+			})
+
+			It("should report the cluster topology in the status", func() {
+				hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+					return commontestutils.ClusterInfoSRCPHAIMock{}
+				}
+
+				req := commontestutils.NewReq(commontestutils.NewHco())
+				updateClusterTopology(req)
+
+				Expect(req.StatusDirty).To(BeTrue())
+				Expect(req.Instance.Status.ClusterTopology).To(Equal(&hcov1beta1.ClusterTopology{
+					ControlPlane:     "SingleReplica",
+					Infrastructure:   "HighlyAvailable",
+					ConsoleAvailable: true,
+				}))
+
+				By("not modifying the status if the topology was not changed")
+				req.StatusDirty = false
+				updateClusterTopology(req)
+				Expect(req.StatusDirty).To(BeFalse())
+			})
+
+			It("should report a hosted control plane without the console", func() {
+				hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+					return hostedClusterInfo{}
+				}
+
+				req := commontestutils.NewReq(commontestutils.NewHco())
+				updateClusterTopology(req)
+
+				Expect(req.StatusDirty).To(BeTrue())
+				Expect(req.Instance.Status.ClusterTopology).To(Equal(&hcov1beta1.ClusterTopology{
+					ControlPlane:     "External",
+					Infrastructure:   "HighlyAvailable",
+					ConsoleAvailable: false,
+				}))
+			})
+
+			It("should warn about the configuration that is not supported after the cluster was reduced to a single worker node", func() {
+				hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+					return commontestutils.ClusterInfoSNOMock{}
+				}
+
+				hco := commontestutils.NewHco()
+				hco.Spec.EvictionStrategy = ptr.To(kubevirtcorev1.EvictionStrategyLiveMigrate)
+				hco.Spec.FeatureGates.EnableManagedTenantQuota = ptr.To(true)
+				hco.Status.ClusterTopology = &hcov1beta1.ClusterTopology{
+					ControlPlane:     "SingleReplica",
+					Infrastructure:   "HighlyAvailable",
+					ConsoleAvailable: true,
+				}
+
+				r := initReconciler(commontestutils.InitClient(nil), nil)
+				req := commontestutils.NewReq(hco)
+				Expect(updateClusterTopology(req)).To(BeTrue())
+				r.warnOnSingleWorkerConfiguration(req)
+
+				events := r.eventEmitter.(*commontestutils.EventEmitterMock)
+				Expect(events.CheckEvents([]commontestutils.MockEvent{
+					{
+						EventType: corev1.EventTypeWarning,
+						Reason:    unsupportedTopologyReason,
+						Msg:       "the LiveMigrate eviction strategy is not supported on single node clusters, and will block the node drain; use None, LiveMigrateIfPossible or External instead",
+					},
+					{
+						EventType: corev1.EventTypeWarning,
+						Reason:    unsupportedTopologyReason,
+						Msg:       "the EnableManagedTenantQuota feature gate is not supported on single node clusters; MTQ is removed",
+					},
+				})).To(BeTrue())
+			})
+
+			It("should not warn on a highly available cluster", func() {
+				hco := commontestutils.NewHco()
+				hco.Spec.EvictionStrategy = ptr.To(kubevirtcorev1.EvictionStrategyLiveMigrate)
+				hco.Spec.FeatureGates.EnableManagedTenantQuota = ptr.To(true)
+
+				r := initReconciler(commontestutils.InitClient(nil), nil)
+				req := commontestutils.NewReq(hco)
+				r.warnOnSingleWorkerConfiguration(req)
+
+				events := r.eventEmitter.(*commontestutils.EventEmitterMock)
+				Expect(events.CheckEvents([]commontestutils.MockEvent{
+					{
+						EventType: corev1.EventTypeWarning,
+						Reason:    unsupportedTopologyReason,
+						Msg:       "the EnableManagedTenantQuota feature gate is not supported on single node clusters; MTQ is removed",
+					},
+				})).To(BeFalse())
+			})
+		})
+
 		Context("Detection of a tainted configuration", func() {
 			var (
 				hcoNamespace *corev1.Namespace
@@ -4024,6 +4945,24 @@ func verifySystemHealthStatusError(hco *hcov1beta1.HyperConverged) {
 	ExpectWithOffset(1, systemHealthStatusMetric).To(Equal(metrics.SystemHealthStatusError))
 }
 
+func verifyUpgradeableMetric(expected bool) {
+	upgradeable, err := metrics.HcoMetrics.IsHCOMetricUpgradeable()
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	ExpectWithOffset(1, upgradeable).To(Equal(expected))
+}
+
+func verifyGoldenImagesNotUpToDateMetric(expected int) {
+	notUpToDate, err := metrics.HcoMetrics.GetGoldenImagesNotUpToDateCount()
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	ExpectWithOffset(1, notUpToDate).To(BeEquivalentTo(expected))
+}
+
+func verifyClusterReadyMetric(expected bool) {
+	ready, err := metrics.HcoMetrics.IsHCOMetricClusterReady()
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	ExpectWithOffset(1, ready).To(Equal(expected))
+}
+
 func searchInRelatedObjects(relatedObjects []corev1.ObjectReference, kind, name string) bool {
 	for _, obj := range relatedObjects {
 		if obj.Kind == kind && obj.Name == name {
@@ -0,0 +1,58 @@
+package hyperconverged
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+const deferredWorkloadUpdates = "workloadUpdateStrategy.workloadUpdateMethods"
+
+// applyMaintenanceWindow updates the maintenance window status, and returns the time to wait until the next
+// maintenance window transition; zero if there is no maintenance window.
+func applyMaintenanceWindow(req *common.HcoRequest) time.Duration {
+	mw := req.Instance.Spec.MaintenanceWindow
+	if mw == nil {
+		if req.Instance.Status.MaintenanceWindow != nil {
+			req.Instance.Status.MaintenanceWindow = nil
+			req.StatusDirty = true
+		}
+		return 0
+	}
+
+	now := common.Now()
+	open, transition, err := common.GetMaintenanceWindowState(mw, now)
+	if err != nil {
+		// should not happen; the webhook validates the maintenance window
+		req.Logger.Error(err, "can't calculate the maintenance window state")
+		return 0
+	}
+
+	status := &hcov1beta1.MaintenanceWindowStatus{
+		Open: open,
+	}
+
+	if !transition.IsZero() {
+		// the status keeps the time in seconds resolution
+		status.NextTransition = &metav1.Time{Time: transition.Truncate(time.Second)}
+	}
+
+	if !open && len(req.Instance.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods) > 0 {
+		status.DeferredChanges = []string{deferredWorkloadUpdates}
+	}
+
+	if !equality.Semantic.DeepEqual(req.Instance.Status.MaintenanceWindow, status) {
+		req.Instance.Status.MaintenanceWindow = status
+		req.StatusDirty = true
+	}
+
+	if transition.IsZero() {
+		return 0
+	}
+
+	return transition.Sub(now)
+}
@@ -0,0 +1,81 @@
+package hyperconverged
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("test maintenance window", func() {
+	origNow := common.Now
+	now := time.Date(2023, time.October, 18, 12, 30, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		common.Now = func() time.Time { return now }
+	})
+
+	AfterEach(func() {
+		common.Now = origNow
+	})
+
+	It("should not set the status if there is no maintenance window", func() {
+		hco := commontestutils.NewHco()
+		req := commontestutils.NewReq(hco)
+
+		Expect(applyMaintenanceWindow(req)).To(BeZero())
+		Expect(hco.Status.MaintenanceWindow).To(BeNil())
+		Expect(req.StatusDirty).To(BeFalse())
+	})
+
+	It("should remove the status if the maintenance window was removed", func() {
+		hco := commontestutils.NewHco()
+		hco.Status.MaintenanceWindow = &hcov1beta1.MaintenanceWindowStatus{Open: true}
+		req := commontestutils.NewReq(hco)
+
+		Expect(applyMaintenanceWindow(req)).To(BeZero())
+		Expect(hco.Status.MaintenanceWindow).To(BeNil())
+		Expect(req.StatusDirty).To(BeTrue())
+	})
+
+	It("should report the deferred changes while the maintenance window is closed", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []string{"LiveMigrate"}
+		hco.Spec.MaintenanceWindow = &hcov1beta1.MaintenanceWindow{
+			StartTime: "14:00",
+			Duration:  metav1.Duration{Duration: time.Hour},
+		}
+		req := commontestutils.NewReq(hco)
+
+		Expect(applyMaintenanceWindow(req)).To(Equal(90 * time.Minute))
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.MaintenanceWindow).ToNot(BeNil())
+		Expect(hco.Status.MaintenanceWindow.Open).To(BeFalse())
+		Expect(hco.Status.MaintenanceWindow.NextTransition.Time).To(Equal(time.Date(2023, time.October, 18, 14, 0, 0, 0, time.UTC)))
+		Expect(hco.Status.MaintenanceWindow.DeferredChanges).To(ConsistOf(deferredWorkloadUpdates))
+
+		By("not modifying the status again if nothing was changed")
+		req = commontestutils.NewReq(hco)
+		applyMaintenanceWindow(req)
+		Expect(req.StatusDirty).To(BeFalse())
+	})
+
+	It("should not report deferred changes while the maintenance window is open", func() {
+		hco := commontestutils.NewHco()
+		hco.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []string{"LiveMigrate"}
+		hco.Spec.MaintenanceWindow = &hcov1beta1.MaintenanceWindow{
+			StartTime: "12:00",
+			Duration:  metav1.Duration{Duration: time.Hour},
+		}
+		req := commontestutils.NewReq(hco)
+
+		Expect(applyMaintenanceWindow(req)).To(Equal(30 * time.Minute))
+		Expect(hco.Status.MaintenanceWindow.Open).To(BeTrue())
+		Expect(hco.Status.MaintenanceWindow.DeferredChanges).To(BeEmpty())
+	})
+})
@@ -0,0 +1,86 @@
+package hyperconverged
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+// relatedObjectsGCInterval is the minimal time between two passes of the related objects garbage collection, unless
+// the HyperConverged CR was modified in between.
+const relatedObjectsGCInterval = 10 * time.Minute
+
+// pruneRelatedObjects removes the entries of the relatedObjects list in the HyperConverged status, that reference
+// objects that do not exist anymore; for example, the objects that were removed after a feature gate was disabled, or
+// the objects of a kind that is no longer served by the cluster.
+//
+// The objects are read directly from the API server, so the pass is done only periodically, or when the
+// HyperConverged CR was modified since the last pass. It must be called only after all the operands were successfully
+// ensured, so the list only contains the objects that are still managed by HCO.
+func (r *ReconcileHyperConverged) pruneRelatedObjects(req *common.HcoRequest) {
+	if req.Instance.Generation == r.relatedObjectsGCGeneration && common.Now().Sub(r.relatedObjectsGCTime) < relatedObjectsGCInterval {
+		return
+	}
+
+	refs := make([]corev1.ObjectReference, 0, len(req.Instance.Status.RelatedObjects))
+	var pruned []string
+
+	for _, ref := range req.Instance.Status.RelatedObjects {
+		exists, err := r.relatedObjectExists(req, ref)
+		if err != nil {
+			req.Logger.Error(err, "failed to check if a related object still exists", "kind", ref.Kind, "namespace", ref.Namespace, "name", ref.Name)
+		}
+
+		if exists || err != nil {
+			refs = append(refs, ref)
+			continue
+		}
+
+		pruned = append(pruned, relatedObjectString(ref))
+	}
+
+	r.relatedObjectsGCGeneration = req.Instance.Generation
+	r.relatedObjectsGCTime = common.Now()
+
+	if len(pruned) == 0 {
+		return
+	}
+
+	msg := fmt.Sprintf("Removed %d stale related objects: %s", len(pruned), strings.Join(pruned, ", "))
+	req.Logger.Info(msg)
+	r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "RelatedObjectsPruned", msg)
+
+	req.Instance.Status.RelatedObjects = refs
+	req.StatusDirty = true
+}
+
+func (r *ReconcileHyperConverged) relatedObjectExists(req *common.HcoRequest, ref corev1.ObjectReference) (bool, error) {
+	obj := &metav1.PartialObjectMetadata{}
+	obj.SetGroupVersionKind(ref.GroupVersionKind())
+
+	err := r.apiReader.Get(req.Ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, obj)
+	if err == nil {
+		return true, nil
+	}
+
+	if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return false, nil
+	}
+
+	return false, err
+}
+
+func relatedObjectString(ref corev1.ObjectReference) string {
+	if ref.Namespace == "" {
+		return fmt.Sprintf("%s %s", ref.Kind, ref.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", ref.Kind, ref.Namespace, ref.Name)
+}
@@ -0,0 +1,129 @@
+package hyperconverged
+
+import (
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/reference"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+	"github.com/kubevirt/hyperconverged-cluster-operator/version"
+)
+
+var _ = Describe("test related objects garbage collection", func() {
+	var (
+		expected *BasicExpected
+		hco      *hcov1beta1.HyperConverged
+		r        *ReconcileHyperConverged
+
+		kvRef         corev1.ObjectReference
+		staleCMRef    corev1.ObjectReference
+		staleCRDRef   corev1.ObjectReference
+		gcTime        time.Time
+		expectedEvent commontestutils.MockEvent
+	)
+
+	getClusterInfo := hcoutil.GetClusterInfo
+	now := common.Now
+
+	BeforeEach(func() {
+		hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+			return commontestutils.ClusterInfoMock{}
+		}
+
+		_ = os.Setenv("VIRTIOWIN_CONTAINER", commontestutils.VirtioWinImage)
+		_ = os.Setenv("OPERATOR_NAMESPACE", namespace)
+		_ = os.Setenv(hcoutil.HcoKvIoVersionName, version.Version)
+
+		expected = getBasicDeployment()
+		hco = expected.hco
+
+		ref, err := reference.GetReference(commontestutils.GetScheme(), expected.kv)
+		Expect(err).ToNot(HaveOccurred())
+		kvRef = *ref
+
+		staleCMRef = corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: namespace, Name: "stale-configmap"}
+		staleCRDRef = corev1.ObjectReference{APIVersion: "apiextensions.k8s.io/v1", Kind: "CustomResourceDefinition", Name: "stale.crd.io"}
+
+		hco.Status.RelatedObjects = []corev1.ObjectReference{kvRef, staleCMRef, staleCRDRef}
+
+		gcTime = time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+		common.Now = func() time.Time { return gcTime }
+
+		expectedEvent = commontestutils.MockEvent{
+			EventType: corev1.EventTypeNormal,
+			Reason:    "RelatedObjectsPruned",
+			Msg:       "Removed 2 stale related objects: ConfigMap " + namespace + "/stale-configmap, CustomResourceDefinition stale.crd.io",
+		}
+	})
+
+	AfterEach(func() {
+		hcoutil.GetClusterInfo = getClusterInfo
+		common.Now = now
+	})
+
+	It("should remove the related objects that do not exist anymore, and emit an event", func() {
+		r = initReconciler(expected.initClient(), nil)
+		req := commontestutils.NewReq(hco)
+
+		r.pruneRelatedObjects(req)
+
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.RelatedObjects).To(Equal([]corev1.ObjectReference{kvRef}))
+		Expect(r.relatedObjectsGCTime).To(Equal(gcTime))
+		Expect(r.eventEmitter.(*commontestutils.EventEmitterMock).CheckEvents([]commontestutils.MockEvent{expectedEvent})).To(BeTrue())
+	})
+
+	It("should not modify the status if all the related objects exist", func() {
+		hco.Status.RelatedObjects = []corev1.ObjectReference{kvRef}
+
+		r = initReconciler(expected.initClient(), nil)
+		req := commontestutils.NewReq(hco)
+
+		r.pruneRelatedObjects(req)
+
+		Expect(req.StatusDirty).To(BeFalse())
+		Expect(hco.Status.RelatedObjects).To(Equal([]corev1.ObjectReference{kvRef}))
+		Expect(r.eventEmitter.(*commontestutils.EventEmitterMock).CheckNoEventEmitted()).To(BeTrue())
+	})
+
+	It("should not run again before the interval, unless the HyperConverged CR was modified", func() {
+		hco.Generation = 1
+
+		r = initReconciler(expected.initClient(), nil)
+		r.relatedObjectsGCGeneration = 1
+		r.relatedObjectsGCTime = gcTime.Add(-relatedObjectsGCInterval / 2)
+
+		req := commontestutils.NewReq(hco)
+		r.pruneRelatedObjects(req)
+		Expect(req.StatusDirty).To(BeFalse())
+		Expect(hco.Status.RelatedObjects).To(HaveLen(3))
+
+		By("run when the HyperConverged CR was modified")
+		hco.Generation = 2
+		r.pruneRelatedObjects(req)
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.RelatedObjects).To(Equal([]corev1.ObjectReference{kvRef}))
+		Expect(r.relatedObjectsGCGeneration).To(Equal(int64(2)))
+	})
+
+	It("should run again after the interval", func() {
+		hco.Generation = 1
+
+		r = initReconciler(expected.initClient(), nil)
+		r.relatedObjectsGCGeneration = 1
+		r.relatedObjectsGCTime = gcTime.Add(-relatedObjectsGCInterval)
+
+		req := commontestutils.NewReq(hco)
+		r.pruneRelatedObjects(req)
+		Expect(req.StatusDirty).To(BeTrue())
+		Expect(hco.Status.RelatedObjects).To(Equal([]corev1.ObjectReference{kvRef}))
+		Expect(r.eventEmitter.(*commontestutils.EventEmitterMock).CheckEvents([]commontestutils.MockEvent{expectedEvent})).To(BeTrue())
+	})
+})
@@ -0,0 +1,48 @@
+package hyperconverged
+
+import (
+	"errors"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/metrics"
+)
+
+// updateRequeueMetrics counts the reconciliations that the controller requeues with backoff; i.e. the failed
+// reconciliations, and the ones that requested to be requeued without a delay. The reconciliations that failed because
+// of an update conflict, usually caused by another controller that modifies the same resource, are also counted by the
+// type of the conflicting resource.
+func updateRequeueMetrics(result reconcile.Result, err error, logger logr.Logger) {
+	reason := ""
+	if err != nil {
+		reason = metrics.RequeueReasonError
+	} else if result.Requeue && result.RequeueAfter == 0 {
+		reason = metrics.RequeueReasonRequested
+	}
+
+	if reason != "" {
+		if metricErr := metrics.HcoMetrics.IncReconcileRequeues(reason); metricErr != nil {
+			logger.Error(metricErr, "failed to update the reconcileRequeues metric")
+		}
+	}
+
+	if apierrors.IsConflict(err) {
+		if metricErr := metrics.HcoMetrics.IncReconcileConflicts(getConflictResource(err)); metricErr != nil {
+			logger.Error(metricErr, "failed to update the reconcileConflicts metric")
+		}
+	}
+}
+
+// getConflictResource returns the type of the conflicting resource from the details of a conflict error. The API
+// server reports the resource, e.g. "kubevirts", in the kind field of the details.
+func getConflictResource(err error) string {
+	var statusErr apierrors.APIStatus
+	if errors.As(err, &statusErr) {
+		if details := statusErr.Status().Details; details != nil && details.Kind != "" {
+			return details.Kind
+		}
+	}
+	return "unknown"
+}
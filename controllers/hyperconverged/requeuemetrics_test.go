@@ -0,0 +1,73 @@
+package hyperconverged
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/metrics"
+)
+
+var _ = Describe("test the reconcile requeue metrics", func() {
+	logger := logf.Log.WithName("requeuemetrics_test")
+
+	getRequeues := func() (float64, float64) {
+		errCount, err := metrics.HcoMetrics.GetReconcileRequeuesCount(metrics.RequeueReasonError)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		requestedCount, err := metrics.HcoMetrics.GetReconcileRequeuesCount(metrics.RequeueReasonRequested)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		return errCount, requestedCount
+	}
+
+	DescribeTable("should count the requeues with backoff", func(result reconcile.Result, err error, expectedErrors, expectedRequested float64) {
+		errCount, requestedCount := getRequeues()
+
+		updateRequeueMetrics(result, err, logger)
+
+		newErrCount, newRequestedCount := getRequeues()
+		Expect(newErrCount - errCount).To(Equal(expectedErrors))
+		Expect(newRequestedCount - requestedCount).To(Equal(expectedRequested))
+	},
+		Entry("no requeue", reconcile.Result{}, nil, float64(0), float64(0)),
+		Entry("requeue", reconcile.Result{Requeue: true}, nil, float64(0), float64(1)),
+		Entry("delayed requeue", reconcile.Result{Requeue: true, RequeueAfter: time.Minute}, nil, float64(0), float64(0)),
+		Entry("delayed reconciliation", reconcile.Result{RequeueAfter: time.Minute}, nil, float64(0), float64(0)),
+		Entry("error", reconcile.Result{}, errors.New("fake error"), float64(1), float64(0)),
+		Entry("error with requeue", reconcile.Result{Requeue: true}, errors.New("fake error"), float64(1), float64(0)),
+	)
+
+	It("should count the update conflicts by the conflicting resource", func() {
+		conflictErr := apierrors.NewConflict(schema.GroupResource{Group: "kubevirt.io", Resource: "kubevirts"}, "kubevirt-kubevirt-hyperconverged", errors.New("the object has been modified"))
+
+		before, err := metrics.HcoMetrics.GetReconcileConflictsCount("kubevirts")
+		Expect(err).ToNot(HaveOccurred())
+
+		updateRequeueMetrics(reconcile.Result{Requeue: true}, fmt.Errorf("failed to update the KubeVirt CR; %w", conflictErr), logger)
+
+		after, err := metrics.HcoMetrics.GetReconcileConflictsCount("kubevirts")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(after - before).To(Equal(float64(1)))
+	})
+
+	It("should not count errors that are not conflicts", func() {
+		before, err := metrics.HcoMetrics.GetReconcileConflictsCount("unknown")
+		Expect(err).ToNot(HaveOccurred())
+
+		updateRequeueMetrics(reconcile.Result{}, errors.New("fake error"), logger)
+
+		after, err := metrics.HcoMetrics.GetReconcileConflictsCount("unknown")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(after).To(Equal(before))
+	})
+
+	It("should use an unknown resource if the conflict error has no details", func() {
+		Expect(getConflictResource(&apierrors.StatusError{})).To(Equal("unknown"))
+	})
+})
@@ -0,0 +1,116 @@
+package hyperconverged
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+// specMigration moves the value of a deprecated field of the HyperConverged CR to its replacement. The migrate function
+// modifies the HyperConverged CR in place, and returns a message describing the migration, and true if anything was
+// migrated. It must be idempotent, as it is called on each reconciliation during the upgrade.
+type specMigration struct {
+	name    string
+	migrate func(hc *hcov1beta1.HyperConverged) (string, bool)
+}
+
+// specMigrations is the list of the migrations to run during the upgrade. To handle a renamed or a deprecated field,
+// add a new migration to the end of the list.
+var specMigrations = []specMigration{
+	{
+		name:    "mediatedDevicesTypes",
+		migrate: migrateMediatedDevicesTypes,
+	},
+}
+
+// applySpecMigrations runs the spec migrations on the HyperConverged CR. Each applied migration is recorded in the
+// HyperConverged status, and emits an event.
+func (r *ReconcileHyperConverged) applySpecMigrations(req *common.HcoRequest) bool {
+	return r.runSpecMigrations(req, specMigrations)
+}
+
+func (r *ReconcileHyperConverged) runSpecMigrations(req *common.HcoRequest, migrations []specMigration) bool {
+	modified := false
+
+	for _, m := range migrations {
+		msg, migrated := m.migrate(req.Instance)
+		if !migrated {
+			continue
+		}
+
+		req.Logger.Info("migrated a deprecated field of the HyperConverged CR", "migration", m.name, "message", msg)
+		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "SpecMigrated", fmt.Sprintf("%s: %s", m.name, msg))
+
+		setSpecMigrationStatus(&req.Instance.Status, hcov1beta1.SpecMigrationStatus{
+			Name:          m.name,
+			Message:       msg,
+			Version:       r.ownVersion,
+			MigrationTime: metav1.NewTime(common.Now()),
+		})
+
+		modified = true
+	}
+
+	if modified {
+		req.Dirty = true
+		req.StatusDirty = true
+	}
+
+	return modified
+}
+
+func setSpecMigrationStatus(status *hcov1beta1.HyperConvergedStatus, migration hcov1beta1.SpecMigrationStatus) {
+	for i := range status.SpecMigrations {
+		if status.SpecMigrations[i].Name == migration.Name {
+			status.SpecMigrations[i] = migration
+			return
+		}
+	}
+	status.SpecMigrations = append(status.SpecMigrations, migration)
+}
+
+// migrateMediatedDevicesTypes moves the deprecated mediatedDevicesTypes fields to the mediatedDeviceTypes fields. If
+// both fields are set, the mediatedDeviceTypes field is already the one in use, and the deprecated field is dropped.
+func migrateMediatedDevicesTypes(hc *hcov1beta1.HyperConverged) (string, bool) {
+	mdc := hc.Spec.MediatedDevicesConfiguration
+	if mdc == nil {
+		return "", false
+	}
+
+	var moved []string
+
+	if migrateMediatedDeviceTypeList(&mdc.MediatedDevicesTypes, &mdc.MediatedDeviceTypes) { //nolint SA1019
+		moved = append(moved, "spec.mediatedDevicesConfiguration.mediatedDevicesTypes")
+	}
+
+	for i := range mdc.NodeMediatedDeviceTypes {
+		nmdc := &mdc.NodeMediatedDeviceTypes[i]
+		if migrateMediatedDeviceTypeList(&nmdc.MediatedDevicesTypes, &nmdc.MediatedDeviceTypes) { //nolint SA1019
+			moved = append(moved, fmt.Sprintf("spec.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[%d].mediatedDevicesTypes", i))
+		}
+	}
+
+	if len(moved) == 0 {
+		return "", false
+	}
+
+	return fmt.Sprintf("migrated %s to the mediatedDeviceTypes field", strings.Join(moved, ", ")), true
+}
+
+func migrateMediatedDeviceTypeList(deprecated, replacement *[]string) bool {
+	if len(*deprecated) == 0 {
+		return false
+	}
+
+	if len(*replacement) == 0 {
+		*replacement = *deprecated
+	}
+	*deprecated = nil
+
+	return true
+}
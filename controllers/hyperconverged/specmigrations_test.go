@@ -0,0 +1,174 @@
+package hyperconverged
+
+import (
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+	"github.com/kubevirt/hyperconverged-cluster-operator/version"
+)
+
+var _ = Describe("test spec migrations", func() {
+	var (
+		expected *BasicExpected
+		hco      *hcov1beta1.HyperConverged
+	)
+
+	getClusterInfo := hcoutil.GetClusterInfo
+	now := common.Now
+
+	BeforeEach(func() {
+		hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+			return commontestutils.ClusterInfoMock{}
+		}
+
+		_ = os.Setenv("VIRTIOWIN_CONTAINER", commontestutils.VirtioWinImage)
+		_ = os.Setenv("OPERATOR_NAMESPACE", namespace)
+		_ = os.Setenv(hcoutil.HcoKvIoVersionName, version.Version)
+
+		expected = getBasicDeployment()
+		hco = expected.hco
+	})
+
+	AfterEach(func() {
+		hcoutil.GetClusterInfo = getClusterInfo
+		common.Now = now
+	})
+
+	Context("runSpecMigrations", func() {
+		renameMigration := specMigration{
+			name: "renamed",
+			migrate: func(hc *hcov1beta1.HyperConverged) (string, bool) {
+				if hc.Spec.LocalStorageClassName == "" { //nolint SA1019
+					return "", false
+				}
+				hc.Spec.LocalStorageClassName = "" //nolint SA1019
+				return "dropped localStorageClassName", true
+			},
+		}
+
+		noopMigration := specMigration{
+			name: "noop",
+			migrate: func(_ *hcov1beta1.HyperConverged) (string, bool) {
+				return "", false
+			},
+		}
+
+		It("should do nothing if there is nothing to migrate", func() {
+			r := initReconciler(expected.initClient(), nil)
+			req := commontestutils.NewReq(hco)
+
+			Expect(r.runSpecMigrations(req, []specMigration{renameMigration, noopMigration})).To(BeFalse())
+			Expect(req.Dirty).To(BeFalse())
+			Expect(req.StatusDirty).To(BeFalse())
+			Expect(hco.Status.SpecMigrations).To(BeEmpty())
+			Expect(r.eventEmitter.(*commontestutils.EventEmitterMock).CheckEvents([]commontestutils.MockEvent{
+				{EventType: corev1.EventTypeNormal, Reason: "SpecMigrated"},
+			})).To(BeFalse())
+		})
+
+		It("should record the applied migrations in the status, and emit an event", func() {
+			migrationTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+			common.Now = func() time.Time { return migrationTime }
+
+			hco.Spec.LocalStorageClassName = "local" //nolint SA1019
+			r := initReconciler(expected.initClient(), nil)
+			req := commontestutils.NewReq(hco)
+
+			Expect(r.runSpecMigrations(req, []specMigration{renameMigration, noopMigration})).To(BeTrue())
+			Expect(req.Dirty).To(BeTrue())
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(hco.Spec.LocalStorageClassName).To(BeEmpty()) //nolint SA1019
+
+			Expect(hco.Status.SpecMigrations).To(HaveLen(1))
+			Expect(hco.Status.SpecMigrations[0].Name).To(Equal("renamed"))
+			Expect(hco.Status.SpecMigrations[0].Message).To(Equal("dropped localStorageClassName"))
+			Expect(hco.Status.SpecMigrations[0].Version).To(Equal(version.Version))
+			Expect(hco.Status.SpecMigrations[0].MigrationTime.Time).To(Equal(migrationTime))
+
+			Expect(r.eventEmitter.(*commontestutils.EventEmitterMock).CheckEvents([]commontestutils.MockEvent{
+				{EventType: corev1.EventTypeNormal, Reason: "SpecMigrated", Msg: "renamed: dropped localStorageClassName"},
+			})).To(BeTrue())
+
+			By("replacing the status of a migration that runs again")
+			hco.Spec.LocalStorageClassName = "local" //nolint SA1019
+			common.Now = func() time.Time { return migrationTime.Add(time.Hour) }
+
+			Expect(r.runSpecMigrations(req, []specMigration{renameMigration})).To(BeTrue())
+			Expect(hco.Status.SpecMigrations).To(HaveLen(1))
+			Expect(hco.Status.SpecMigrations[0].MigrationTime.Time).To(Equal(migrationTime.Add(time.Hour)))
+		})
+	})
+
+	Context("migrateMediatedDevicesTypes", func() {
+		It("should do nothing if the mediated devices are not configured", func() {
+			hco.Spec.MediatedDevicesConfiguration = nil
+			_, migrated := migrateMediatedDevicesTypes(hco)
+			Expect(migrated).To(BeFalse())
+		})
+
+		It("should do nothing if the deprecated fields are not set", func() {
+			hco.Spec.MediatedDevicesConfiguration = &hcov1beta1.MediatedDevicesConfiguration{
+				MediatedDeviceTypes: []string{"nvidia-222"},
+				NodeMediatedDeviceTypes: []hcov1beta1.NodeMediatedDeviceTypesConfig{
+					{
+						NodeSelector:        map[string]string{"gpu": "a"},
+						MediatedDeviceTypes: []string{"nvidia-223"},
+					},
+				},
+			}
+			_, migrated := migrateMediatedDevicesTypes(hco)
+			Expect(migrated).To(BeFalse())
+		})
+
+		It("should move the deprecated fields to their replacements", func() {
+			hco.Spec.MediatedDevicesConfiguration = &hcov1beta1.MediatedDevicesConfiguration{
+				MediatedDevicesTypes: []string{"nvidia-222"}, //nolint SA1019
+				NodeMediatedDeviceTypes: []hcov1beta1.NodeMediatedDeviceTypesConfig{
+					{
+						NodeSelector:        map[string]string{"gpu": "a"},
+						MediatedDeviceTypes: []string{"nvidia-223"},
+					},
+					{
+						NodeSelector:         map[string]string{"gpu": "b"},
+						MediatedDevicesTypes: []string{"nvidia-224"}, //nolint SA1019
+					},
+				},
+			}
+
+			msg, migrated := migrateMediatedDevicesTypes(hco)
+			Expect(migrated).To(BeTrue())
+			Expect(msg).To(Equal("migrated spec.mediatedDevicesConfiguration.mediatedDevicesTypes, spec.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[1].mediatedDevicesTypes to the mediatedDeviceTypes field"))
+
+			mdc := hco.Spec.MediatedDevicesConfiguration
+			Expect(mdc.MediatedDevicesTypes).To(BeEmpty()) //nolint SA1019
+			Expect(mdc.MediatedDeviceTypes).To(Equal([]string{"nvidia-222"}))
+			Expect(mdc.NodeMediatedDeviceTypes[0].MediatedDeviceTypes).To(Equal([]string{"nvidia-223"}))
+			Expect(mdc.NodeMediatedDeviceTypes[1].MediatedDevicesTypes).To(BeEmpty()) //nolint SA1019
+			Expect(mdc.NodeMediatedDeviceTypes[1].MediatedDeviceTypes).To(Equal([]string{"nvidia-224"}))
+
+			By("not migrating again")
+			_, migrated = migrateMediatedDevicesTypes(hco)
+			Expect(migrated).To(BeFalse())
+		})
+
+		It("should keep the replacement field, if both fields are set", func() {
+			hco.Spec.MediatedDevicesConfiguration = &hcov1beta1.MediatedDevicesConfiguration{
+				MediatedDevicesTypes: []string{"nvidia-222"}, //nolint SA1019
+				MediatedDeviceTypes:  []string{"nvidia-223"},
+			}
+
+			_, migrated := migrateMediatedDevicesTypes(hco)
+			Expect(migrated).To(BeTrue())
+			Expect(hco.Spec.MediatedDevicesConfiguration.MediatedDevicesTypes).To(BeEmpty()) //nolint SA1019
+			Expect(hco.Spec.MediatedDevicesConfiguration.MediatedDeviceTypes).To(Equal([]string{"nvidia-223"}))
+		})
+	})
+})
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
 	consolev1 "github.com/openshift/api/console/v1"
 	operatorv1 "github.com/openshift/api/operator/v1"
 	routev1 "github.com/openshift/api/route/v1"
@@ -53,18 +55,23 @@ func initReconciler(client client.Client, old *ReconcileHyperConverged) *Reconci
 	s := commontestutils.GetScheme()
 	eventEmitter := commontestutils.NewEventEmitterMock()
 	ci := commontestutils.ClusterInfoMock{}
-	operandHandler := operands.NewOperandHandler(client, s, ci, eventEmitter)
+	operandHandler := operands.NewOperandHandler(client, client, s, ci, eventEmitter)
 	upgradeMode := false
 	firstLoop := true
 	upgradeableCondition := newStubOperatorCondition()
+	var upgradeStartTime time.Time
+	var upgradeTimedOutComponents []string
 	if old != nil {
 		upgradeMode = old.upgradeMode
 		firstLoop = old.firstLoop
 		upgradeableCondition = old.upgradeableCondition
+		upgradeStartTime = old.upgradeStartTime
+		upgradeTimedOutComponents = old.upgradeTimedOutComponents
 	}
 	// Create a ReconcileHyperConverged object with the scheme and fake client
 	return &ReconcileHyperConverged{
 		client:               client,
+		apiReader:            client,
 		scheme:               s,
 		operandHandler:       operandHandler,
 		eventEmitter:         eventEmitter,
@@ -72,6 +79,10 @@ func initReconciler(client client.Client, old *ReconcileHyperConverged) *Reconci
 		ownVersion:           version.Version,
 		upgradeMode:          upgradeMode,
 		upgradeableCondition: upgradeableCondition,
+		upgradeStartTime:     upgradeStartTime,
+		upgradeTimeout:       defaultUpgradeTimeout,
+
+		upgradeTimedOutComponents: upgradeTimedOutComponents,
 	}
 }
 
@@ -217,8 +228,8 @@ func getBasicDeployment() *BasicExpected {
 		BlockOwnerDeletion: ptr.To(false),
 		Controller:         ptr.To(false),
 	}
-	res.mService = alerts.NewMetricsService(namespace, deploymentRef)
-	res.serviceMonitor = alerts.NewServiceMonitor(namespace, deploymentRef)
+	res.mService = alerts.NewMetricsService(namespace, deploymentRef, true)
+	res.serviceMonitor = alerts.NewServiceMonitor(namespace, deploymentRef, true)
 
 	expectedKV, err := operands.NewKubeVirt(hco, namespace)
 	ExpectWithOffset(1, err).ToNot(HaveOccurred())
@@ -384,3 +395,44 @@ func checkAvailability(hco *hcov1beta1.HyperConverged, expected metav1.Condition
 		Fail(fmt.Sprintf(`Can't find 'Available' condition; %+v`, hco.Status.Conditions))
 	}
 }
+
+// monitoringAvailabilityClusterInfo simulates the installation or the removal of the monitoring CRDs after HCO was
+// started
+type monitoringAvailabilityClusterInfo struct {
+	commontestutils.ClusterInfoMock
+	available bool
+	changed   bool
+}
+
+func (c *monitoringAvailabilityClusterInfo) IsMonitoringAvailable() bool {
+	return c.available
+}
+
+func (c *monitoringAvailabilityClusterInfo) RefreshMonitoringAvailability(_ context.Context, _ client.Client) bool {
+	changed := c.changed
+	c.changed = false
+	return changed
+}
+
+// archClusterInfo simulates a cluster with nodes of the given architectures
+type archClusterInfo struct {
+	commontestutils.ClusterInfoMock
+	archs []string
+}
+
+func (c archClusterInfo) GetNodeArchitectures() []string {
+	return c.archs
+}
+
+// hostedClusterInfo simulates a cluster with a hosted control plane, and without the console
+type hostedClusterInfo struct {
+	commontestutils.ClusterInfoMock
+}
+
+func (hostedClusterInfo) GetControlPlaneTopology() openshiftconfigv1.TopologyMode {
+	return openshiftconfigv1.ExternalTopologyMode
+}
+
+func (hostedClusterInfo) IsConsoleAvailable() bool {
+	return false
+}
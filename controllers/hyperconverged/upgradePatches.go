@@ -3,11 +3,15 @@ package hyperconverged
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/blang/semver/v4"
 	jsonpatch "github.com/evanphx/json-patch/v5"
@@ -17,6 +21,11 @@ import (
 
 const (
 	upgradeChangesFileLocation = "./upgradePatches.json"
+
+	// hotfixUpgradePatchesConfigMapName is the name of an optional ConfigMap in the HCO namespace, with additional
+	// upgrade patches. It allows shipping an emergency migration of the HyperConverged CR, without a new HCO image.
+	hotfixUpgradePatchesConfigMapName = "hco-upgrade-patches"
+	hotfixUpgradePatchesConfigMapKey  = "upgradePatches.json"
 )
 
 type hcoCRPatch struct {
@@ -51,6 +60,12 @@ type UpgradePatches struct {
 	ObjectsToBeRemoved []objectToBeRemoved `json:"objectsToBeRemoved"`
 }
 
+// hotfixUpgradePatches is the format of the hotfix upgrade patches ConfigMap. Only the patches of the HyperConverged CR
+// are supported.
+type hotfixUpgradePatches struct {
+	HCOCRPatchList []hcoCRPatch `json:"hcoCRPatchList"`
+}
+
 var (
 	hcoUpgradeChanges     UpgradePatches
 	hcoUpgradeChangesRead = false
@@ -132,6 +147,40 @@ func validateUpgradePatch(req *common.HcoRequest, p hcoCRPatch) error {
 	return nil
 }
 
+// readHotfixUpgradePatches reads and validates the upgrade patches of the hotfix upgrade patches ConfigMap, if it
+// exists. If any of the patches is not valid, none of them is returned, to avoid a partial migration.
+func readHotfixUpgradePatches(req *common.HcoRequest, reader client.Reader) ([]hcoCRPatch, error) {
+	cm := &corev1.ConfigMap{}
+	key := client.ObjectKey{Namespace: req.Namespace, Name: hotfixUpgradePatchesConfigMapName}
+	if err := reader.Get(req.Ctx, key, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read the %s hotfix upgrade patches ConfigMap; %w", hotfixUpgradePatchesConfigMapName, err)
+	}
+
+	data, found := cm.Data[hotfixUpgradePatchesConfigMapKey]
+	if !found {
+		return nil, fmt.Errorf("the %s hotfix upgrade patches ConfigMap does not contain the %s key", hotfixUpgradePatchesConfigMapName, hotfixUpgradePatchesConfigMapKey)
+	}
+
+	patches := hotfixUpgradePatches{}
+	dec := json.NewDecoder(strings.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&patches); err != nil {
+		return nil, fmt.Errorf("failed to parse the %s hotfix upgrade patches ConfigMap; %w", hotfixUpgradePatchesConfigMapName, err)
+	}
+
+	for i, p := range patches.HCOCRPatchList {
+		if err := validateUpgradePatch(req, p); err != nil {
+			return nil, fmt.Errorf("invalid upgrade patch #%d in the %s hotfix upgrade patches ConfigMap; %w", i, hotfixUpgradePatchesConfigMapName, err)
+		}
+	}
+
+	req.Logger.Info("read the hotfix upgrade patches", "ConfigMap", hotfixUpgradePatchesConfigMapName, "resourceVersion", cm.ResourceVersion, "patches", len(patches.HCOCRPatchList))
+	return patches.HCOCRPatchList, nil
+}
+
 func validateUpgradeLeftover(_ *common.HcoRequest, r objectToBeRemoved) error {
 	_, err := semver.ParseRange(r.SemverRange)
 	if err != nil {
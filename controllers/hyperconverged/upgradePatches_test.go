@@ -6,6 +6,9 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
@@ -191,6 +194,57 @@ var _ = Describe("upgradePatches", func() {
 
 	})
 
+	Context("readHotfixUpgradePatches", func() {
+		var req *common.HcoRequest
+
+		newConfigMap := func(data string) *corev1.ConfigMap {
+			return &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      hotfixUpgradePatchesConfigMapName,
+					Namespace: commontestutils.Namespace,
+				},
+				Data: map[string]string{hotfixUpgradePatchesConfigMapKey: data},
+			}
+		}
+
+		BeforeEach(func() {
+			req = commontestutils.NewReq(commontestutils.NewHco())
+		})
+
+		It("should return no patches if the ConfigMap does not exist", func() {
+			cl := commontestutils.InitClient([]client.Object{})
+
+			patches, err := readHotfixUpgradePatches(req, cl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(patches).To(BeEmpty())
+		})
+
+		It("should read the patches from the ConfigMap", func() {
+			cm := newConfigMap(`{"hcoCRPatchList": [{"semverRange": "<1.11.0", "jsonPatch": [{"op": "replace", "path": "/spec/liveMigrationConfig/progressTimeout", "value": 1000}]}]}`)
+			cl := commontestutils.InitClient([]client.Object{cm})
+
+			patches, err := readHotfixUpgradePatches(req, cl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(patches).To(HaveLen(1))
+			Expect(patches[0].SemverRange).To(Equal("<1.11.0"))
+		})
+
+		DescribeTable("should reject a ConfigMap that is not valid", func(cm *corev1.ConfigMap, message string) {
+			cl := commontestutils.InitClient([]client.Object{cm})
+
+			patches, err := readHotfixUpgradePatches(req, cl)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+			Expect(patches).To(BeNil())
+		},
+			Entry("missing key", &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: hotfixUpgradePatchesConfigMapName, Namespace: commontestutils.Namespace},
+			}, "does not contain the upgradePatches.json key"),
+			Entry("bad json", newConfigMap(`{"hcoCRPatchList": [`), "failed to parse the hco-upgrade-patches hotfix upgrade patches ConfigMap"),
+			Entry("unsupported field", newConfigMap(`{"objectsToBeRemoved": []}`), `unknown field "objectsToBeRemoved"`),
+			Entry("bad semver range", newConfigMap(`{"hcoCRPatchList": [{"semverRange": "bad", "jsonPatch": []}]}`), "invalid upgrade patch #0"),
+			Entry("not on spec", newConfigMap(`{"hcoCRPatchList": [{"semverRange": "<1.11.0", "jsonPatch": [{"op": "replace", "path": "/status/systemHealthStatus", "value": "healthy"}]}]}`), "can only modify spec fields"),
+		)
+	})
 })
 
 func copyTestFile(filename string) error {
@@ -0,0 +1,152 @@
+package nodes
+
+import (
+	"context"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var (
+	log = logf.Log.WithName("controller_nodes")
+
+	// all the node events are reconciled by a single request, as the whole node list is counted anyway
+	nodesRequest = reconcile.Request{NamespacedName: types.NamespacedName{Name: "nodes"}}
+)
+
+// ReconcileNodeCounter counts the cluster nodes again, when nodes are added or removed, or when their roles or taints
+// are modified; e.g. when worker nodes are added to a single node cluster. If the node topology was changed, it triggers
+// the reconciliation of the HyperConverged CR, so the operands are adjusted to the new topology.
+type ReconcileNodeCounter struct {
+	client    client.Client
+	ci        hcoutil.ClusterInfo
+	hc        types.NamespacedName
+	hcoEvents chan<- event.GenericEvent
+}
+
+// Implement reconcile.Reconciler so the controller can reconcile objects
+var _ reconcile.Reconciler = &ReconcileNodeCounter{}
+
+// RegisterReconciler creates a new Nodes Reconciler and registers it into manager. The reconciliation of the
+// HyperConverged CR is triggered by sending an event to the hcoEvents channel.
+func RegisterReconciler(mgr manager.Manager, ci hcoutil.ClusterInfo, hcoEvents chan<- event.GenericEvent) error {
+	r, err := newReconciler(mgr, ci, hcoEvents)
+	if err != nil {
+		return err
+	}
+	return add(mgr, r)
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager, ci hcoutil.ClusterInfo, hcoEvents chan<- event.GenericEvent) (reconcile.Reconciler, error) {
+	namespace, err := hcoutil.GetOperatorNamespaceFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ReconcileNodeCounter{
+		client:    mgr.GetClient(),
+		ci:        ci,
+		hc:        types.NamespacedName{Name: hcoutil.HyperConvergedName, Namespace: namespace},
+		hcoEvents: hcoEvents,
+	}
+	return r, nil
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+
+	// Setup a new controller to reconcile the nodes
+	log.Info("Setting up nodes controller")
+	c, err := controller.New("nodes-controller", mgr, controller.Options{
+		Reconciler: r,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Watch for the nodes that were added or removed, or that their role, architecture or taints were changed
+	return c.Watch(
+		source.Kind(mgr.GetCache(), &corev1.Node{}),
+		handler.EnqueueRequestsFromMapFunc(func(_ context.Context, _ client.Object) []reconcile.Request {
+			return []reconcile.Request{nodesRequest}
+		}),
+		nodeTopologyPredicate,
+	)
+}
+
+// nodeTopologyPredicate filters out the node updates that don't affect the node topology, like the status heartbeats
+var nodeTopologyPredicate = predicate.Funcs{
+	CreateFunc: func(_ event.CreateEvent) bool { return true },
+	DeleteFunc: func(_ event.DeleteEvent) bool { return true },
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldNode, ok := e.ObjectOld.(*corev1.Node)
+		if !ok {
+			return false
+		}
+		newNode, ok := e.ObjectNew.(*corev1.Node)
+		if !ok {
+			return false
+		}
+
+		return hasLabelChanged(oldNode, newNode, hcoutil.NodeRoleMasterLabel) ||
+			hasLabelChanged(oldNode, newNode, hcoutil.NodeRoleWorkerLabel) ||
+			hasLabelChanged(oldNode, newNode, hcoutil.NodeRoleInfraLabel) ||
+			oldNode.Labels[corev1.LabelArchStable] != newNode.Labels[corev1.LabelArchStable] ||
+			oldNode.Status.NodeInfo.Architecture != newNode.Status.NodeInfo.Architecture ||
+			!reflect.DeepEqual(oldNode.Spec.Taints, newNode.Spec.Taints)
+	},
+	GenericFunc: func(_ event.GenericEvent) bool { return false },
+}
+
+func hasLabelChanged(oldNode, newNode *corev1.Node, label string) bool {
+	_, oldExists := oldNode.Labels[label]
+	_, newExists := newNode.Labels[label]
+
+	return oldExists != newExists
+}
+
+// Reconcile refreshes the node topology of the cluster, and triggers the reconciliation of the HyperConverged CR if
+// it was changed
+func (r *ReconcileNodeCounter) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	changed, err := r.ci.RefreshNodeTopology(ctx, r.client)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !changed {
+		return reconcile.Result{}, nil
+	}
+
+	log.Info("The node topology was changed; reconciling the HyperConverged CR",
+		"infrastructureTopology", r.ci.GetInfrastructureTopology(),
+		"architectures", r.ci.GetNodeArchitectures(),
+		"schedulableInfraNodes", r.ci.GetInfraNodeCount(),
+		"schedulableWorkerNodes", r.ci.GetWorkerNodeCount(),
+	)
+
+	hc := &hcov1beta1.HyperConverged{}
+	hc.Name = r.hc.Name
+	hc.Namespace = r.hc.Namespace
+
+	// a reconciliation that is already pending covers this change as well
+	select {
+	case r.hcoEvents <- event.GenericEvent{Object: hc}:
+	default:
+	}
+
+	return reconcile.Result{}, nil
+}
@@ -0,0 +1,175 @@
+package nodes
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("NodesController", func() {
+
+	Describe("Controller setup", func() {
+		It("Should setup the controller", func() {
+			Expect(os.Setenv(hcoutil.OperatorNamespaceEnv, commontestutils.Namespace)).To(Succeed())
+
+			cl := commontestutils.InitClient(nil)
+			mgr, err := commontestutils.NewManagerMock(&rest.Config{}, manager.Options{}, cl, log)
+			Expect(err).ToNot(HaveOccurred())
+			mockmgr, ok := mgr.(*commontestutils.ManagerMock)
+			Expect(ok).To(BeTrue())
+
+			Expect(mockmgr.GetRunnables()).To(BeEmpty())
+
+			Expect(RegisterReconciler(mgr, commontestutils.ClusterInfoMock{}, make(chan event.GenericEvent, 1))).To(Succeed())
+			Expect(mockmgr.GetRunnables()).To(HaveLen(1))
+		})
+	})
+
+	Describe("Reconcile", func() {
+		var (
+			hcoEvents chan event.GenericEvent
+			hcName    = types.NamespacedName{Name: hcoutil.HyperConvergedName, Namespace: commontestutils.Namespace}
+		)
+
+		newReconcileNodeCounter := func(changed bool, err error) *ReconcileNodeCounter {
+			return &ReconcileNodeCounter{
+				client:    commontestutils.InitClient(nil),
+				ci:        nodeTopologyClusterInfo{changed: changed, err: err},
+				hc:        hcName,
+				hcoEvents: hcoEvents,
+			}
+		}
+
+		BeforeEach(func() {
+			hcoEvents = make(chan event.GenericEvent, 1)
+		})
+
+		It("should trigger the reconciliation of the HyperConverged CR if the node topology was changed", func() {
+			r := newReconcileNodeCounter(true, nil)
+
+			Expect(r.Reconcile(context.Background(), nodesRequest)).To(BeZero())
+
+			Expect(hcoEvents).To(HaveLen(1))
+			evt := <-hcoEvents
+			Expect(evt.Object.GetName()).To(Equal(hcName.Name))
+			Expect(evt.Object.GetNamespace()).To(Equal(hcName.Namespace))
+		})
+
+		It("should not block if the reconciliation of the HyperConverged CR is already pending", func() {
+			r := newReconcileNodeCounter(true, nil)
+
+			Expect(r.Reconcile(context.Background(), nodesRequest)).To(BeZero())
+			Expect(r.Reconcile(context.Background(), nodesRequest)).To(BeZero())
+
+			Expect(hcoEvents).To(HaveLen(1))
+		})
+
+		It("should not trigger the reconciliation of the HyperConverged CR if the node topology was not changed", func() {
+			r := newReconcileNodeCounter(false, nil)
+
+			Expect(r.Reconcile(context.Background(), nodesRequest)).To(BeZero())
+
+			Expect(hcoEvents).To(BeEmpty())
+		})
+
+		It("should return an error if the nodes can't be read", func() {
+			r := newReconcileNodeCounter(false, errors.New("fake error"))
+
+			_, err := r.Reconcile(context.Background(), nodesRequest)
+			Expect(err).To(MatchError("fake error"))
+
+			Expect(hcoEvents).To(BeEmpty())
+		})
+	})
+
+	Describe("nodeTopologyPredicate", func() {
+		newNode := func(labels map[string]string, arch string) *corev1.Node {
+			return &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "node",
+					Labels: labels,
+				},
+				Status: corev1.NodeStatus{
+					NodeInfo: corev1.NodeSystemInfo{
+						Architecture: arch,
+					},
+				},
+			}
+		}
+
+		withTaint := func(node *corev1.Node, taint corev1.Taint) *corev1.Node {
+			node.Spec.Taints = append(node.Spec.Taints, taint)
+			return node
+		}
+
+		It("should accept created and deleted nodes", func() {
+			node := newNode(nil, "amd64")
+			Expect(nodeTopologyPredicate.Create(event.CreateEvent{Object: node})).To(BeTrue())
+			Expect(nodeTopologyPredicate.Delete(event.DeleteEvent{Object: node})).To(BeTrue())
+		})
+
+		DescribeTable("should filter the node updates", func(oldNode, newNode client.Object, expected bool) {
+			Expect(nodeTopologyPredicate.Update(event.UpdateEvent{ObjectOld: oldNode, ObjectNew: newNode})).To(Equal(expected))
+		},
+			Entry("no change",
+				newNode(map[string]string{hcoutil.NodeRoleWorkerLabel: ""}, "amd64"),
+				newNode(map[string]string{hcoutil.NodeRoleWorkerLabel: ""}, "amd64"),
+				false,
+			),
+			Entry("unrelated label was added",
+				newNode(map[string]string{hcoutil.NodeRoleWorkerLabel: ""}, "amd64"),
+				newNode(map[string]string{hcoutil.NodeRoleWorkerLabel: "", "some-label": "value"}, "amd64"),
+				false,
+			),
+			Entry("worker role was added",
+				newNode(map[string]string{hcoutil.NodeRoleMasterLabel: ""}, "amd64"),
+				newNode(map[string]string{hcoutil.NodeRoleMasterLabel: "", hcoutil.NodeRoleWorkerLabel: ""}, "amd64"),
+				true,
+			),
+			Entry("master role was removed",
+				newNode(map[string]string{hcoutil.NodeRoleMasterLabel: ""}, "amd64"),
+				newNode(nil, "amd64"),
+				true,
+			),
+			Entry("architecture was changed",
+				newNode(nil, ""),
+				newNode(nil, "arm64"),
+				true,
+			),
+			Entry("infra role was added",
+				newNode(map[string]string{hcoutil.NodeRoleWorkerLabel: ""}, "amd64"),
+				newNode(map[string]string{hcoutil.NodeRoleWorkerLabel: "", hcoutil.NodeRoleInfraLabel: ""}, "amd64"),
+				true,
+			),
+			Entry("node was tainted",
+				newNode(nil, "amd64"),
+				withTaint(newNode(nil, "amd64"), corev1.Taint{Key: hcoutil.NodeRoleInfraLabel, Effect: corev1.TaintEffectNoSchedule}),
+				true,
+			),
+		)
+	})
+})
+
+// nodeTopologyClusterInfo simulates the refresh of the node topology
+type nodeTopologyClusterInfo struct {
+	commontestutils.ClusterInfoMock
+	changed bool
+	err     error
+}
+
+func (c nodeTopologyClusterInfo) RefreshNodeTopology(_ context.Context, _ client.Reader) (bool, error) {
+	return c.changed, c.err
+}
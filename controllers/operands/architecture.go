@@ -0,0 +1,90 @@
+package operands
+
+import (
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	deployKubeSecondaryDNSFeatureGate   = "deployKubeSecondaryDNS"
+	deployVMConsoleProxyFeatureGate     = "deployVmConsoleProxy"
+	enableManagedTenantQuotaFeatureGate = "enableManagedTenantQuota"
+)
+
+// archFeatureGate is a feature gate that deploys an operand, that is only built for some of the node architectures
+type archFeatureGate struct {
+	name          string
+	architectures []string
+	isEnabled     func(hc *hcov1beta1.HyperConverged) bool
+}
+
+// archFeatureGates are the feature gates that are not supported on all the node architectures. The feature gates that
+// are not listed here are supported on all the architectures.
+var archFeatureGates = []archFeatureGate{
+	{
+		name:          deployKubeSecondaryDNSFeatureGate,
+		architectures: []string{hcoutil.ArchAMD64, hcoutil.ArchARM64},
+		isEnabled: func(hc *hcov1beta1.HyperConverged) bool {
+			return hc.Spec.FeatureGates.DeployKubeSecondaryDNS != nil && *hc.Spec.FeatureGates.DeployKubeSecondaryDNS
+		},
+	},
+	{
+		name:          deployVMConsoleProxyFeatureGate,
+		architectures: []string{hcoutil.ArchAMD64, hcoutil.ArchARM64},
+		isEnabled: func(hc *hcov1beta1.HyperConverged) bool {
+			return hc.Spec.FeatureGates.DeployVMConsoleProxy != nil && *hc.Spec.FeatureGates.DeployVMConsoleProxy
+		},
+	},
+	{
+		name:          enableManagedTenantQuotaFeatureGate,
+		architectures: []string{hcoutil.ArchAMD64, hcoutil.ArchARM64},
+		isEnabled: func(hc *hcov1beta1.HyperConverged) bool {
+			return hc.Spec.FeatureGates.EnableManagedTenantQuota != nil && *hc.Spec.FeatureGates.EnableManagedTenantQuota
+		},
+	},
+}
+
+// UnsupportedFeatureGate is an enabled feature gate, that is not supported on some of the cluster node architectures
+type UnsupportedFeatureGate struct {
+	Name string
+	// Architectures are the node architectures that the feature gate does not support
+	Architectures []string
+}
+
+// GetUnsupportedFeatureGates returns the enabled feature gates that are not supported on all the architectures of the
+// cluster nodes. HCO does not deploy the operands of these feature gates, as their pods would fail to run on the nodes
+// of the unsupported architectures.
+func GetUnsupportedFeatureGates(hc *hcov1beta1.HyperConverged) []UnsupportedFeatureGate {
+	var unsupported []UnsupportedFeatureGate
+	for _, fg := range archFeatureGates {
+		if !fg.isEnabled(hc) {
+			continue
+		}
+
+		if archs := getUnsupportedArchitectures(fg.architectures); len(archs) > 0 {
+			unsupported = append(unsupported, UnsupportedFeatureGate{Name: fg.name, Architectures: archs})
+		}
+	}
+
+	return unsupported
+}
+
+// isFeatureGateSupported returns false if the feature gate is not supported on some of the cluster node architectures
+func isFeatureGateSupported(name string) bool {
+	for _, fg := range archFeatureGates {
+		if fg.name == name {
+			return len(getUnsupportedArchitectures(fg.architectures)) == 0
+		}
+	}
+	return true
+}
+
+func getUnsupportedArchitectures(supported []string) []string {
+	var unsupported []string
+	for _, arch := range hcoutil.GetClusterInfo().GetNodeArchitectures() {
+		if !hcoutil.ContainsString(supported, arch) {
+			unsupported = append(unsupported, arch)
+		}
+	}
+	return unsupported
+}
@@ -0,0 +1,83 @@
+package operands
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Architecture-aware feature gates", func() {
+	var hco *hcov1beta1.HyperConverged
+
+	getClusterInfo := hcoutil.GetClusterInfo
+
+	setNodeArchitectures := func(archs ...string) {
+		hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+			return archClusterInfo{archs: archs}
+		}
+	}
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		hco.Spec.FeatureGates.DeployKubeSecondaryDNS = ptr.To(true)
+		hco.Spec.FeatureGates.DeployVMConsoleProxy = ptr.To(true)
+		hco.Spec.FeatureGates.EnableManagedTenantQuota = ptr.To(true)
+	})
+
+	AfterEach(func() {
+		hcoutil.GetClusterInfo = getClusterInfo
+	})
+
+	It("should support all the feature gates on amd64 and arm64 nodes", func() {
+		setNodeArchitectures(hcoutil.ArchAMD64, hcoutil.ArchARM64)
+
+		Expect(GetUnsupportedFeatureGates(hco)).To(BeEmpty())
+		Expect(isFeatureGateSupported(deployKubeSecondaryDNSFeatureGate)).To(BeTrue())
+		Expect(IsMTQEnabled(hco)).To(BeTrue())
+	})
+
+	It("should report the enabled feature gates that are not supported on s390x nodes", func() {
+		setNodeArchitectures(hcoutil.ArchAMD64, hcoutil.ArchS390X)
+
+		hco.Spec.FeatureGates.DeployVMConsoleProxy = ptr.To(false)
+
+		Expect(GetUnsupportedFeatureGates(hco)).To(Equal([]UnsupportedFeatureGate{
+			{Name: deployKubeSecondaryDNSFeatureGate, Architectures: []string{hcoutil.ArchS390X}},
+			{Name: enableManagedTenantQuotaFeatureGate, Architectures: []string{hcoutil.ArchS390X}},
+		}))
+		Expect(isFeatureGateSupported(deployVMConsoleProxyFeatureGate)).To(BeFalse())
+		Expect(isFeatureGateSupported("unknownFeatureGate")).To(BeTrue())
+	})
+
+	It("should not deploy the operands of the unsupported feature gates", func() {
+		setNodeArchitectures(hcoutil.ArchS390X)
+
+		Expect(IsMTQEnabled(hco)).To(BeFalse())
+
+		cna, err := NewNetworkAddons(hco)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cna.Spec.KubeSecondaryDNS).To(BeNil())
+
+		ssp, _, err := NewSSP(hco)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ssp.Spec.FeatureGates.DeployVmConsoleProxy).To(BeFalse())
+	})
+})
+
+// archClusterInfo simulates a cluster with nodes of the given architectures
+type archClusterInfo struct {
+	commontestutils.ClusterInfoMock
+	archs []string
+}
+
+func (c archClusterInfo) GetNodeArchitectures() []string {
+	return c.archs
+}
+
+func (archClusterInfo) IsInfrastructureHighlyAvailable() bool {
+	return true
+}
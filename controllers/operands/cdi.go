@@ -51,9 +51,11 @@ func (*cdiHooks) getEmptyCr() client.Object { return &cdiv1beta1.CDI{} }
 func (*cdiHooks) getConditions(cr runtime.Object) []metav1.Condition {
 	return osConditionsToK8s(cr.(*cdiv1beta1.CDI).Status.Conditions)
 }
-func (*cdiHooks) checkComponentVersion(cr runtime.Object) bool {
-	found := cr.(*cdiv1beta1.CDI)
-	return checkComponentVersion(hcoutil.CdiVersionEnvV, found.Status.ObservedVersion)
+func (*cdiHooks) getObservedVersion(cr runtime.Object) string {
+	return cr.(*cdiv1beta1.CDI).Status.ObservedVersion
+}
+func (h *cdiHooks) checkComponentVersion(cr runtime.Object) bool {
+	return checkComponentVersion(hcoutil.CdiVersionEnvV, h.getObservedVersion(cr))
 }
 func (h *cdiHooks) reset() {
 	h.cache = nil
@@ -128,15 +130,27 @@ func NewCDI(hc *hcov1beta1.HyperConverged, opts ...string) (*cdiv1beta1.CDI, err
 		spec.Config.FilesystemOverhead = hc.Spec.FilesystemOverhead.DeepCopy()
 	}
 
+	if hc.Spec.DataVolumeTTLSeconds != nil {
+		spec.Config.DataVolumeTTLSeconds = hc.Spec.DataVolumeTTLSeconds
+	}
+
+	if hc.Spec.UploadProxyURLOverride != nil {
+		spec.Config.UploadProxyURLOverride = hc.Spec.UploadProxyURLOverride
+	}
+
 	if hc.Spec.StorageImport != nil {
 		if length := len(hc.Spec.StorageImport.InsecureRegistries); length > 0 {
 			spec.Config.InsecureRegistries = make([]string, length)
 			copy(spec.Config.InsecureRegistries, hc.Spec.StorageImport.InsecureRegistries)
 		}
+
+		if hc.Spec.StorageImport.ImportProxy != nil {
+			spec.Config.ImportProxy = hc.Spec.StorageImport.ImportProxy.DeepCopy()
+		}
 	}
 
-	if hc.Spec.Infra.NodePlacement != nil {
-		hc.Spec.Infra.NodePlacement.DeepCopyInto(&spec.Infra)
+	if infraNodePlacement := getInfraConfig(hc).NodePlacement; infraNodePlacement != nil {
+		infraNodePlacement.DeepCopyInto(&spec.Infra)
 	}
 
 	if hc.Spec.Workloads.NodePlacement != nil {
@@ -16,6 +16,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/reference"
+	"k8s.io/utils/ptr"
 
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
@@ -59,6 +60,37 @@ var _ = Describe("CDI Operand", func() {
 			Expect(foundResource.Annotations).To(Equal(map[string]string{cdiConfigAuthorityAnnotation: ""}))
 		})
 
+		It("should report the observed version of CDI in the HyperConverged status", func() {
+			expectedResource, err := NewCDI(hco)
+			Expect(err).ToNot(HaveOccurred())
+			expectedResource.Status.ObservedVersion = "v1.58.0"
+			cl := commontestutils.InitClient([]client.Object{hco, expectedResource})
+			handler := (*genericOperand)(newCdiHandler(cl, commontestutils.GetScheme()))
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+
+			Expect(hco.Status.OperandVersions).To(Equal([]hcov1beta1.Version{{Name: "CDI", Version: "v1.58.0"}}))
+		})
+
+		It("should report the generation of the HyperConverged CR that was rendered into CDI", func() {
+			hco.Generation = 3
+			expectedResource, err := NewCDI(hco)
+			Expect(err).ToNot(HaveOccurred())
+			cl := commontestutils.InitClient([]client.Object{hco, expectedResource})
+			handler := (*genericOperand)(newCdiHandler(cl, commontestutils.GetScheme()))
+			Expect(handler.ensure(req).Err).ToNot(HaveOccurred())
+			Expect(hco.Status.OperandObservedGenerations).To(Equal([]hcov1beta1.OperandObservedGeneration{{Name: "CDI", ObservedGeneration: 3}}))
+
+			By("updating the generation once the HyperConverged CR is modified")
+			hco.Generation = 4
+			hco.Spec.StorageImport = &hcov1beta1.StorageImportConfig{InsecureRegistries: []string{"myregistry"}}
+			handler.reset()
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+			Expect(hco.Status.OperandObservedGenerations).To(Equal([]hcov1beta1.OperandObservedGeneration{{Name: "CDI", ObservedGeneration: 4}}))
+		})
+
 		It("should find if present", func() {
 			expectedResource, err := NewCDI(hco)
 			Expect(err).ToNot(HaveOccurred())
@@ -125,7 +157,7 @@ var _ = Describe("CDI Operand", func() {
 				existingResource, err := NewCDI(hco)
 				Expect(err).ToNot(HaveOccurred())
 
-				hco.Spec.Infra = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
+				hco.Spec.Infra = hcov1beta1.HyperConvergedInfraConfig{HyperConvergedConfig: hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}}
 				hco.Spec.Workloads = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
 
 				cl := commontestutils.InitClient([]client.Object{hco, existingResource})
@@ -163,7 +195,7 @@ var _ = Describe("CDI Operand", func() {
 			It("should remove node placement if missing in HCO CR", func() {
 
 				hcoNodePlacement := commontestutils.NewHco()
-				hcoNodePlacement.Spec.Infra = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
+				hcoNodePlacement.Spec.Infra = hcov1beta1.HyperConvergedInfraConfig{HyperConvergedConfig: hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}}
 				hcoNodePlacement.Spec.Workloads = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
 				existingResource, err := NewCDI(hcoNodePlacement)
 				Expect(err).ToNot(HaveOccurred())
@@ -201,7 +233,7 @@ var _ = Describe("CDI Operand", func() {
 			})
 
 			It("should modify node placement according to HCO CR", func() {
-				hco.Spec.Infra = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
+				hco.Spec.Infra = hcov1beta1.HyperConvergedInfraConfig{HyperConvergedConfig: hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}}
 				hco.Spec.Workloads = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
 				existingResource, err := NewCDI(hco)
 				Expect(err).ToNot(HaveOccurred())
@@ -239,7 +271,7 @@ var _ = Describe("CDI Operand", func() {
 			})
 
 			It("should overwrite node placement if directly set on CDI CR", func() {
-				hco.Spec.Infra = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
+				hco.Spec.Infra = hcov1beta1.HyperConvergedInfraConfig{HyperConvergedConfig: hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}}
 				hco.Spec.Workloads = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
 				existingResource, err := NewCDI(hco)
 				Expect(err).ToNot(HaveOccurred())
@@ -686,6 +718,173 @@ var _ = Describe("CDI Operand", func() {
 				Expect(foundCDI.Spec.Config.InsecureRegistries).To(HaveLen(2))
 				Expect(foundCDI.Spec.Config.InsecureRegistries).To(ContainElements("other1:5000", "other2:5000"))
 			})
+
+			It("should add ImportProxy if exists in HC and missing in CDI", func() {
+				existingResource, err := NewCDI(hco)
+				Expect(err).ToNot(HaveOccurred())
+				hco.Spec.StorageImport = &hcov1beta1.StorageImportConfig{
+					ImportProxy: &cdiv1beta1.ImportProxy{
+						HTTPProxy:      ptr.To("http://proxy.example.com:3128"),
+						HTTPSProxy:     ptr.To("http://proxy.example.com:3129"),
+						NoProxy:        ptr.To("internal.example.com,10.0.0.0/8"),
+						TrustedCAProxy: ptr.To("my-ca-proxy-cm"),
+					},
+				}
+
+				cl := commontestutils.InitClient([]client.Object{hco, existingResource})
+				handler := (*genericOperand)(newCdiHandler(cl, commontestutils.GetScheme()))
+				res := handler.ensure(req)
+				Expect(res.UpgradeDone).To(BeFalse())
+				Expect(res.Updated).To(BeTrue())
+				Expect(res.Overwritten).To(BeFalse())
+				Expect(res.Err).ToNot(HaveOccurred())
+
+				foundCdi := &cdiv1beta1.CDI{}
+				Expect(
+					cl.Get(context.TODO(),
+						types.NamespacedName{Name: existingResource.Name, Namespace: existingResource.Namespace},
+						foundCdi),
+				).ToNot(HaveOccurred())
+
+				Expect(foundCdi.Spec.Config).ToNot(BeNil())
+				Expect(foundCdi.Spec.Config.ImportProxy).To(Equal(hco.Spec.StorageImport.ImportProxy))
+			})
+
+			It("should remove ImportProxy if missing in HCO CR", func() {
+				existingCdi, err := NewCDI(hco)
+				Expect(err).ToNot(HaveOccurred())
+				existingCdi.Spec.Config.ImportProxy = &cdiv1beta1.ImportProxy{
+					HTTPProxy: ptr.To("http://proxy.example.com:3128"),
+				}
+
+				cl := commontestutils.InitClient([]client.Object{hco, existingCdi})
+				handler := (*genericOperand)(newCdiHandler(cl, commontestutils.GetScheme()))
+				res := handler.ensure(req)
+				Expect(res.UpgradeDone).To(BeFalse())
+				Expect(res.Updated).To(BeTrue())
+				Expect(res.Overwritten).To(BeFalse())
+				Expect(res.Err).ToNot(HaveOccurred())
+
+				foundCDI := &cdiv1beta1.CDI{}
+				Expect(
+					cl.Get(context.TODO(),
+						types.NamespacedName{Name: existingCdi.Name, Namespace: existingCdi.Namespace},
+						foundCDI),
+				).ToNot(HaveOccurred())
+
+				Expect(foundCDI.Spec.Config).ToNot(BeNil())
+				Expect(foundCDI.Spec.Config.ImportProxy).To(BeNil())
+			})
+		})
+
+		Context("Test DataVolumeTTLSeconds", func() {
+			It("should not set DataVolumeTTLSeconds by default", func() {
+				cdi, err := NewCDI(hco)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cdi.Spec.Config.DataVolumeTTLSeconds).To(BeNil())
+			})
+
+			It("should set DataVolumeTTLSeconds according to HCO CR", func() {
+				existingCDI, err := NewCDI(hco)
+				Expect(err).ToNot(HaveOccurred())
+
+				hco.Spec.DataVolumeTTLSeconds = ptr.To[int32](-1)
+
+				cl := commontestutils.InitClient([]client.Object{hco, existingCDI})
+				handler := (*genericOperand)(newCdiHandler(cl, commontestutils.GetScheme()))
+				res := handler.ensure(req)
+				Expect(res.UpgradeDone).To(BeFalse())
+				Expect(res.Updated).To(BeTrue())
+				Expect(res.Overwritten).To(BeFalse())
+				Expect(res.Err).ToNot(HaveOccurred())
+
+				foundCDI := &cdiv1beta1.CDI{}
+				Expect(
+					cl.Get(context.TODO(),
+						types.NamespacedName{Name: existingCDI.Name, Namespace: existingCDI.Namespace},
+						foundCDI),
+				).ToNot(HaveOccurred())
+
+				Expect(foundCDI.Spec.Config.DataVolumeTTLSeconds).To(HaveValue(Equal(int32(-1))))
+			})
+
+			It("should remove DataVolumeTTLSeconds if missing in HCO CR", func() {
+				existingCDI, err := NewCDI(hco)
+				Expect(err).ToNot(HaveOccurred())
+				existingCDI.Spec.Config.DataVolumeTTLSeconds = ptr.To[int32](300)
+
+				cl := commontestutils.InitClient([]client.Object{hco, existingCDI})
+				handler := (*genericOperand)(newCdiHandler(cl, commontestutils.GetScheme()))
+				res := handler.ensure(req)
+				Expect(res.UpgradeDone).To(BeFalse())
+				Expect(res.Updated).To(BeTrue())
+				Expect(res.Overwritten).To(BeFalse())
+				Expect(res.Err).ToNot(HaveOccurred())
+
+				foundCDI := &cdiv1beta1.CDI{}
+				Expect(
+					cl.Get(context.TODO(),
+						types.NamespacedName{Name: existingCDI.Name, Namespace: existingCDI.Namespace},
+						foundCDI),
+				).ToNot(HaveOccurred())
+
+				Expect(foundCDI.Spec.Config.DataVolumeTTLSeconds).To(BeNil())
+			})
+		})
+
+		Context("Test UploadProxyURLOverride", func() {
+			It("should not set UploadProxyURLOverride by default", func() {
+				cdi, err := NewCDI(hco)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cdi.Spec.Config.UploadProxyURLOverride).To(BeNil())
+			})
+
+			It("should set UploadProxyURLOverride according to HCO CR", func() {
+				existingCDI, err := NewCDI(hco)
+				Expect(err).ToNot(HaveOccurred())
+
+				hco.Spec.UploadProxyURLOverride = ptr.To("cdi-uploadproxy.apps.example.com")
+
+				cl := commontestutils.InitClient([]client.Object{hco, existingCDI})
+				handler := (*genericOperand)(newCdiHandler(cl, commontestutils.GetScheme()))
+				res := handler.ensure(req)
+				Expect(res.UpgradeDone).To(BeFalse())
+				Expect(res.Updated).To(BeTrue())
+				Expect(res.Overwritten).To(BeFalse())
+				Expect(res.Err).ToNot(HaveOccurred())
+
+				foundCDI := &cdiv1beta1.CDI{}
+				Expect(
+					cl.Get(context.TODO(),
+						types.NamespacedName{Name: existingCDI.Name, Namespace: existingCDI.Namespace},
+						foundCDI),
+				).ToNot(HaveOccurred())
+
+				Expect(foundCDI.Spec.Config.UploadProxyURLOverride).To(HaveValue(Equal("cdi-uploadproxy.apps.example.com")))
+			})
+
+			It("should remove UploadProxyURLOverride if missing in HCO CR", func() {
+				existingCDI, err := NewCDI(hco)
+				Expect(err).ToNot(HaveOccurred())
+				existingCDI.Spec.Config.UploadProxyURLOverride = ptr.To("https://old-uploadproxy.example.com")
+
+				cl := commontestutils.InitClient([]client.Object{hco, existingCDI})
+				handler := (*genericOperand)(newCdiHandler(cl, commontestutils.GetScheme()))
+				res := handler.ensure(req)
+				Expect(res.UpgradeDone).To(BeFalse())
+				Expect(res.Updated).To(BeTrue())
+				Expect(res.Overwritten).To(BeFalse())
+				Expect(res.Err).ToNot(HaveOccurred())
+
+				foundCDI := &cdiv1beta1.CDI{}
+				Expect(
+					cl.Get(context.TODO(),
+						types.NamespacedName{Name: existingCDI.Name, Namespace: existingCDI.Namespace},
+						foundCDI),
+				).ToNot(HaveOccurred())
+
+				Expect(foundCDI.Spec.Config.UploadProxyURLOverride).To(BeNil())
+			})
 		})
 
 		Context("Test UninstallStrategy", func() {
@@ -998,6 +1197,12 @@ var _ = Describe("CDI Operand", func() {
 				Reason:  "CDIDegraded",
 				Message: "CDI is degraded: Bar",
 			}))
+			// Check the deployment progress
+			Expect(hco.Status.Progress).ToNot(BeNil())
+			Expect(hco.Status.Progress.Components).To(Equal([]hcov1beta1.ComponentProgress{
+				{Name: "CDI", Phase: hcov1beta1.ComponentPhaseDegraded},
+			}))
+			Expect(hco.Status.Progress.Message).To(Equal("0/1 components ready"))
 		})
 
 		Context("Jsonpatch Annotation", func() {
@@ -0,0 +1,82 @@
+package operands
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// commonTemplatesNamespaceOperand creates the namespace that is set in the commonTemplatesNamespace field of the
+// HyperConverged CR, if it does not exist, so SSP will be able to deploy the common templates into it.
+//
+// The namespace is never removed by HCO, because it may contain user resources; e.g. custom templates. HCO only
+// watches its own namespace, so the namespace is not read from the cache. Instead, HCO tries to create it once per
+// modification of the HyperConverged CR.
+type commonTemplatesNamespaceOperand struct {
+	Client client.Client
+	Scheme *runtime.Scheme
+	// the last namespace that was created, or was found to already exist
+	ensured string
+}
+
+func newCommonTemplatesNamespaceHandler(Client client.Client, Scheme *runtime.Scheme) Operand {
+	return &commonTemplatesNamespaceOperand{
+		Client: Client,
+		Scheme: Scheme,
+	}
+}
+
+func (h *commonTemplatesNamespaceOperand) ensure(req *common.HcoRequest) *EnsureResult {
+	res := NewEnsureResult(&corev1.Namespace{})
+
+	namespace := NewCommonTemplatesNamespace(req.Instance)
+	if namespace == nil || namespace.Name == h.ensured {
+		return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
+	}
+
+	res.SetName(namespace.Name)
+	err := common.NewCustomMetadataClient(h.Client, req.Instance).Create(req.Ctx, namespace)
+	switch {
+	case err == nil:
+		req.Logger.Info("Created the common templates namespace", "namespace", namespace.Name)
+		res.SetCreated()
+	case apierrors.IsAlreadyExists(err):
+		req.Logger.Info("The common templates namespace already exists", "namespace", namespace.Name)
+	default:
+		return res.Error(fmt.Errorf("failed to create the %s common templates namespace; %w", namespace.Name, err))
+	}
+
+	h.ensured = namespace.Name
+	return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
+}
+
+func (h *commonTemplatesNamespaceOperand) reset() {
+	h.ensured = ""
+}
+
+// NewCommonTemplatesNamespace returns the namespace for the common templates, if it is set in the HyperConverged CR,
+// or nil if the default namespace is used
+func NewCommonTemplatesNamespace(hc *hcov1beta1.HyperConverged) *corev1.Namespace {
+	if hc.Spec.CommonTemplatesNamespace == nil || *hc.Spec.CommonTemplatesNamespace == defaultCommonTemplatesNamespace {
+		return nil
+	}
+
+	return &corev1.Namespace{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "Namespace",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   *hc.Spec.CommonTemplatesNamespace,
+			Labels: getLabels(hc, hcoutil.AppComponentCompute),
+		},
+	}
+}
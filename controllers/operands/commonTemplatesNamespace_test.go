@@ -0,0 +1,115 @@
+package operands
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Common templates namespace tests", func() {
+	const templatesNamespace = "vm-templates"
+
+	var (
+		hco *v1beta1.HyperConverged
+		req *common.HcoRequest
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		hco.Spec.CommonTemplatesNamespace = ptr.To(templatesNamespace)
+		req = commontestutils.NewReq(hco)
+	})
+
+	Context("test NewCommonTemplatesNamespace", func() {
+		It("should return nil if the namespace is not set", func() {
+			hco.Spec.CommonTemplatesNamespace = nil
+			Expect(NewCommonTemplatesNamespace(hco)).To(BeNil())
+		})
+
+		It("should return nil for the default namespace", func() {
+			hco.Spec.CommonTemplatesNamespace = ptr.To(defaultCommonTemplatesNamespace)
+			Expect(NewCommonTemplatesNamespace(hco)).To(BeNil())
+		})
+
+		It("should return the namespace with the HCO labels", func() {
+			ns := NewCommonTemplatesNamespace(hco)
+			Expect(ns).ToNot(BeNil())
+			Expect(ns.Name).To(Equal(templatesNamespace))
+			Expect(ns.Labels).To(HaveKeyWithValue(hcoutil.AppLabel, hco.Name))
+			Expect(ns.Labels).To(HaveKeyWithValue(hcoutil.AppLabelComponent, string(hcoutil.AppComponentCompute)))
+		})
+	})
+
+	Context("test commonTemplatesNamespaceOperand", func() {
+		It("should create the namespace if it is missing", func() {
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := newCommonTemplatesNamespaceHandler(cl, commontestutils.GetScheme())
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+
+			ns := &corev1.Namespace{}
+			Expect(cl.Get(context.TODO(), client.ObjectKey{Name: templatesNamespace}, ns)).To(Succeed())
+			Expect(ns.Labels).To(HaveKeyWithValue(hcoutil.AppLabel, hco.Name))
+		})
+
+		It("should not modify an existing namespace", func() {
+			existing := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   templatesNamespace,
+					Labels: map[string]string{"user-label": "value"},
+				},
+			}
+			cl := commontestutils.InitClient([]client.Object{hco, existing})
+			handler := newCommonTemplatesNamespaceHandler(cl, commontestutils.GetScheme())
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeFalse())
+
+			ns := &corev1.Namespace{}
+			Expect(cl.Get(context.TODO(), client.ObjectKey{Name: templatesNamespace}, ns)).To(Succeed())
+			Expect(ns.Labels).To(Equal(map[string]string{"user-label": "value"}))
+		})
+
+		It("should not create the default namespace", func() {
+			hco.Spec.CommonTemplatesNamespace = nil
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := newCommonTemplatesNamespaceHandler(cl, commontestutils.GetScheme())
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeFalse())
+
+			namespaces := &corev1.NamespaceList{}
+			Expect(cl.List(context.TODO(), namespaces)).To(Succeed())
+			Expect(namespaces.Items).ToNot(ContainElement(HaveField("Name", defaultCommonTemplatesNamespace)))
+		})
+
+		It("should only try to create the namespace again after a reset", func() {
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := newCommonTemplatesNamespaceHandler(cl, commontestutils.GetScheme())
+
+			Expect(handler.ensure(req).Created).To(BeTrue())
+
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: templatesNamespace}}
+			Expect(cl.Delete(context.TODO(), ns)).To(Succeed())
+
+			Expect(handler.ensure(req).Created).To(BeFalse())
+
+			handler.reset()
+			Expect(handler.ensure(req).Created).To(BeTrue())
+		})
+	})
+})
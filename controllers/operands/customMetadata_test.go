@@ -0,0 +1,132 @@
+package operands
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Custom metadata tests", func() {
+	var (
+		hco *v1beta1.HyperConverged
+		req *common.HcoRequest
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		hco.Spec.Metadata = &v1beta1.ResourcesMetadata{
+			Labels:      map[string]string{"cost-center": "1234"},
+			Annotations: map[string]string{"owner": "virt-team"},
+		}
+		req = commontestutils.NewReq(hco)
+	})
+
+	Context("test a CR operand", func() {
+		It("should create the CR with the custom metadata", func() {
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := (*genericOperand)(newCdiHandler(cl, commontestutils.GetScheme()))
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+
+			foundCDI := &cdiv1beta1.CDI{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Name: res.Name}, foundCDI)).To(Succeed())
+			Expect(foundCDI.Labels).To(HaveKeyWithValue("cost-center", "1234"))
+			Expect(foundCDI.Labels).To(HaveKeyWithValue(hcoutil.AppLabel, commontestutils.Name))
+			Expect(foundCDI.Annotations).To(HaveKeyWithValue("owner", "virt-team"))
+
+			By("not updating the CR in the next reconciliation")
+			req = commontestutils.NewReq(hco)
+			res = handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeFalse())
+		})
+
+		It("should add the custom metadata to an existing CR", func() {
+			cdi, err := NewCDI(hco)
+			Expect(err).ToNot(HaveOccurred())
+
+			cl := commontestutils.InitClient([]client.Object{hco, cdi})
+			handler := (*genericOperand)(newCdiHandler(cl, commontestutils.GetScheme()))
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+			Expect(res.Overwritten).To(BeFalse())
+
+			foundCDI := &cdiv1beta1.CDI{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Name: res.Name}, foundCDI)).To(Succeed())
+			Expect(foundCDI.Labels).To(HaveKeyWithValue("cost-center", "1234"))
+			Expect(foundCDI.Annotations).To(HaveKeyWithValue("owner", "virt-team"))
+		})
+
+		It("should not override the labels that HCO sets", func() {
+			hco.Spec.Metadata.Labels[hcoutil.AppLabelComponent] = "custom"
+
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := (*genericOperand)(newCdiHandler(cl, commontestutils.GetScheme()))
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+
+			foundCDI := &cdiv1beta1.CDI{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Name: res.Name}, foundCDI)).To(Succeed())
+			Expect(foundCDI.Labels).To(HaveKeyWithValue(hcoutil.AppLabelComponent, string(hcoutil.AppComponentStorage)))
+			Expect(foundCDI.Labels).To(HaveKeyWithValue("cost-center", "1234"))
+		})
+	})
+
+	Context("test an operand with a static required object", func() {
+		It("should reconcile a removed custom label", func() {
+			required := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-cm",
+					Namespace: commontestutils.Namespace,
+					Labels:    getLabels(hco, hcoutil.AppComponentCompute),
+				},
+				Data: map[string]string{"key": "value"},
+			}
+
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := newCmHandler(cl, commontestutils.GetScheme(), required)
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+			Expect(required.Labels).ToNot(HaveKey("cost-center"))
+
+			foundCM := &corev1.ConfigMap{}
+			Expect(cl.Get(context.Background(), client.ObjectKeyFromObject(required), foundCM)).To(Succeed())
+			Expect(foundCM.Labels).To(HaveKeyWithValue("cost-center", "1234"))
+
+			By("removing the custom label from the ConfigMap")
+			delete(foundCM.Labels, "cost-center")
+			Expect(cl.Update(context.Background(), foundCM)).To(Succeed())
+
+			req = commontestutils.NewReq(hco)
+			req.HCOTriggered = false
+			res = handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+			Expect(res.Overwritten).To(BeTrue())
+
+			Expect(cl.Get(context.Background(), client.ObjectKeyFromObject(required), foundCM)).To(Succeed())
+			Expect(foundCM.Labels).To(HaveKeyWithValue("cost-center", "1234"))
+			Expect(foundCM.Annotations).To(HaveKeyWithValue("owner", "virt-team"))
+			Expect(foundCM.Data).To(HaveKeyWithValue("key", "value"))
+		})
+	})
+})
@@ -20,8 +20,22 @@ import (
 const (
 	dashboardManifestLocationVarName = "DASHBOARD_FILES_LOCATION"
 	dashboardManifestLocationDefault = "./dashboard"
+
+	// dashboardNamespaceVarName is the namespace to deploy the dashboards into, instead of the namespace from the
+	// dashboard file. On non-OpenShift clusters, the dashboards are only deployed if it is set.
+	dashboardNamespaceVarName = "GRAFANA_DASHBOARD_NAMESPACE"
+	// grafanaDashboardLabel is the label the Grafana dashboard sidecar looks for
+	grafanaDashboardLabel = "grafana_dashboard"
 )
 
+func getDashboardNamespace() string {
+	return os.Getenv(dashboardNamespaceVarName)
+}
+
+func shouldDeployDashboards(ci util.ClusterInfo) bool {
+	return ci.IsOpenshift() || getDashboardNamespace() != ""
+}
+
 func getDashboardHandlers(logger log.Logger, Client client.Client, Scheme *runtime.Scheme, hc *hcov1beta1.HyperConverged) ([]Operand, error) {
 	filesLocation := util.GetManifestDirPath(dashboardManifestLocationVarName, dashboardManifestLocationDefault)
 
@@ -68,9 +82,16 @@ func processDashboardConfigMapFile(path string, info os.FileInfo, logger log.Log
 		if err != nil {
 			logger.Error(err, "Can't generate a Configmap object from yaml file", "file name", path)
 		} else {
+			if cm.Labels == nil {
+				cm.Labels = make(map[string]string)
+			}
 			for k, v := range getLabels(hc, util.AppComponentCompute) {
 				cm.Labels[k] = v
 			}
+			if namespace := getDashboardNamespace(); namespace != "" {
+				cm.Namespace = namespace
+				cm.Labels[grafanaDashboardLabel] = "1"
+			}
 			return newCmHandler(Client, Scheme, cm), nil
 		}
 	}
@@ -16,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
 var _ = Describe("Dashboard tests", func() {
@@ -166,4 +167,63 @@ var _ = Describe("Dashboard tests", func() {
 			})
 		})
 	})
+
+	Context("test the dashboard namespace", func() {
+		AfterEach(func() {
+			_ = os.Unsetenv(dashboardNamespaceVarName)
+		})
+
+		It("should use the namespace from the dashboard file, if the namespace is not set", func() {
+			_ = os.Setenv(dashboardManifestLocationVarName, testFilesLocation)
+
+			cli := commontestutils.InitClient([]client.Object{})
+			handlers, err := getDashboardHandlers(logger, cli, schemeForTest, hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handlers).To(HaveLen(1))
+
+			cm := handlers[0].(*genericOperand).hooks.(*cmHooks).required
+			Expect(cm.Namespace).To(Equal("openshift-config-managed"))
+			Expect(cm.Labels).ToNot(HaveKey(grafanaDashboardLabel))
+		})
+
+		It("should deploy the dashboards into the namespace, if set", func() {
+			_ = os.Setenv(dashboardManifestLocationVarName, testFilesLocation)
+			_ = os.Setenv(dashboardNamespaceVarName, "grafana")
+
+			cli := commontestutils.InitClient([]client.Object{})
+			handlers, err := getDashboardHandlers(logger, cli, schemeForTest, hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handlers).To(HaveLen(1))
+
+			req := commontestutils.NewReq(commontestutils.NewHco())
+			res := handlers[0].ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+
+			cm := &corev1.ConfigMap{}
+			Expect(cli.Get(context.TODO(), client.ObjectKey{Namespace: "grafana", Name: "grafana-dashboard-kubevirt-top-consumers"}, cm)).To(Succeed())
+			Expect(cm.Labels).To(HaveKeyWithValue(grafanaDashboardLabel, "1"))
+			Expect(cm.Labels).To(HaveKeyWithValue(hcoutil.AppLabel, hco.Name))
+		})
+
+		It("should deploy the dashboards on OpenShift", func() {
+			Expect(shouldDeployDashboards(commontestutils.ClusterInfoMock{})).To(BeTrue())
+		})
+
+		It("should deploy the dashboards on Kubernetes only if the namespace is set", func() {
+			ci := kubernetesClusterInfo{}
+			Expect(shouldDeployDashboards(ci)).To(BeFalse())
+
+			_ = os.Setenv(dashboardNamespaceVarName, "grafana")
+			Expect(shouldDeployDashboards(ci)).To(BeTrue())
+		})
+	})
 })
+
+type kubernetesClusterInfo struct {
+	commontestutils.ClusterInfoMock
+}
+
+func (kubernetesClusterInfo) IsOpenshift() bool {
+	return false
+}
@@ -0,0 +1,119 @@
+package operands
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+// getCommonDictBase returns the version of the common template, that the customization of the modified common template
+// is based on, as kept in the HyperConverged status. It returns nil if the template was not customized before, or if it
+// was customized by a previous version of HCO, that did not keep the base.
+func getCommonDictBase(hc *hcov1beta1.HyperConverged, name string) *cdiv1beta1.DataImportCronSpec {
+	for _, dictStatus := range hc.Status.DataImportCronTemplates {
+		if dictStatus.Name == name && dictStatus.Status.CommonTemplate && dictStatus.Status.Modified {
+			return dictStatus.Status.CommonTemplateSpec
+		}
+	}
+	return nil
+}
+
+// mergeCommonDict does a three-way merge of a modified common template. The customization is the difference between
+// the template in the HyperConverged CR and the base version of the common template it was done on. The customization
+// is applied on the current version of the common template, so the changes that HCO ships in the common template are
+// not lost. The customized fields that were also modified in the current version of the common template keep their
+// customized values, and are returned as conflicts.
+func mergeCommonDict(base, current, customized *cdiv1beta1.DataImportCronSpec) (*cdiv1beta1.DataImportCronSpec, []string, error) {
+	if reflect.DeepEqual(base, current) {
+		return customized.DeepCopy(), nil, nil
+	}
+
+	baseJSON, err := json.Marshal(base)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	currentJSON, err := json.Marshal(current)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	customizedJSON, err := json.Marshal(customized)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	customization, err := jsonpatch.CreateMergePatch(baseJSON, customizedJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	upstream, err := jsonpatch.CreateMergePatch(baseJSON, currentJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conflicts, err := getMergePatchConflicts(customization, upstream)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mergedJSON, err := jsonpatch.MergePatch(currentJSON, customization)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	merged := &cdiv1beta1.DataImportCronSpec{}
+	if err = json.Unmarshal(mergedJSON, merged); err != nil {
+		return nil, nil, err
+	}
+
+	return merged, conflicts, nil
+}
+
+func getMergePatchConflicts(customization, upstream []byte) ([]string, error) {
+	customizationMap := make(map[string]any)
+	if err := json.Unmarshal(customization, &customizationMap); err != nil {
+		return nil, err
+	}
+
+	upstreamMap := make(map[string]any)
+	if err := json.Unmarshal(upstream, &upstreamMap); err != nil {
+		return nil, err
+	}
+
+	conflicts := findMergePatchConflicts("spec", customizationMap, upstreamMap)
+	sort.Strings(conflicts)
+
+	return conflicts, nil
+}
+
+// findMergePatchConflicts returns the paths of the fields that are modified differently by the two merge patches
+func findMergePatchConflicts(path string, patch1, patch2 map[string]any) []string {
+	var conflicts []string
+
+	for key, value1 := range patch1 {
+		value2, found := patch2[key]
+		if !found {
+			continue
+		}
+
+		fieldPath := path + "." + key
+
+		map1, isMap1 := value1.(map[string]any)
+		map2, isMap2 := value2.(map[string]any)
+		if isMap1 && isMap2 {
+			conflicts = append(conflicts, findMergePatchConflicts(fieldPath, map1, map2)...)
+		} else if !reflect.DeepEqual(value1, value2) {
+			conflicts = append(conflicts, fieldPath)
+		}
+	}
+
+	return conflicts
+}
@@ -0,0 +1,137 @@
+package operands
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+// driftReporter is implemented by operands that can compare the live object with the object that HCO would render,
+// without modifying the live object.
+type driftReporter interface {
+	reportDrift(req *common.HcoRequest) (*hcov1beta1.OperandDrift, error)
+}
+
+// ReportDrift checks, for each supported operand, if the live object matches the object that HCO would render from
+// the HyperConverged CR. ReportDrift never modifies the operands.
+func (h *OperandHandler) ReportDrift(req *common.HcoRequest) ([]hcov1beta1.OperandDrift, error) {
+	var report []hcov1beta1.OperandDrift
+	for _, handler := range h.operands {
+		reporter, ok := handler.(driftReporter)
+		if !ok {
+			continue
+		}
+
+		drift, err := reporter.reportDrift(req)
+		if err != nil {
+			return nil, err
+		}
+		report = append(report, *drift)
+	}
+
+	return report, nil
+}
+
+func (h *genericOperand) reportDrift(req *common.HcoRequest) (*hcov1beta1.OperandDrift, error) {
+	required, err := h.hooks.getFullCr(req.Instance)
+	if err != nil {
+		return nil, err
+	}
+
+	drift := &hcov1beta1.OperandDrift{
+		Kind:      h.getKind(required),
+		Name:      required.GetName(),
+		Namespace: required.GetNamespace(),
+	}
+
+	found := h.hooks.getEmptyCr()
+	if err = h.Client.Get(req.Ctx, client.ObjectKeyFromObject(required), found); err != nil {
+		if apierrors.IsNotFound(err) {
+			drift.Missing = true
+			return drift, nil
+		}
+		return nil, err
+	}
+
+	drift.DiffPaths, err = getDiffPaths(required, found)
+	if err != nil {
+		return nil, fmt.Errorf("can't compare the %s %s; %w", drift.Kind, drift.Name, err)
+	}
+	drift.InSync = len(drift.DiffPaths) == 0
+
+	return drift, nil
+}
+
+func (h *genericOperand) getKind(obj client.Object) string {
+	if gvk, err := apiutil.GVKForObject(obj, h.Scheme); err == nil {
+		return gvk.Kind
+	}
+	return h.crType
+}
+
+// getDiffPaths returns the paths of the fields in the found object, that are different from the required object. The
+// object metadata and status are ignored, except for the labels that HCO sets.
+func getDiffPaths(required, found client.Object) ([]string, error) {
+	requiredMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(required)
+	if err != nil {
+		return nil, err
+	}
+
+	foundMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(found)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range []string{"apiVersion", "kind", "metadata", "status"} {
+		delete(requiredMap, field)
+		delete(foundMap, field)
+	}
+
+	paths := diffMaps("", requiredMap, foundMap)
+
+	foundLabels := found.GetLabels()
+	for key, value := range required.GetLabels() {
+		if foundValue, ok := foundLabels[key]; !ok || foundValue != value {
+			paths = append(paths, "metadata.labels."+key)
+		}
+	}
+
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func diffMaps(prefix string, required, found map[string]interface{}) []string {
+	var paths []string
+	for key, requiredValue := range required {
+		path := prefix + key
+		foundValue, ok := found[key]
+		if !ok {
+			paths = append(paths, path)
+			continue
+		}
+
+		requiredChild, requiredIsMap := requiredValue.(map[string]interface{})
+		foundChild, foundIsMap := foundValue.(map[string]interface{})
+		if requiredIsMap && foundIsMap {
+			paths = append(paths, diffMaps(path+".", requiredChild, foundChild)...)
+		} else if !reflect.DeepEqual(requiredValue, foundValue) {
+			paths = append(paths, path)
+		}
+	}
+
+	for key := range found {
+		if _, ok := required[key]; !ok {
+			paths = append(paths, prefix+key)
+		}
+	}
+
+	return paths
+}
@@ -0,0 +1,112 @@
+package operands
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Configuration drift report", func() {
+	var (
+		hco *v1beta1.HyperConverged
+		req *common.HcoRequest
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		req = commontestutils.NewReq(hco)
+	})
+
+	Context("test genericOperand reportDrift", func() {
+		It("should report a missing operand", func() {
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := (*genericOperand)(newKubevirtHandler(cl, commontestutils.GetScheme()))
+
+			drift, err := handler.reportDrift(req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(drift.Kind).To(Equal("KubeVirt"))
+			Expect(drift.Name).To(Equal("kubevirt-" + hco.Name))
+			Expect(drift.Namespace).To(Equal(hco.Namespace))
+			Expect(drift.Missing).To(BeTrue())
+			Expect(drift.InSync).To(BeFalse())
+		})
+
+		It("should report an operand that matches the required object as in sync", func() {
+			kv, err := NewKubeVirt(hco)
+			Expect(err).ToNot(HaveOccurred())
+
+			cl := commontestutils.InitClient([]client.Object{hco, kv})
+			handler := (*genericOperand)(newKubevirtHandler(cl, commontestutils.GetScheme()))
+
+			drift, err := handler.reportDrift(req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(drift.Missing).To(BeFalse())
+			Expect(drift.InSync).To(BeTrue())
+			Expect(drift.DiffPaths).To(BeEmpty())
+		})
+
+		It("should report the diff paths without modifying the operand", func() {
+			kv, err := NewKubeVirt(hco)
+			Expect(err).ToNot(HaveOccurred())
+			kv.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{"fakeFg"}
+			kv.Spec.Configuration.MachineType = "fake-machine-type"
+			delete(kv.Labels, hcoutil.AppLabelComponent)
+
+			cl := commontestutils.InitClient([]client.Object{hco, kv})
+			handler := (*genericOperand)(newKubevirtHandler(cl, commontestutils.GetScheme()))
+
+			drift, err := handler.reportDrift(req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(drift.InSync).To(BeFalse())
+			Expect(drift.DiffPaths).To(Equal([]string{
+				"metadata.labels." + hcoutil.AppLabelComponent,
+				"spec.configuration.developerConfiguration.featureGates",
+				"spec.configuration.machineType",
+			}))
+
+			foundKV := &kubevirtcorev1.KubeVirt{}
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(kv), foundKV)).To(Succeed())
+			Expect(foundKV.Spec.Configuration.DeveloperConfiguration.FeatureGates).To(Equal([]string{"fakeFg"}))
+			Expect(foundKV.Spec.Configuration.MachineType).To(Equal("fake-machine-type"))
+		})
+	})
+
+	Context("test diffMaps", func() {
+		It("should report missing, modified and unexpected fields", func() {
+			required := map[string]interface{}{
+				"a": "value",
+				"b": map[string]interface{}{
+					"c": int64(1),
+					"d": []interface{}{"x", "y"},
+				},
+				"e": "missing",
+			}
+			found := map[string]interface{}{
+				"a": "value",
+				"b": map[string]interface{}{
+					"c": int64(2),
+					"d": []interface{}{"x", "y"},
+					"f": true,
+				},
+			}
+
+			Expect(diffMaps("spec.", required, found)).To(ConsistOf("spec.b.c", "spec.b.f", "spec.e"))
+		})
+
+		It("should return no paths for equal maps", func() {
+			m := map[string]interface{}{
+				"a": map[string]interface{}{"b": "c"},
+			}
+			Expect(diffMaps("", m, m)).To(BeEmpty())
+		})
+	})
+})
@@ -16,6 +16,9 @@ type EnsureResult struct {
 	Err         error
 	Type        string
 	Name        string
+	Namespace   string
+	// FieldManager is the field manager of the last modification of the resource, before HCO updated it
+	FieldManager string
 }
 
 func NewEnsureResult(resource runtime.Object) *EnsureResult {
@@ -54,6 +57,16 @@ func (r *EnsureResult) SetName(name string) *EnsureResult {
 	return r
 }
 
+func (r *EnsureResult) SetNamespace(namespace string) *EnsureResult {
+	r.Namespace = namespace
+	return r
+}
+
+func (r *EnsureResult) SetFieldManager(fieldManager string) *EnsureResult {
+	r.FieldManager = fieldManager
+	return r
+}
+
 func (r *EnsureResult) SetDeleted() *EnsureResult {
 	r.Deleted = true
 	return r
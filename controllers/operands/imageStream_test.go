@@ -131,7 +131,7 @@ var _ = Describe("imageStream tests", func() {
 			eventEmitter := commontestutils.NewEventEmitterMock()
 			ci := commontestutils.ClusterInfoMock{}
 			cli := commontestutils.InitClient([]client.Object{hcoNamespace, hco, ci.GetCSV()})
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
 
 			req := commontestutils.NewReq(hco)
@@ -193,7 +193,7 @@ var _ = Describe("imageStream tests", func() {
 			cli := commontestutils.InitClient([]client.Object{hcoNamespace, hco, ci.GetCSV()})
 
 			eventEmitter := commontestutils.NewEventEmitterMock()
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
 
 			req := commontestutils.NewReq(hco)
@@ -0,0 +1,92 @@
+package operands
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// isInfraPlacementHighlyAvailable returns true if the control-plane pods of the operands, like virt-api,
+// virt-controller and the template validator, can be spread over at least two nodes, so they should be deployed with
+// more than one replica.
+//
+// If the infra components are placed on the dedicated infra nodes, only the schedulable infra nodes are counted.
+// Otherwise, the infra components are scheduled on the worker nodes, or also on the infra nodes if their taint is
+// tolerated. Deploying more replicas than nodes colocates the pods on the same node, where the pod disruption budgets
+// of the operands block the node drain.
+func isInfraPlacementHighlyAvailable(hc *hcov1beta1.HyperConverged) bool {
+	ci := hcoutil.GetClusterInfo()
+	nodePlacement := getInfraConfig(hc).NodePlacement
+
+	if isPlacedOnInfraNodes(nodePlacement) {
+		return ci.GetInfraNodeCount() >= 2
+	}
+
+	if !ci.IsInfrastructureHighlyAvailable() {
+		return false
+	}
+
+	nodes := ci.GetWorkerNodeCount()
+	if toleratesInfraNodes(nodePlacement) {
+		nodes += ci.GetInfraNodeCount()
+	}
+
+	return nodes >= 2
+}
+
+// isPlacedOnInfraNodes returns true if the node placement selects the infra nodes, either by the node selector or by
+// the required node affinity
+func isPlacedOnInfraNodes(nodePlacement *sdkapi.NodePlacement) bool {
+	if nodePlacement == nil {
+		return false
+	}
+
+	if _, ok := nodePlacement.NodeSelector[hcoutil.NodeRoleInfraLabel]; ok {
+		return true
+	}
+
+	affinity := nodePlacement.Affinity
+	if affinity == nil || affinity.NodeAffinity == nil || affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return false
+	}
+
+	terms := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	if len(terms) == 0 {
+		return false
+	}
+
+	// the terms are ORed, so all of them must select the infra nodes
+	for _, term := range terms {
+		if !selectsInfraNodes(term) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func selectsInfraNodes(term corev1.NodeSelectorTerm) bool {
+	for _, req := range term.MatchExpressions {
+		if req.Key == hcoutil.NodeRoleInfraLabel && (req.Operator == corev1.NodeSelectorOpExists || req.Operator == corev1.NodeSelectorOpIn) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toleratesInfraNodes(nodePlacement *sdkapi.NodePlacement) bool {
+	if nodePlacement == nil {
+		return false
+	}
+
+	for _, toleration := range nodePlacement.Tolerations {
+		if toleration.ToleratesTaint(&corev1.Taint{Key: hcoutil.ManagedServiceInfraNodeTaintKey, Effect: corev1.TaintEffectNoSchedule}) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,138 @@
+package operands
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Infra replicas", func() {
+	var hco *hcov1beta1.HyperConverged
+
+	getClusterInfo := hcoutil.GetClusterInfo
+
+	setNodeCount := func(infraNodes, workerNodes int) {
+		hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+			return nodeCountClusterInfo{infraNodes: infraNodes, workerNodes: workerNodes}
+		}
+	}
+
+	infraNodeSelector := &sdkapi.NodePlacement{
+		NodeSelector: map[string]string{hcoutil.NodeRoleInfraLabel: ""},
+		Tolerations: []corev1.Toleration{
+			{Key: hcoutil.NodeRoleInfraLabel, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+		},
+	}
+
+	infraNodeAffinity := &sdkapi.NodePlacement{
+		Affinity: &corev1.Affinity{
+			NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{
+						{
+							MatchExpressions: []corev1.NodeSelectorRequirement{
+								{Key: hcoutil.NodeRoleInfraLabel, Operator: corev1.NodeSelectorOpExists},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+	})
+
+	AfterEach(func() {
+		hcoutil.GetClusterInfo = getClusterInfo
+	})
+
+	DescribeTable("should count the nodes that the infra components are placed on",
+		func(nodePlacement *sdkapi.NodePlacement, infraNodes, workerNodes int, expected bool) {
+			setNodeCount(infraNodes, workerNodes)
+			hco.Spec.Infra.NodePlacement = nodePlacement
+
+			Expect(isInfraPlacementHighlyAvailable(hco)).To(Equal(expected))
+		},
+		Entry("no node placement, multiple workers", nil, 0, 3, true),
+		Entry("no node placement, single schedulable worker with dedicated infra nodes", nil, 3, 1, false),
+		Entry("infra node selector, multiple infra nodes", infraNodeSelector, 2, 1, true),
+		Entry("infra node selector, single infra node", infraNodeSelector, 1, 3, false),
+		Entry("infra node affinity, multiple infra nodes", infraNodeAffinity, 3, 0, true),
+		Entry("infra node affinity, single infra node", infraNodeAffinity, 1, 3, false),
+		Entry("tolerating the infra nodes, single worker", &sdkapi.NodePlacement{Tolerations: infraNodeSelector.Tolerations}, 1, 1, true),
+	)
+
+	It("should not be highly available on a single node cluster", func() {
+		hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+			return commontestutils.ClusterInfoSNOMock{}
+		}
+
+		Expect(isInfraPlacementHighlyAvailable(hco)).To(BeFalse())
+	})
+
+	It("should count the infra nodes on managed services, as their taint is tolerated by default", func() {
+		hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+			return managedServiceNodeCountClusterInfo{nodeCountClusterInfo{infraNodes: 2, workerNodes: 1}}
+		}
+
+		Expect(isInfraPlacementHighlyAvailable(hco)).To(BeTrue())
+	})
+
+	It("should deploy a single replica of virt-api and virt-controller on a single infra node", func() {
+		setNodeCount(1, 3)
+		hco.Spec.Infra.NodePlacement = infraNodeSelector
+
+		kv, err := NewKubeVirt(hco)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kv.Spec.Infra.Replicas).To(HaveValue(Equal(uint8(1))))
+
+		ssp, _, err := NewSSP(hco)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ssp.Spec.TemplateValidator.Replicas).To(HaveValue(Equal(int32(1))))
+	})
+
+	It("should let the operands decide the replicas on multiple infra nodes", func() {
+		setNodeCount(2, 3)
+		hco.Spec.Infra.NodePlacement = infraNodeSelector
+
+		kv, err := NewKubeVirt(hco)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kv.Spec.Infra.Replicas).To(BeNil())
+
+		ssp, _, err := NewSSP(hco)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ssp.Spec.TemplateValidator.Replicas).To(HaveValue(Equal(int32(defaultTemplateValidatorReplicas))))
+	})
+})
+
+// nodeCountClusterInfo simulates a highly available cluster with the given number of schedulable nodes
+type nodeCountClusterInfo struct {
+	commontestutils.ClusterInfoMock
+	infraNodes  int
+	workerNodes int
+}
+
+func (c nodeCountClusterInfo) GetInfraNodeCount() int {
+	return c.infraNodes
+}
+
+func (c nodeCountClusterInfo) GetWorkerNodeCount() int {
+	return c.workerNodes
+}
+
+// managedServiceNodeCountClusterInfo simulates a managed OpenShift service cluster with the given number of schedulable
+// nodes
+type managedServiceNodeCountClusterInfo struct {
+	nodeCountClusterInfo
+}
+
+func (managedServiceNodeCountClusterInfo) GetManagedService() hcoutil.ManagedService {
+	return hcoutil.ManagedServiceROSA
+}
@@ -38,6 +38,9 @@ const (
 	DefaultAMD64EmulatedMachines = "q35*,pc-q35*"
 )
 
+// the seccomp profile that KubeVirt installs on the nodes
+const kvSeccompProfile = "kubevirt/kubevirt.json"
+
 var (
 	useKVMEmulation = false
 )
@@ -109,11 +112,59 @@ const (
 	kvVMPersistentState = "VMPersistentState"
 )
 
+const memoryOvercommitEventReason = "MemoryOvercommitApplied"
+
 const (
 	highBurstProfileBurst = 400
 	highBurstProfileQPS   = 200
+
+	// the upper limits of the custom tuning policy; five times the highBurst profile values
+	customTuningPolicyMaxBurst = 5 * highBurstProfileBurst
+	customTuningPolicyMaxQPS   = 5 * highBurstProfileQPS
 )
 
+type workloadDensitySettings struct {
+	cpuAllocationRatio int
+	memoryOvercommit   int
+	enableKSM          bool
+	evictionStrategy   kubevirtcorev1.EvictionStrategy
+}
+
+// the values of each workload density preset
+var workloadDensityPresets = map[hcov1beta1.HyperConvergedWorkloadDensityPreset]workloadDensitySettings{
+	hcov1beta1.HyperConvergedDensePreset: {
+		cpuAllocationRatio: 20,
+		memoryOvercommit:   150,
+		enableKSM:          true,
+		evictionStrategy:   kubevirtcorev1.EvictionStrategyLiveMigrate,
+	},
+	hcov1beta1.HyperConvergedBalancedPreset: {
+		cpuAllocationRatio: 10,
+		memoryOvercommit:   100,
+		evictionStrategy:   kubevirtcorev1.EvictionStrategyLiveMigrate,
+	},
+	hcov1beta1.HyperConvergedPerformancePreset: {
+		cpuAllocationRatio: 1,
+		memoryOvercommit:   100,
+		evictionStrategy:   kubevirtcorev1.EvictionStrategyLiveMigrateIfPossible,
+	},
+}
+
+// GetDefaultEvictionStrategy returns the eviction strategy to use if the evictionStrategy field of the HyperConverged
+// CR is not set. The VMs can't be migrated on a single node cluster, so the default there is None. Otherwise, the
+// default is the eviction strategy of the workload density preset, if set, or LiveMigrate.
+func GetDefaultEvictionStrategy(hc *hcov1beta1.HyperConverged) kubevirtcorev1.EvictionStrategy {
+	if !hcoutil.GetClusterInfo().IsInfrastructureHighlyAvailable() {
+		return kubevirtcorev1.EvictionStrategyNone
+	}
+
+	if preset, ok := workloadDensityPresets[hc.Spec.WorkloadDensityPreset]; ok {
+		return preset.evictionStrategy
+	}
+
+	return kubevirtcorev1.EvictionStrategyLiveMigrate
+}
+
 var (
 	hardCodeKvFgs = []string{
 		kvDataVolumesGate,
@@ -193,17 +244,22 @@ var (
 type kubevirtHandler genericOperand
 
 func newKubevirtHandler(Client client.Client, Scheme *runtime.Scheme) *kubevirtHandler {
+	return newKubevirtHandlerWithEventEmitter(Client, Scheme, nil)
+}
+
+func newKubevirtHandlerWithEventEmitter(Client client.Client, Scheme *runtime.Scheme, eventEmitter hcoutil.EventEmitter) *kubevirtHandler {
 	return &kubevirtHandler{
 		Client:                 Client,
 		Scheme:                 Scheme,
 		crType:                 "KubeVirt",
 		setControllerReference: true,
-		hooks:                  &kubevirtHooks{},
+		hooks:                  &kubevirtHooks{eventEmitter: eventEmitter},
 	}
 }
 
 type kubevirtHooks struct {
-	cache *kubevirtcorev1.KubeVirt
+	cache        *kubevirtcorev1.KubeVirt
+	eventEmitter hcoutil.EventEmitter
 }
 
 type rateLimits struct {
@@ -226,15 +282,17 @@ func (*kubevirtHooks) getEmptyCr() client.Object { return &kubevirtcorev1.KubeVi
 func (*kubevirtHooks) getConditions(cr runtime.Object) []metav1.Condition {
 	return translateKubeVirtConds(cr.(*kubevirtcorev1.KubeVirt).Status.Conditions)
 }
-func (*kubevirtHooks) checkComponentVersion(cr runtime.Object) bool {
-	found := cr.(*kubevirtcorev1.KubeVirt)
-	return checkComponentVersion(hcoutil.KubevirtVersionEnvV, found.Status.ObservedKubeVirtVersion)
+func (*kubevirtHooks) getObservedVersion(cr runtime.Object) string {
+	return cr.(*kubevirtcorev1.KubeVirt).Status.ObservedKubeVirtVersion
+}
+func (h *kubevirtHooks) checkComponentVersion(cr runtime.Object) bool {
+	return checkComponentVersion(hcoutil.KubevirtVersionEnvV, h.getObservedVersion(cr))
 }
 func (h *kubevirtHooks) reset() {
 	h.cache = nil
 }
 
-func (*kubevirtHooks) updateCr(req *common.HcoRequest, Client client.Client, exists runtime.Object, required runtime.Object) (bool, bool, error) {
+func (h *kubevirtHooks) updateCr(req *common.HcoRequest, Client client.Client, exists runtime.Object, required runtime.Object) (bool, bool, error) {
 	virt, ok1 := required.(*kubevirtcorev1.KubeVirt)
 	found, ok2 := exists.(*kubevirtcorev1.KubeVirt)
 	if !ok1 || !ok2 {
@@ -247,12 +305,16 @@ func (*kubevirtHooks) updateCr(req *common.HcoRequest, Client client.Client, exi
 		} else {
 			req.Logger.Info("Reconciling an externally updated KubeVirt's Spec to its opinionated values")
 		}
+		memoryOvercommitModified := getKvMemoryOvercommit(found) != getKvMemoryOvercommit(virt)
 		hcoutil.DeepCopyLabels(&virt.ObjectMeta, &found.ObjectMeta)
 		virt.Spec.DeepCopyInto(&found.Spec)
 		err := Client.Update(req.Ctx, found)
 		if err != nil {
 			return false, false, err
 		}
+		if memoryOvercommitModified {
+			h.emitMemoryOvercommitEvent(req, getKvMemoryOvercommit(virt))
+		}
 		return true, !req.HCOTriggered, nil
 	}
 	return false, false, nil
@@ -260,6 +322,27 @@ func (*kubevirtHooks) updateCr(req *common.HcoRequest, Client client.Client, exi
 
 func (*kubevirtHooks) justBeforeComplete(_ *common.HcoRequest) { /* no implementation */ }
 
+// emitMemoryOvercommitEvent notifies that a new memory overcommit was applied to KubeVirt. Only the VMs that are
+// started after the change are affected by it.
+func (h *kubevirtHooks) emitMemoryOvercommitEvent(req *common.HcoRequest, memoryOvercommit int) {
+	if h.eventEmitter == nil {
+		return
+	}
+
+	msg := "The KubeVirt memory overcommit was reset to the default"
+	if memoryOvercommit != 0 {
+		msg = fmt.Sprintf("The KubeVirt memory overcommit was set to %d%%", memoryOvercommit)
+	}
+	h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, memoryOvercommitEventReason, msg)
+}
+
+func getKvMemoryOvercommit(kv *kubevirtcorev1.KubeVirt) int {
+	if kv.Spec.Configuration.DeveloperConfiguration == nil {
+		return 0
+	}
+	return kv.Spec.Configuration.DeveloperConfiguration.MemoryOvercommit
+}
+
 func NewKubeVirt(hc *hcov1beta1.HyperConverged, opts ...string) (*kubevirtcorev1.KubeVirt, error) {
 	config, err := getKVConfig(hc)
 	if err != nil {
@@ -268,7 +351,7 @@ func NewKubeVirt(hc *hcov1beta1.HyperConverged, opts ...string) (*kubevirtcorev1
 
 	kvCertConfig := hcoCertConfig2KvCertificateRotateStrategy(hc.Spec.CertConfig)
 
-	infrastructureHighlyAvailable := hcoutil.GetClusterInfo().IsInfrastructureHighlyAvailable()
+	infrastructureHighlyAvailable := isInfraPlacementHighlyAvailable(hc)
 
 	uninstallStrategy := kubevirtcorev1.KubeVirtUninstallStrategyBlockUninstallIfWorkloadsExist
 	if hc.Spec.UninstallStrategy == hcov1beta1.HyperConvergedUninstallStrategyRemoveWorkloads {
@@ -277,7 +360,7 @@ func NewKubeVirt(hc *hcov1beta1.HyperConverged, opts ...string) (*kubevirtcorev1
 
 	spec := kubevirtcorev1.KubeVirtSpec{
 		UninstallStrategy:           uninstallStrategy,
-		Infra:                       hcoConfig2KvConfig(hc.Spec.Infra, infrastructureHighlyAvailable),
+		Infra:                       hcoConfig2KvConfig(getInfraConfig(hc).HyperConvergedConfig, infrastructureHighlyAvailable),
 		Workloads:                   hcoConfig2KvConfig(hc.Spec.Workloads, true),
 		Configuration:               *config,
 		CertificateRotationStrategy: *kvCertConfig,
@@ -288,6 +371,15 @@ func NewKubeVirt(hc *hcov1beta1.HyperConverged, opts ...string) (*kubevirtcorev1
 		ServiceMonitorNamespace:     getNamespace(hc.Namespace, opts),
 	}
 
+	windowOpen, _, err := common.GetMaintenanceWindowState(hc.Spec.MaintenanceWindow, common.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !windowOpen {
+		// defer the automatic workload updates to the maintenance window
+		spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = nil
+	}
+
 	kv := NewKubeVirtWithNameOnly(hc, opts...)
 	kv.Spec = spec
 
@@ -344,11 +436,42 @@ func getHcoHighBurstProfileTuningValues(hc *hcov1beta1.HyperConverged) (*kubevir
 	}, nil
 }
 
+func getHcoCustomTuningValues(hc *hcov1beta1.HyperConverged) (*kubevirtcorev1.ReloadableComponentConfiguration, error) {
+	rates := hc.Spec.TuningPolicyRates
+	if rates == nil {
+		return nil, fmt.Errorf("the custom tuning policy is set, but spec.tuningPolicyRates is missing")
+	}
+
+	if rates.QPS < 1 || rates.QPS > customTuningPolicyMaxQPS {
+		return nil, fmt.Errorf("spec.tuningPolicyRates.qps must be between 1 and %d", customTuningPolicyMaxQPS)
+	}
+	if rates.Burst < 1 || rates.Burst > customTuningPolicyMaxBurst {
+		return nil, fmt.Errorf("spec.tuningPolicyRates.burst must be between 1 and %d", customTuningPolicyMaxBurst)
+	}
+	if rates.Burst < rates.QPS {
+		return nil, fmt.Errorf("spec.tuningPolicyRates.burst must not be lower than spec.tuningPolicyRates.qps")
+	}
+
+	return &kubevirtcorev1.ReloadableComponentConfiguration{
+		RestClient: &kubevirtcorev1.RESTClientConfiguration{
+			RateLimiter: &kubevirtcorev1.RateLimiter{
+				TokenBucketRateLimiter: &kubevirtcorev1.TokenBucketRateLimiter{
+					QPS:   float32(rates.QPS),
+					Burst: int(rates.Burst),
+				},
+			},
+		},
+	}, nil
+}
+
 func hcoTuning2Kv(hc *hcov1beta1.HyperConverged) (*kubevirtcorev1.ReloadableComponentConfiguration, error) {
-	if hc.Spec.TuningPolicy == hcov1beta1.HyperConvergedAnnotationTuningPolicy {
+	switch hc.Spec.TuningPolicy {
+	case hcov1beta1.HyperConvergedAnnotationTuningPolicy:
 		return getHcoAnnotationTuning(hc)
-	} else if hc.Spec.TuningPolicy == hcov1beta1.HyperConvergedHighBurstProfile {
+	case hcov1beta1.HyperConvergedHighBurstProfile:
 		return getHcoHighBurstProfileTuningValues(hc)
+	case hcov1beta1.HyperConvergedCustomTuningPolicy:
+		return getHcoCustomTuningValues(hc)
 	}
 	return nil, nil
 }
@@ -395,7 +518,7 @@ func getKVConfig(hc *hcov1beta1.HyperConverged) (*kubevirtcorev1.KubeVirtConfigu
 		return nil, err
 	}
 
-	seccompConfig := getKVSeccompConfig()
+	seccompConfig := getKVSeccompConfig(hc.Spec.VMISeccompProfile)
 
 	config := &kubevirtcorev1.KubeVirtConfiguration{
 		DeveloperConfiguration: devConfig,
@@ -416,6 +539,17 @@ func getKVConfig(hc *hcov1beta1.HyperConverged) (*kubevirtcorev1.KubeVirtConfigu
 		EvictionStrategy:             hc.Spec.EvictionStrategy,
 	}
 
+	if preset, ok := workloadDensityPresets[hc.Spec.WorkloadDensityPreset]; ok {
+		if preset.enableKSM {
+			config.KSMConfiguration = &kubevirtcorev1.KSMConfiguration{NodeLabelSelector: &metav1.LabelSelector{}}
+		}
+
+		if config.EvictionStrategy == nil {
+			evictionStrategy := GetDefaultEvictionStrategy(hc)
+			config.EvictionStrategy = &evictionStrategy
+		}
+	}
+
 	if smbiosConfig, ok := os.LookupEnv(smbiosEnvName); ok {
 		if smbiosConfig = strings.TrimSpace(smbiosConfig); smbiosConfig != "" {
 			config.SMBIOSConfig = &kubevirtcorev1.SMBiosConfiguration{}
@@ -481,6 +615,13 @@ func getObsoleteCPUConfig(hcObsoleteCPUConf *hcov1beta1.HyperConvergedObsoleteCP
 	return obsoleteCPUModels, minCPUModel
 }
 
+// IsObsoleteCPUModel returns true if the CPU model is obsolete; i.e. it is either in the hard-coded list of the
+// obsolete CPU models, or in the list of the HyperConverged CR
+func IsObsoleteCPUModel(hc *hcov1beta1.HyperConverged, cpuModel string) bool {
+	obsoleteCPUModels, _ := getObsoleteCPUConfig(hc.Spec.ObsoleteCPUs)
+	return obsoleteCPUModels[cpuModel]
+}
+
 func toKvMediatedDevicesConfiguration(mdevsConfig *hcov1beta1.MediatedDevicesConfiguration) *kubevirtcorev1.MediatedDevicesConfiguration {
 	if mdevsConfig == nil {
 		return nil
@@ -649,21 +790,41 @@ func getKVDevConfig(hc *hcov1beta1.HyperConverged) (*kubevirtcorev1.DeveloperCon
 	if lv := hc.Spec.LogVerbosityConfig; lv != nil && lv.Kubevirt != nil {
 		devConf.LogVerbosity = lv.Kubevirt.DeepCopy()
 	}
+	if preset, ok := workloadDensityPresets[hc.Spec.WorkloadDensityPreset]; ok {
+		devConf.CPUAllocationRatio = preset.cpuAllocationRatio
+		devConf.MemoryOvercommit = preset.memoryOvercommit
+	}
 	if hc.Spec.ResourceRequirements != nil && hc.Spec.ResourceRequirements.VmiCPUAllocationRatio != nil {
 		devConf.CPUAllocationRatio = *hc.Spec.ResourceRequirements.VmiCPUAllocationRatio
 	}
+	if hwd := hc.Spec.HigherWorkloadDensity; hwd != nil && hwd.MemoryOvercommitPercentage != nil {
+		devConf.MemoryOvercommit = *hwd.MemoryOvercommitPercentage
+	}
 
 	return devConf, nil
 }
 
-// Static for now, could be configured in the HCO CR in the future
-func getKVSeccompConfig() *kubevirtcorev1.SeccompConfiguration {
-	kubevirtProfile := "kubevirt/kubevirt.json"
+// getKVSeccompConfig returns the seccomp configuration of the virt-launcher pods. If the profile is not set in the
+// HyperConverged CR, the KubeVirt seccomp profile is used.
+func getKVSeccompConfig(profile *corev1.SeccompProfile) *kubevirtcorev1.SeccompConfiguration {
+	customProfile := &kubevirtcorev1.CustomProfile{}
+
+	switch {
+	case profile == nil:
+		localhostProfile := kvSeccompProfile
+		customProfile.LocalhostProfile = &localhostProfile
+	case profile.Type == corev1.SeccompProfileTypeRuntimeDefault:
+		customProfile.RuntimeDefaultProfile = true
+	case profile.Type == corev1.SeccompProfileTypeLocalhost && profile.LocalhostProfile != nil:
+		localhostProfile := *profile.LocalhostProfile
+		customProfile.LocalhostProfile = &localhostProfile
+	default: // Unconfined
+		return nil
+	}
+
 	return &kubevirtcorev1.SeccompConfiguration{
 		VirtualMachineInstanceProfile: &kubevirtcorev1.VirtualMachineInstanceProfile{
-			CustomProfile: &kubevirtcorev1.CustomProfile{
-				LocalhostProfile: &kubevirtProfile,
-			},
+			CustomProfile: customProfile,
 		},
 	}
 }
@@ -679,12 +840,15 @@ func NewKubeVirtWithNameOnly(hc *hcov1beta1.HyperConverged, opts ...string) *kub
 }
 
 func hcoConfig2KvConfig(hcoConfig hcov1beta1.HyperConvergedConfig, infrastructureHighlyAvailable bool) *kubevirtcorev1.ComponentConfig {
-	if hcoConfig.NodePlacement == nil && infrastructureHighlyAvailable {
+	if hcoConfig.NodePlacement == nil && hcoConfig.Replicas == nil && infrastructureHighlyAvailable {
 		return nil
 	}
 
 	kvConfig := &kubevirtcorev1.ComponentConfig{}
-	if !infrastructureHighlyAvailable {
+	if hcoConfig.Replicas != nil {
+		replicas := *hcoConfig.Replicas
+		kvConfig.Replicas = &replicas
+	} else if !infrastructureHighlyAvailable {
 		var singleReplica uint8 = 1
 		kvConfig.Replicas = &singleReplica
 	}
@@ -173,26 +173,53 @@ func getKvUIDeployment(hc *hcov1beta1.HyperConverged, deploymentName string, ima
 		},
 	}
 
-	if hc.Spec.Infra.NodePlacement != nil {
-		if hc.Spec.Infra.NodePlacement.NodeSelector != nil {
+	infra := getInfraConfig(hc)
+	if infra.NodePlacement != nil {
+		if infra.NodePlacement.NodeSelector != nil {
 			deployment.Spec.Template.Spec.NodeSelector = make(map[string]string)
-			for key, value := range hc.Spec.Infra.NodePlacement.NodeSelector {
+			for key, value := range infra.NodePlacement.NodeSelector {
 				deployment.Spec.Template.Spec.NodeSelector[key] = value
 			}
 		}
 
-		if hc.Spec.Infra.NodePlacement.Affinity != nil {
-			deployment.Spec.Template.Spec.Affinity = hc.Spec.Infra.NodePlacement.Affinity.DeepCopy()
+		if infra.NodePlacement.Affinity != nil {
+			deployment.Spec.Template.Spec.Affinity = infra.NodePlacement.Affinity.DeepCopy()
 		}
 
-		if hc.Spec.Infra.NodePlacement.Tolerations != nil {
-			deployment.Spec.Template.Spec.Tolerations = make([]corev1.Toleration, len(hc.Spec.Infra.NodePlacement.Tolerations))
-			copy(deployment.Spec.Template.Spec.Tolerations, hc.Spec.Infra.NodePlacement.Tolerations)
+		if infra.NodePlacement.Tolerations != nil {
+			deployment.Spec.Template.Spec.Tolerations = make([]corev1.Toleration, len(infra.NodePlacement.Tolerations))
+			copy(deployment.Spec.Template.Spec.Tolerations, infra.NodePlacement.Tolerations)
 		}
 	}
+
+	deployment.Spec.Template.Spec.TopologySpreadConstraints = getTopologySpreadConstraints(infra, labels)
+
 	return deployment
 }
 
+// getTopologySpreadConstraints returns the topology spread constraints of the HyperConverged infra configuration, for
+// the pods with the given labels. Constraints without a label selector are set to select these pods, as a constraint
+// without a label selector does not spread anything.
+func getTopologySpreadConstraints(hcoConfig hcov1beta1.HyperConvergedInfraConfig, podLabels map[string]string) []corev1.TopologySpreadConstraint {
+	if len(hcoConfig.TopologySpreadConstraints) == 0 {
+		return nil
+	}
+
+	constraints := make([]corev1.TopologySpreadConstraint, len(hcoConfig.TopologySpreadConstraints))
+	for i, constraint := range hcoConfig.TopologySpreadConstraints {
+		constraint.DeepCopyInto(&constraints[i])
+		if constraints[i].LabelSelector == nil {
+			matchLabels := make(map[string]string, len(podLabels))
+			for key, value := range podLabels {
+				matchLabels[key] = value
+			}
+			constraints[i].LabelSelector = &metav1.LabelSelector{MatchLabels: matchLabels}
+		}
+	}
+
+	return constraints
+}
+
 func NewKvUIPluginSvc(hc *hcov1beta1.HyperConverged) *corev1.Service {
 	servicePorts := []corev1.ServicePort{
 		{
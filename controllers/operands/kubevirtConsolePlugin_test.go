@@ -484,7 +484,7 @@ var _ = Describe("Kubevirt Console Plugin", func() {
 				deploymentManifestor func(*hcov1beta1.HyperConverged) *appsv1.Deployment, handlerFunc GetHandler) {
 
 				hco.Spec.Workloads = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
-				hco.Spec.Infra = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewOtherNodePlacement()}
+				hco.Spec.Infra = hcov1beta1.HyperConvergedInfraConfig{HyperConvergedConfig: hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewOtherNodePlacement()}}
 				existingResource := deploymentManifestor(hco)
 
 				// mock a reconciliation triggered by a change in the deployment
@@ -622,6 +622,89 @@ var _ = Describe("Kubevirt Console Plugin", func() {
 				Entry("proxy deployment", hcoutil.AppComponentUIProxy, NewKvUIProxyDeployment, newKvUIProxyDeploymentHandler),
 			)
 		})
+
+		Context("Topology Spread Constraints", func() {
+			DescribeTable("apply the topology spread constraints if missing", func(appComponent hcoutil.AppComponent,
+				deploymentManifestor func(converged *hcov1beta1.HyperConverged) *appsv1.Deployment, handlerFunc GetHandler) {
+				existingResource := deploymentManifestor(hco)
+
+				customSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"custom": "label"}}
+				hco.Spec.Infra.TopologySpreadConstraints = []v1.TopologySpreadConstraint{
+					{
+						MaxSkew:           1,
+						TopologyKey:       "topology.kubernetes.io/zone",
+						WhenUnsatisfiable: v1.ScheduleAnyway,
+					},
+					{
+						MaxSkew:           2,
+						TopologyKey:       "kubernetes.io/hostname",
+						WhenUnsatisfiable: v1.DoNotSchedule,
+						LabelSelector:     customSelector,
+					},
+				}
+
+				cl := commontestutils.InitClient([]client.Object{hco, existingResource})
+				handlers, err := handlerFunc(logger, cl, commontestutils.GetScheme(), hco)
+
+				Expect(err).ToNot(HaveOccurred())
+				res := handlers[0].ensure(req)
+				Expect(res.Created).To(BeFalse())
+				Expect(res.Updated).To(BeTrue())
+				Expect(res.Err).ToNot(HaveOccurred())
+
+				foundResource := &appsv1.Deployment{}
+				Expect(
+					cl.Get(context.TODO(),
+						types.NamespacedName{Name: existingResource.Name, Namespace: existingResource.Namespace},
+						foundResource),
+				).To(Succeed())
+
+				Expect(existingResource.Spec.Template.Spec.TopologySpreadConstraints).To(BeEmpty())
+
+				constraints := foundResource.Spec.Template.Spec.TopologySpreadConstraints
+				Expect(constraints).To(HaveLen(2))
+				Expect(constraints[0].TopologyKey).To(Equal("topology.kubernetes.io/zone"))
+				Expect(constraints[0].LabelSelector).ToNot(BeNil())
+				Expect(constraints[0].LabelSelector.MatchLabels).To(Equal(foundResource.Spec.Template.Labels))
+				Expect(constraints[0].LabelSelector.MatchLabels).To(HaveKeyWithValue(hcoutil.AppLabelComponent, string(appComponent)))
+				Expect(constraints[1].LabelSelector).To(Equal(customSelector))
+
+				By("not modifying the HyperConverged constraints")
+				Expect(hco.Spec.Infra.TopologySpreadConstraints[0].LabelSelector).To(BeNil())
+			},
+				Entry("plugin deployment", hcoutil.AppComponentUIPlugin, NewKvUIPluginDeployment, newKvUIPluginDeploymentHandler),
+				Entry("proxy deployment", hcoutil.AppComponentUIProxy, NewKvUIProxyDeployment, newKvUIProxyDeploymentHandler),
+			)
+
+			DescribeTable("remove the topology spread constraints if removed from the HyperConverged CR", func(appComponent hcoutil.AppComponent,
+				deploymentManifestor func(converged *hcov1beta1.HyperConverged) *appsv1.Deployment, handlerFunc GetHandler) {
+				hcoWithConstraints := commontestutils.NewHco()
+				hcoWithConstraints.Spec.Infra.TopologySpreadConstraints = []v1.TopologySpreadConstraint{
+					{MaxSkew: 1, TopologyKey: "topology.kubernetes.io/zone", WhenUnsatisfiable: v1.ScheduleAnyway},
+				}
+				existingResource := deploymentManifestor(hcoWithConstraints)
+				Expect(existingResource.Spec.Template.Spec.TopologySpreadConstraints).To(HaveLen(1))
+
+				cl := commontestutils.InitClient([]client.Object{hco, existingResource})
+				handlers, err := handlerFunc(logger, cl, commontestutils.GetScheme(), hco)
+
+				Expect(err).ToNot(HaveOccurred())
+				res := handlers[0].ensure(req)
+				Expect(res.Updated).To(BeTrue())
+				Expect(res.Err).ToNot(HaveOccurred())
+
+				foundResource := &appsv1.Deployment{}
+				Expect(
+					cl.Get(context.TODO(),
+						types.NamespacedName{Name: existingResource.Name, Namespace: existingResource.Namespace},
+						foundResource),
+				).To(Succeed())
+				Expect(foundResource.Spec.Template.Spec.TopologySpreadConstraints).To(BeEmpty())
+			},
+				Entry("plugin deployment", hcoutil.AppComponentUIPlugin, NewKvUIPluginDeployment, newKvUIPluginDeploymentHandler),
+				Entry("proxy deployment", hcoutil.AppComponentUIProxy, NewKvUIProxyDeployment, newKvUIProxyDeploymentHandler),
+			)
+		})
 	})
 
 	Context("Kubevirt Plugin and UI Proxy Service", func() {
@@ -1314,7 +1314,7 @@ Version: 1.2.3`)
 				existingResource, err := NewKubeVirt(hco)
 				Expect(err).ToNot(HaveOccurred())
 
-				hco.Spec.Infra = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
+				hco.Spec.Infra = hcov1beta1.HyperConvergedInfraConfig{HyperConvergedConfig: hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}}
 				hco.Spec.Workloads = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
 
 				cl := commontestutils.InitClient([]client.Object{hco, existingResource})
@@ -1350,7 +1350,7 @@ Version: 1.2.3`)
 
 			It("should remove node placement if missing in HCO CR", func() {
 				hcoNodePlacement := commontestutils.NewHco()
-				hcoNodePlacement.Spec.Infra = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
+				hcoNodePlacement.Spec.Infra = hcov1beta1.HyperConvergedInfraConfig{HyperConvergedConfig: hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}}
 				hcoNodePlacement.Spec.Workloads = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
 				existingResource, err := NewKubeVirt(hcoNodePlacement)
 				Expect(err).ToNot(HaveOccurred())
@@ -1380,7 +1380,7 @@ Version: 1.2.3`)
 			})
 
 			It("should modify node placement according to HCO CR", func() {
-				hco.Spec.Infra = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
+				hco.Spec.Infra = hcov1beta1.HyperConvergedInfraConfig{HyperConvergedConfig: hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}}
 				hco.Spec.Workloads = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
 				existingResource, err := NewKubeVirt(hco)
 				Expect(err).ToNot(HaveOccurred())
@@ -1427,7 +1427,7 @@ Version: 1.2.3`)
 			})
 
 			It("should overwrite node placement if directly set on KV CR", func() {
-				hco.Spec.Infra = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
+				hco.Spec.Infra = hcov1beta1.HyperConvergedInfraConfig{HyperConvergedConfig: hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}}
 				hco.Spec.Workloads = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
 				existingResource, err := NewKubeVirt(hco)
 				Expect(err).ToNot(HaveOccurred())
@@ -2537,7 +2537,7 @@ Version: 1.2.3`)
 			Context("Custom Infra placement, default Workloads placement", func() {
 
 				BeforeEach(func() {
-					hco.Spec.Infra = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
+					hco.Spec.Infra = hcov1beta1.HyperConvergedInfraConfig{HyperConvergedConfig: hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}}
 				})
 
 				It("should set replica=1 on SNO", func() {
@@ -2662,7 +2662,7 @@ Version: 1.2.3`)
 			Context("Custom Infra and Workloads placement", func() {
 
 				BeforeEach(func() {
-					hco.Spec.Infra = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
+					hco.Spec.Infra = hcov1beta1.HyperConvergedInfraConfig{HyperConvergedConfig: hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}}
 					hco.Spec.Workloads = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
 				})
 
@@ -2706,6 +2706,95 @@ Version: 1.2.3`)
 
 			})
 
+			Context("Custom Infra replicas", func() {
+
+				BeforeEach(func() {
+					hco.Spec.Infra = hcov1beta1.HyperConvergedInfraConfig{HyperConvergedConfig: hcov1beta1.HyperConvergedConfig{Replicas: ptr.To[uint8](3)}}
+				})
+
+				It("should set the requested replicas when not on SNO", func() {
+					hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+						return &commontestutils.ClusterInfoMock{}
+					}
+					kv, err := NewKubeVirt(hco)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(kv.Spec.Infra).To(Not(BeNil()))
+					Expect(kv.Spec.Infra.Replicas).To(HaveValue(Equal(uint8(3))))
+					Expect(kv.Spec.Infra.NodePlacement).To(BeNil())
+					Expect(kv.Spec.Workloads).To(BeNil())
+				})
+
+				It("should override the SNO default", func() {
+					hco.Spec.Infra.Replicas = ptr.To[uint8](2)
+					hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+						return &commontestutils.ClusterInfoSNOMock{}
+					}
+					kv, err := NewKubeVirt(hco)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(kv.Spec.Infra).To(Not(BeNil()))
+					Expect(kv.Spec.Infra.Replicas).To(HaveValue(Equal(uint8(2))))
+				})
+
+			})
+
+		})
+
+		Context("VMI seccomp profile", func() {
+			It("should use the KubeVirt seccomp profile by default", func() {
+				kv, err := NewKubeVirt(hco)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(kv.Spec.Configuration.SeccompConfiguration).ToNot(BeNil())
+				Expect(kv.Spec.Configuration.SeccompConfiguration.VirtualMachineInstanceProfile).ToNot(BeNil())
+				Expect(kv.Spec.Configuration.SeccompConfiguration.VirtualMachineInstanceProfile.CustomProfile).To(Equal(&kubevirtcorev1.CustomProfile{
+					LocalhostProfile: ptr.To("kubevirt/kubevirt.json"),
+				}))
+			})
+
+			DescribeTable("should set the seccomp profile from the HyperConverged CR",
+				func(profile *corev1.SeccompProfile, expected *kubevirtcorev1.SeccompConfiguration) {
+					hco.Spec.VMISeccompProfile = profile
+
+					kv, err := NewKubeVirt(hco)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(kv.Spec.Configuration.SeccompConfiguration).To(Equal(expected))
+				},
+				Entry("RuntimeDefault", &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault}, &kubevirtcorev1.SeccompConfiguration{
+					VirtualMachineInstanceProfile: &kubevirtcorev1.VirtualMachineInstanceProfile{
+						CustomProfile: &kubevirtcorev1.CustomProfile{RuntimeDefaultProfile: true},
+					},
+				}),
+				Entry("Localhost", &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeLocalhost, LocalhostProfile: ptr.To("custom/profile.json")}, &kubevirtcorev1.SeccompConfiguration{
+					VirtualMachineInstanceProfile: &kubevirtcorev1.VirtualMachineInstanceProfile{
+						CustomProfile: &kubevirtcorev1.CustomProfile{LocalhostProfile: ptr.To("custom/profile.json")},
+					},
+				}),
+				Entry("Unconfined", &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeUnconfined}, nil),
+			)
+
+			It("should reconcile the seccomp profile", func() {
+				existingResource, err := NewKubeVirt(hco)
+				Expect(err).ToNot(HaveOccurred())
+
+				hco.Spec.VMISeccompProfile = &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault}
+
+				cl := commontestutils.InitClient([]client.Object{hco, existingResource})
+				handler := (*genericOperand)(newKubevirtHandler(cl, commontestutils.GetScheme()))
+				res := handler.ensure(req)
+				Expect(res.Updated).To(BeTrue())
+				Expect(res.Err).ToNot(HaveOccurred())
+
+				foundResource := &kubevirtcorev1.KubeVirt{}
+				Expect(
+					cl.Get(context.TODO(),
+						types.NamespacedName{Name: existingResource.Name, Namespace: existingResource.Namespace},
+						foundResource),
+				).To(Succeed())
+
+				Expect(foundResource.Spec.Configuration.SeccompConfiguration.VirtualMachineInstanceProfile.CustomProfile).To(Equal(&kubevirtcorev1.CustomProfile{
+					RuntimeDefaultProfile: true,
+				}))
+			})
 		})
 
 		Context("Cluster level EvictionStrategy", func() {
@@ -2848,6 +2937,240 @@ Version: 1.2.3`)
 			})
 		})
 
+		Context("Maintenance window", func() {
+			origNow := common.Now
+
+			BeforeEach(func() {
+				common.Now = func() time.Time {
+					return time.Date(2023, time.October, 18, 12, 30, 0, 0, time.UTC)
+				}
+				hco.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []string{"LiveMigrate"}
+			})
+
+			AfterEach(func() {
+				common.Now = origNow
+			})
+
+			It("should keep the workload update methods while the maintenance window is open", func() {
+				hco.Spec.MaintenanceWindow = &hcov1beta1.MaintenanceWindow{
+					StartTime: "12:00",
+					Duration:  metav1.Duration{Duration: time.Hour},
+				}
+
+				kv, err := NewKubeVirt(hco)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods).To(ConsistOf(kubevirtcorev1.WorkloadUpdateMethodLiveMigrate))
+			})
+
+			It("should defer the workload updates while the maintenance window is closed", func() {
+				hco.Spec.MaintenanceWindow = &hcov1beta1.MaintenanceWindow{
+					StartTime: "14:00",
+					Duration:  metav1.Duration{Duration: time.Hour},
+				}
+
+				kv, err := NewKubeVirt(hco)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods).To(BeEmpty())
+			})
+
+			It("should fail for a wrong maintenance window", func() {
+				hco.Spec.MaintenanceWindow = &hcov1beta1.MaintenanceWindow{
+					StartTime: "14:00",
+				}
+
+				_, err := NewKubeVirt(hco)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("Workload density preset", func() {
+			getClusterInfo := hcoutil.GetClusterInfo
+
+			BeforeEach(func() {
+				hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+					return &commontestutils.ClusterInfoMock{}
+				}
+			})
+
+			AfterEach(func() {
+				hcoutil.GetClusterInfo = getClusterInfo
+			})
+
+			It("should not set overcommit or KSM by default", func() {
+				kv, err := NewKubeVirt(hco)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(kv.Spec.Configuration.DeveloperConfiguration.CPUAllocationRatio).To(BeZero())
+				Expect(kv.Spec.Configuration.DeveloperConfiguration.MemoryOvercommit).To(BeZero())
+				Expect(kv.Spec.Configuration.KSMConfiguration).To(BeNil())
+			})
+
+			DescribeTable("should set the preset values", func(preset hcov1beta1.HyperConvergedWorkloadDensityPreset, cpuRatio, memoryOvercommit int, ksm bool, evictionStrategy kubevirtcorev1.EvictionStrategy) {
+				hco.Spec.WorkloadDensityPreset = preset
+				hco.Spec.EvictionStrategy = nil
+
+				kv, err := NewKubeVirt(hco)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(kv.Spec.Configuration.DeveloperConfiguration.CPUAllocationRatio).To(Equal(cpuRatio))
+				Expect(kv.Spec.Configuration.DeveloperConfiguration.MemoryOvercommit).To(Equal(memoryOvercommit))
+				if ksm {
+					Expect(kv.Spec.Configuration.KSMConfiguration).ToNot(BeNil())
+					Expect(kv.Spec.Configuration.KSMConfiguration.NodeLabelSelector).ToNot(BeNil())
+				} else {
+					Expect(kv.Spec.Configuration.KSMConfiguration).To(BeNil())
+				}
+				Expect(kv.Spec.Configuration.EvictionStrategy).To(HaveValue(Equal(evictionStrategy)))
+			},
+				Entry("dense", hcov1beta1.HyperConvergedDensePreset, 20, 150, true, kubevirtcorev1.EvictionStrategyLiveMigrate),
+				Entry("balanced", hcov1beta1.HyperConvergedBalancedPreset, 10, 100, false, kubevirtcorev1.EvictionStrategyLiveMigrate),
+				Entry("performance", hcov1beta1.HyperConvergedPerformancePreset, 1, 100, false, kubevirtcorev1.EvictionStrategyLiveMigrateIfPossible),
+			)
+
+			It("should not use the preset eviction strategy on a single node cluster", func() {
+				hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+					return &commontestutils.ClusterInfoSNOMock{}
+				}
+
+				hco.Spec.WorkloadDensityPreset = hcov1beta1.HyperConvergedDensePreset
+				hco.Spec.EvictionStrategy = nil
+
+				kv, err := NewKubeVirt(hco)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(kv.Spec.Configuration.DeveloperConfiguration.CPUAllocationRatio).To(Equal(20))
+				Expect(kv.Spec.Configuration.EvictionStrategy).To(HaveValue(Equal(kubevirtcorev1.EvictionStrategyNone)))
+			})
+
+			It("should prefer explicitly set fields over the preset values", func() {
+				hco.Spec.WorkloadDensityPreset = hcov1beta1.HyperConvergedDensePreset
+				hco.Spec.EvictionStrategy = ptr.To(kubevirtcorev1.EvictionStrategyNone)
+				hco.Spec.ResourceRequirements = &hcov1beta1.OperandResourceRequirements{
+					VmiCPUAllocationRatio: ptr.To(30),
+				}
+
+				kv, err := NewKubeVirt(hco)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(kv.Spec.Configuration.DeveloperConfiguration.CPUAllocationRatio).To(Equal(30))
+				Expect(kv.Spec.Configuration.DeveloperConfiguration.MemoryOvercommit).To(Equal(150))
+				Expect(kv.Spec.Configuration.EvictionStrategy).To(HaveValue(Equal(kubevirtcorev1.EvictionStrategyNone)))
+			})
+
+			It("should update the KubeVirt CR when the preset is modified", func() {
+				existingResource, err := NewKubeVirt(hco)
+				Expect(err).ToNot(HaveOccurred())
+
+				hco.Spec.WorkloadDensityPreset = hcov1beta1.HyperConvergedDensePreset
+
+				cl := commontestutils.InitClient([]client.Object{hco, existingResource})
+				handler := (*genericOperand)(newKubevirtHandler(cl, commontestutils.GetScheme()))
+				res := handler.ensure(req)
+				Expect(res.UpgradeDone).To(BeFalse())
+				Expect(res.Updated).To(BeTrue())
+				Expect(res.Err).ToNot(HaveOccurred())
+
+				foundResource := &kubevirtcorev1.KubeVirt{}
+				Expect(
+					cl.Get(context.TODO(),
+						types.NamespacedName{Name: existingResource.Name, Namespace: existingResource.Namespace},
+						foundResource),
+				).ToNot(HaveOccurred())
+
+				Expect(foundResource.Spec.Configuration.DeveloperConfiguration.MemoryOvercommit).To(Equal(150))
+				Expect(foundResource.Spec.Configuration.KSMConfiguration).ToNot(BeNil())
+			})
+		})
+
+		Context("Higher workload density", func() {
+			It("should set the memory overcommit", func() {
+				hco.Spec.HigherWorkloadDensity = &hcov1beta1.HigherWorkloadDensityConfiguration{
+					MemoryOvercommitPercentage: ptr.To(130),
+				}
+
+				kv, err := NewKubeVirt(hco)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(kv.Spec.Configuration.DeveloperConfiguration.MemoryOvercommit).To(Equal(130))
+			})
+
+			It("should prefer the memory overcommit over the preset value", func() {
+				hco.Spec.WorkloadDensityPreset = hcov1beta1.HyperConvergedDensePreset
+				hco.Spec.HigherWorkloadDensity = &hcov1beta1.HigherWorkloadDensityConfiguration{
+					MemoryOvercommitPercentage: ptr.To(200),
+				}
+
+				kv, err := NewKubeVirt(hco)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(kv.Spec.Configuration.DeveloperConfiguration.CPUAllocationRatio).To(Equal(20))
+				Expect(kv.Spec.Configuration.DeveloperConfiguration.MemoryOvercommit).To(Equal(200))
+			})
+
+			It("should use the preset value if the memory overcommit is not set", func() {
+				hco.Spec.WorkloadDensityPreset = hcov1beta1.HyperConvergedDensePreset
+				hco.Spec.HigherWorkloadDensity = &hcov1beta1.HigherWorkloadDensityConfiguration{}
+
+				kv, err := NewKubeVirt(hco)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(kv.Spec.Configuration.DeveloperConfiguration.MemoryOvercommit).To(Equal(150))
+			})
+
+			It("should emit an event when the memory overcommit is applied", func() {
+				existingResource, err := NewKubeVirt(hco)
+				Expect(err).ToNot(HaveOccurred())
+
+				hco.Spec.HigherWorkloadDensity = &hcov1beta1.HigherWorkloadDensityConfiguration{
+					MemoryOvercommitPercentage: ptr.To(130),
+				}
+
+				eventEmitter := commontestutils.NewEventEmitterMock()
+				cl := commontestutils.InitClient([]client.Object{hco, existingResource})
+				handler := (*genericOperand)(newKubevirtHandlerWithEventEmitter(cl, commontestutils.GetScheme(), eventEmitter))
+				res := handler.ensure(req)
+				Expect(res.Updated).To(BeTrue())
+				Expect(res.Err).ToNot(HaveOccurred())
+
+				foundResource := &kubevirtcorev1.KubeVirt{}
+				Expect(
+					cl.Get(context.TODO(),
+						types.NamespacedName{Name: existingResource.Name, Namespace: existingResource.Namespace},
+						foundResource),
+				).ToNot(HaveOccurred())
+				Expect(foundResource.Spec.Configuration.DeveloperConfiguration.MemoryOvercommit).To(Equal(130))
+
+				expectedEvents := []commontestutils.MockEvent{
+					{
+						EventType: corev1.EventTypeNormal,
+						Reason:    memoryOvercommitEventReason,
+						Msg:       "The KubeVirt memory overcommit was set to 130%",
+					},
+				}
+				Expect(eventEmitter.CheckEvents(expectedEvents)).To(BeTrue())
+
+				By("should not emit the event again if the memory overcommit was not modified")
+				eventEmitter.Reset()
+				hco.Spec.LiveMigrationConfig.ParallelMigrationsPerCluster = ptr.To[uint32](10)
+				handler.hooks.(*kubevirtHooks).reset()
+				res = handler.ensure(req)
+				Expect(res.Updated).To(BeTrue())
+				Expect(res.Err).ToNot(HaveOccurred())
+				Expect(eventEmitter.CheckNoEventEmitted()).To(BeTrue())
+
+				By("should emit an event when the memory overcommit is reset")
+				hco.Spec.HigherWorkloadDensity = nil
+				handler.hooks.(*kubevirtHooks).reset()
+				res = handler.ensure(req)
+				Expect(res.Updated).To(BeTrue())
+				Expect(res.Err).ToNot(HaveOccurred())
+
+				expectedEvents = []commontestutils.MockEvent{
+					{
+						EventType: corev1.EventTypeNormal,
+						Reason:    memoryOvercommitEventReason,
+						Msg:       "The KubeVirt memory overcommit was reset to the default",
+					},
+				}
+				Expect(eventEmitter.CheckEvents(expectedEvents)).To(BeTrue())
+			})
+		})
+
 		Context("VmiCPUAllocationRatio", func() {
 			It("should add CPUAllocationRatio if missing in KV CR", func() {
 				expectedCPUAllocationRatio := 16
@@ -3125,6 +3448,53 @@ Version: 1.2.3`)
 				})
 			})
 
+			Context("with custom profile", func() {
+				It("Should return error if the rates are missing", func() {
+					hco.Spec.TuningPolicy = hcov1beta1.HyperConvergedCustomTuningPolicy
+
+					kv, err := NewKubeVirt(hco)
+
+					Expect(err).To(MatchError(ContainSubstring("spec.tuningPolicyRates is missing")))
+					Expect(kv).To(BeNil())
+				})
+
+				DescribeTable("Should return error if the rates are out of range", func(rates hcov1beta1.TuningPolicyRates, expectedErr string) {
+					hco.Spec.TuningPolicy = hcov1beta1.HyperConvergedCustomTuningPolicy
+					hco.Spec.TuningPolicyRates = &rates
+
+					kv, err := NewKubeVirt(hco)
+
+					Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+					Expect(kv).To(BeNil())
+				},
+					Entry("zero qps", hcov1beta1.TuningPolicyRates{QPS: 0, Burst: 100}, "qps must be between 1 and 1000"),
+					Entry("too high qps", hcov1beta1.TuningPolicyRates{QPS: 1001, Burst: 2000}, "qps must be between 1 and 1000"),
+					Entry("zero burst", hcov1beta1.TuningPolicyRates{QPS: 100, Burst: 0}, "burst must be between 1 and 2000"),
+					Entry("too high burst", hcov1beta1.TuningPolicyRates{QPS: 100, Burst: 2001}, "burst must be between 1 and 2000"),
+					Entry("burst lower than qps", hcov1beta1.TuningPolicyRates{QPS: 200, Burst: 100}, "burst must not be lower than"),
+				)
+
+				It("Should create the fields and populate them using the custom values", func() {
+					hco.Spec.TuningPolicy = hcov1beta1.HyperConvergedCustomTuningPolicy
+					hco.Spec.TuningPolicyRates = &hcov1beta1.TuningPolicyRates{QPS: 150, Burst: 300}
+					// the annotation is only used by the annotation profile
+					hco.Annotations = map[string]string{"hco.kubevirt.io/tuningPolicy": `{"qps": 100, "burst": 200}`}
+
+					kv, err := NewKubeVirt(hco)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(kv).ToNot(BeNil())
+
+					for _, config := range []*kubevirtcorev1.ReloadableComponentConfiguration{
+						kv.Spec.Configuration.APIConfiguration,
+						kv.Spec.Configuration.ControllerConfiguration,
+						kv.Spec.Configuration.WebhookConfiguration,
+						kv.Spec.Configuration.HandlerConfiguration,
+					} {
+						Expect(config.RestClient.RateLimiter.TokenBucketRateLimiter.QPS).To(Equal(float32(150)))
+						Expect(config.RestClient.RateLimiter.TokenBucketRateLimiter.Burst).To(Equal(300))
+					}
+				})
+			})
 		})
 
 		Context("jsonpath Annotation", func() {
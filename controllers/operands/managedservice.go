@@ -0,0 +1,31 @@
+package operands
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// getInfraConfig returns the configuration of the infra components. On managed OpenShift services, the infra nodes
+// are tainted, so if the node placement is not set, the infra components tolerate the taint of the infra nodes by
+// default.
+func getInfraConfig(hc *hcov1beta1.HyperConverged) hcov1beta1.HyperConvergedInfraConfig {
+	infra := hc.Spec.Infra
+	if infra.NodePlacement != nil || !hcoutil.GetClusterInfo().GetManagedService().IsManaged() {
+		return infra
+	}
+
+	infra.NodePlacement = &sdkapi.NodePlacement{
+		Tolerations: []corev1.Toleration{
+			{
+				Key:      hcoutil.ManagedServiceInfraNodeTaintKey,
+				Operator: corev1.TolerationOpExists,
+				Effect:   corev1.TaintEffectNoSchedule,
+			},
+		},
+	}
+
+	return infra
+}
@@ -0,0 +1,91 @@
+package operands
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Managed service guardrails", func() {
+	var hco *hcov1beta1.HyperConverged
+
+	getClusterInfo := hcoutil.GetClusterInfo
+
+	infraToleration := corev1.Toleration{
+		Key:      hcoutil.ManagedServiceInfraNodeTaintKey,
+		Operator: corev1.TolerationOpExists,
+		Effect:   corev1.TaintEffectNoSchedule,
+	}
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+	})
+
+	AfterEach(func() {
+		hcoutil.GetClusterInfo = getClusterInfo
+	})
+
+	Context("on a self-managed cluster", func() {
+		It("should not set the infra node placement", func() {
+			Expect(getInfraConfig(hco).NodePlacement).To(BeNil())
+
+			cdi, err := NewCDI(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cdi.Spec.Infra.Tolerations).To(BeEmpty())
+		})
+	})
+
+	Context("on a managed service", func() {
+		BeforeEach(func() {
+			hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+				return managedServiceClusterInfo{}
+			}
+		})
+
+		It("should not modify the labels of the operands", func() {
+			Expect(getLabels(hco, hcoutil.AppComponentCompute)).To(Equal(hcoutil.GetLabels(hco.Name, hcoutil.AppComponentCompute)))
+		})
+
+		It("should tolerate the infra nodes taint by default", func() {
+			kv, err := NewKubeVirt(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kv.Spec.Infra.NodePlacement.Tolerations).To(ConsistOf(infraToleration))
+
+			cdi, err := NewCDI(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cdi.Spec.Infra.Tolerations).To(ConsistOf(infraToleration))
+
+			cna, err := NewNetworkAddons(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cna.Spec.PlacementConfiguration.Infra.Tolerations).To(ConsistOf(infraToleration))
+
+			ssp, _, err := NewSSP(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ssp.Spec.TemplateValidator.Placement.Tolerations).To(ConsistOf(infraToleration))
+
+			Expect(hco.Spec.Infra.NodePlacement).To(BeNil(), "should not modify the HyperConverged CR")
+		})
+
+		It("should use the infra node placement, if set", func() {
+			hco.Spec.Infra.NodePlacement = commontestutils.NewNodePlacement()
+
+			cdi, err := NewCDI(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cdi.Spec.Infra.Tolerations).To(Equal(hco.Spec.Infra.NodePlacement.Tolerations))
+			Expect(cdi.Spec.Infra.Tolerations).ToNot(ContainElement(infraToleration))
+		})
+	})
+})
+
+// managedServiceClusterInfo simulates a managed OpenShift service cluster
+type managedServiceClusterInfo struct {
+	commontestutils.ClusterInfoMock
+}
+
+func (managedServiceClusterInfo) GetManagedService() hcoutil.ManagedService {
+	return hcoutil.ManagedServiceROSA
+}
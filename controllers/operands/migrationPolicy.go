@@ -0,0 +1,162 @@
+package operands
+
+import (
+	"fmt"
+	"reflect"
+
+	objectreferencesv1 "github.com/openshift/custom-resource-status/objectreferences/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/reference"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+// migrationPolicyOperand creates the KubeVirt MigrationPolicies, defined by the migrationPolicies field in the
+// HyperConverged CR, and removes the ones that are no longer required.
+//
+// The MigrationPolicies are cluster scoped, so HCO can't set an owner reference on them. Instead, they are identified
+// by the HCO labels, and are removed when the HyperConverged CR is deleted.
+type migrationPolicyOperand struct {
+	Client client.Client
+	Scheme *runtime.Scheme
+}
+
+func newMigrationPolicyHandler(Client client.Client, Scheme *runtime.Scheme) Operand {
+	return &migrationPolicyOperand{
+		Client: Client,
+		Scheme: Scheme,
+	}
+}
+
+func (h migrationPolicyOperand) ensure(req *common.HcoRequest) *EnsureResult {
+	res := NewEnsureResult(&migrationsv1alpha1.MigrationPolicy{})
+
+	required := NewMigrationPolicies(req.Instance)
+
+	existing := &migrationsv1alpha1.MigrationPolicyList{}
+	err := h.Client.List(req.Ctx, existing, client.MatchingLabels{
+		hcoutil.AppLabel:          req.Instance.Name,
+		hcoutil.AppLabelComponent: string(hcoutil.AppComponentCompute),
+	})
+	if err != nil {
+		if meta.IsNoMatchError(err) && len(required) == 0 {
+			// the KubeVirt CRDs are not deployed yet; there is nothing to remove
+			return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
+		}
+		return res.Error(err)
+	}
+
+	cl := common.NewCustomMetadataClient(h.Client, req.Instance)
+	for i := range existing.Items {
+		found := &existing.Items[i]
+
+		policy, ok := required[found.Name]
+		if !ok {
+			req.Logger.Info("Removing a MigrationPolicy that is no longer required", "name", found.Name)
+			if err = h.Client.Delete(req.Ctx, found); client.IgnoreNotFound(err) != nil {
+				return res.Error(err)
+			}
+			if err = h.removeRelatedObject(req, found); err != nil {
+				return res.Error(err)
+			}
+			res.SetName(found.Name).SetDeleted()
+			continue
+		}
+		delete(required, found.Name)
+
+		hasCustomMetadata := common.StripCustomMetadata(req.Instance, found)
+		if !reflect.DeepEqual(found.Spec, policy.Spec) || !reflect.DeepEqual(found.Labels, policy.Labels) || !hasCustomMetadata {
+			if req.HCOTriggered {
+				req.Logger.Info("Updating existing MigrationPolicy to new opinionated values", "name", found.Name)
+			} else {
+				req.Logger.Info("Reconciling an externally updated MigrationPolicy to its opinionated values", "name", found.Name)
+			}
+			fieldManager := hcoutil.GetLastFieldManager(found)
+			hcoutil.DeepCopyLabels(&policy.ObjectMeta, &found.ObjectMeta)
+			policy.Spec.DeepCopyInto(&found.Spec)
+			if err = cl.Update(req.Ctx, found); err != nil {
+				return res.Error(err)
+			}
+			res.SetName(found.Name).SetUpdated().SetOverwritten(!req.HCOTriggered).SetFieldManager(fieldManager)
+		}
+
+		if err = h.addRelatedObject(req, found); err != nil {
+			return res.Error(err)
+		}
+	}
+
+	for _, policy := range required {
+		req.Logger.Info("Creating MigrationPolicy", "name", policy.Name)
+		if err = cl.Create(req.Ctx, policy); err != nil {
+			return res.Error(fmt.Errorf("failed to create the %s MigrationPolicy; %w", policy.Name, err))
+		}
+		if err = h.addRelatedObject(req, policy); err != nil {
+			return res.Error(err)
+		}
+		res.SetName(policy.Name).SetCreated()
+	}
+
+	return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
+}
+
+func (h migrationPolicyOperand) reset() { /* no implementation */ }
+
+func (h migrationPolicyOperand) addRelatedObject(req *common.HcoRequest, policy *migrationsv1alpha1.MigrationPolicy) error {
+	changed, err := hcoutil.AddCrToTheRelatedObjectList(&req.Instance.Status.RelatedObjects, policy, h.Scheme)
+	if err != nil {
+		return err
+	}
+
+	if changed {
+		req.StatusDirty = true
+	}
+
+	return nil
+}
+
+func (h migrationPolicyOperand) removeRelatedObject(req *common.HcoRequest, policy *migrationsv1alpha1.MigrationPolicy) error {
+	objectRef, err := reference.GetReference(h.Scheme, policy)
+	if err != nil {
+		return err
+	}
+
+	if err = objectreferencesv1.RemoveObjectReference(&req.Instance.Status.RelatedObjects, *objectRef); err != nil {
+		return err
+	}
+	req.StatusDirty = true
+
+	return nil
+}
+
+// NewMigrationPolicies returns the MigrationPolicies that HCO should create, by their names
+func NewMigrationPolicies(hc *hcov1beta1.HyperConverged) map[string]*migrationsv1alpha1.MigrationPolicy {
+	policies := make(map[string]*migrationsv1alpha1.MigrationPolicy, len(hc.Spec.MigrationPolicies))
+	for _, template := range hc.Spec.MigrationPolicies {
+		policy := &migrationsv1alpha1.MigrationPolicy{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: migrationsv1alpha1.SchemeGroupVersion.String(),
+				Kind:       migrationsv1alpha1.MigrationPolicyKind.Kind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   template.Name,
+				Labels: getLabels(hc, hcoutil.AppComponentCompute),
+			},
+			Spec: *template.Spec.DeepCopy(),
+		}
+
+		if policy.Spec.Selectors == nil {
+			policy.Spec.Selectors = &migrationsv1alpha1.Selectors{}
+		}
+
+		policies[policy.Name] = policy
+	}
+
+	return policies
+}
@@ -0,0 +1,142 @@
+package operands
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Migration policy tests", func() {
+	var (
+		hco *v1beta1.HyperConverged
+		req *common.HcoRequest
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		hco.Spec.MigrationPolicies = []v1beta1.MigrationPolicyTemplate{
+			{
+				Name: "large-vms",
+				Spec: migrationsv1alpha1.MigrationPolicySpec{
+					Selectors: &migrationsv1alpha1.Selectors{
+						VirtualMachineInstanceSelector: migrationsv1alpha1.LabelSelector{"size": "large"},
+					},
+					BandwidthPerMigration:   ptr.To(resource.MustParse("1Gi")),
+					CompletionTimeoutPerGiB: ptr.To[int64](300),
+					AllowPostCopy:           ptr.To(true),
+				},
+			},
+			{
+				Name: "no-selectors",
+				Spec: migrationsv1alpha1.MigrationPolicySpec{
+					AllowAutoConverge: ptr.To(true),
+				},
+			},
+		}
+		req = commontestutils.NewReq(hco)
+	})
+
+	listPolicies := func(cl client.Client) []migrationsv1alpha1.MigrationPolicy {
+		policies := &migrationsv1alpha1.MigrationPolicyList{}
+		Expect(cl.List(context.TODO(), policies)).To(Succeed())
+		return policies.Items
+	}
+
+	Context("test NewMigrationPolicies", func() {
+		It("should create a MigrationPolicy for each template", func() {
+			policies := NewMigrationPolicies(hco)
+			Expect(policies).To(HaveLen(2))
+
+			policy := policies["large-vms"]
+			Expect(policy).ToNot(BeNil())
+			Expect(policy.Labels).To(HaveKeyWithValue(hcoutil.AppLabelComponent, string(hcoutil.AppComponentCompute)))
+			Expect(policy.Spec.Selectors.VirtualMachineInstanceSelector).To(HaveKeyWithValue("size", "large"))
+			Expect(policy.Spec.CompletionTimeoutPerGiB).To(HaveValue(Equal(int64(300))))
+		})
+
+		It("should set empty selectors if they are missing", func() {
+			policy := NewMigrationPolicies(hco)["no-selectors"]
+			Expect(policy).ToNot(BeNil())
+			Expect(policy.Spec.Selectors).ToNot(BeNil())
+		})
+
+		It("should return an empty map if there are no templates", func() {
+			hco.Spec.MigrationPolicies = nil
+			Expect(NewMigrationPolicies(hco)).To(BeEmpty())
+		})
+	})
+
+	Context("test migrationPolicyOperand", func() {
+		It("should create the MigrationPolicies and add them to the related objects", func() {
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := newMigrationPolicyHandler(cl, commontestutils.GetScheme())
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+
+			Expect(listPolicies(cl)).To(HaveLen(2))
+
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(req.Instance.Status.RelatedObjects).To(ContainElement(HaveField("Name", "large-vms")))
+			Expect(req.Instance.Status.RelatedObjects).To(ContainElement(HaveField("Name", "no-selectors")))
+		})
+
+		It("should reconcile a modified MigrationPolicy", func() {
+			modified := NewMigrationPolicies(hco)["large-vms"]
+			modified.Spec.AllowPostCopy = ptr.To(false)
+
+			cl := commontestutils.InitClient([]client.Object{hco, modified})
+			handler := newMigrationPolicyHandler(cl, commontestutils.GetScheme())
+
+			req.HCOTriggered = false
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+			Expect(res.Overwritten).To(BeTrue())
+
+			found := &migrationsv1alpha1.MigrationPolicy{}
+			Expect(cl.Get(context.TODO(), client.ObjectKey{Name: "large-vms"}, found)).To(Succeed())
+			Expect(found.Spec.AllowPostCopy).To(HaveValue(BeTrue()))
+		})
+
+		It("should remove MigrationPolicies that are no longer required", func() {
+			stale := &migrationsv1alpha1.MigrationPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "old-policy",
+					Labels: getLabels(hco, hcoutil.AppComponentCompute),
+				},
+			}
+			unmanaged := &migrationsv1alpha1.MigrationPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "user-policy",
+				},
+			}
+
+			hco.Spec.MigrationPolicies = nil
+			cl := commontestutils.InitClient([]client.Object{hco, stale, unmanaged})
+			handler := newMigrationPolicyHandler(cl, commontestutils.GetScheme())
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Deleted).To(BeTrue())
+
+			policies := listPolicies(cl)
+			Expect(policies).To(HaveLen(1))
+			Expect(policies[0].Name).To(Equal("user-policy"))
+			Expect(req.Instance.Status.RelatedObjects).ToNot(ContainElement(HaveField("Name", "old-policy")))
+		})
+	})
+})
@@ -24,8 +24,7 @@ type mtqOperand struct {
 }
 
 func (mtq mtqOperand) ensure(req *common.HcoRequest) *EnsureResult {
-	if hcoutil.GetClusterInfo().IsInfrastructureHighlyAvailable() && // MTQ is not supported at a single node cluster
-		req.Instance.Spec.FeatureGates.EnableManagedTenantQuota != nil && *req.Instance.Spec.FeatureGates.EnableManagedTenantQuota {
+	if IsMTQEnabled(req.Instance) {
 		// if the FG is set, make sure the MTQ CR is in place and up-to-date
 		return mtq.operand.ensure(req)
 	}
@@ -67,6 +66,12 @@ func (mtq mtqOperand) ensureDeleted(req *common.HcoRequest) *EnsureResult {
 		}
 	}
 
+	setOperandVersion(req, mtq.operand.crType, "")
+	setOperandConditions(req, mtq.operand.crType, nil)
+	setOperandObservedGeneration(req, mtq.operand.crType, 0)
+	removeOperandHealth(req, mtq.operand.crType)
+	removeComponentPhase(req, mtq.operand.crType)
+
 	return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
 }
 
@@ -91,7 +96,11 @@ type mtqHooks struct {
 
 func (h *mtqHooks) getFullCr(hc *hcov1beta1.HyperConverged) (client.Object, error) {
 	if h.cache == nil {
-		h.cache = NewMTQ(hc)
+		mtq, err := NewMTQ(hc)
+		if err != nil {
+			return nil, err
+		}
+		h.cache = mtq
 	}
 	return h.cache, nil
 }
@@ -102,9 +111,11 @@ func (*mtqHooks) getConditions(cr runtime.Object) []metav1.Condition {
 	return osConditionsToK8s(cr.(*mtqv1alpha1.MTQ).Status.Conditions)
 }
 
-func (*mtqHooks) checkComponentVersion(cr runtime.Object) bool {
-	found := cr.(*mtqv1alpha1.MTQ)
-	return checkComponentVersion(hcoutil.MtqVersionEnvV, found.Status.ObservedVersion)
+func (*mtqHooks) getObservedVersion(cr runtime.Object) string {
+	return cr.(*mtqv1alpha1.MTQ).Status.ObservedVersion
+}
+func (h *mtqHooks) checkComponentVersion(cr runtime.Object) bool {
+	return checkComponentVersion(hcoutil.MtqVersionEnvV, h.getObservedVersion(cr))
 }
 
 func (h *mtqHooks) reset() {
@@ -140,7 +151,7 @@ func (*mtqHooks) updateCr(req *common.HcoRequest, Client client.Client, exists r
 
 func (*mtqHooks) justBeforeComplete(_ *common.HcoRequest) { /* no implementation */ }
 
-func NewMTQ(hc *hcov1beta1.HyperConverged, opts ...string) *mtqv1alpha1.MTQ {
+func NewMTQ(hc *hcov1beta1.HyperConverged, opts ...string) (*mtqv1alpha1.MTQ, error) {
 	priorityClassName := mtqv1alpha1.MTQPriorityClass(kvPriorityClass)
 	spec := mtqv1alpha1.MTQSpec{
 		ImagePullPolicy: corev1.PullIfNotPresent,
@@ -157,8 +168,8 @@ func NewMTQ(hc *hcov1beta1.HyperConverged, opts ...string) *mtqv1alpha1.MTQ {
 		PriorityClass: &priorityClassName,
 	}
 
-	if hc.Spec.Infra.NodePlacement != nil {
-		hc.Spec.Infra.NodePlacement.DeepCopyInto(&spec.Infra)
+	if infraNodePlacement := getInfraConfig(hc).NodePlacement; infraNodePlacement != nil {
+		infraNodePlacement.DeepCopyInto(&spec.Infra)
 	}
 
 	if hc.Spec.Workloads.NodePlacement != nil {
@@ -168,7 +179,11 @@ func NewMTQ(hc *hcov1beta1.HyperConverged, opts ...string) *mtqv1alpha1.MTQ {
 	mtq := NewMTQWithNameOnly(hc, opts...)
 	mtq.Spec = spec
 
-	return mtq
+	if err := applyPatchToSpec(hc, common.JSONPatchMTQAnnotationName, mtq); err != nil {
+		return nil, err
+	}
+
+	return mtq, nil
 }
 
 func NewMTQWithNameOnly(hc *hcov1beta1.HyperConverged, opts ...string) *mtqv1alpha1.MTQ {
@@ -68,7 +68,8 @@ var _ = Describe("MTQ tests", func() {
 
 	Context("test NewMTQ", func() {
 		It("should have all default fields", func() {
-			mtq := NewMTQ(hco)
+			mtq, err := NewMTQ(hco)
+			Expect(err).ToNot(HaveOccurred())
 
 			Expect(mtq.Name).Should(Equal("mtq-" + hco.Name))
 			Expect(mtq.Namespace).Should(BeEmpty())
@@ -98,7 +99,8 @@ var _ = Describe("MTQ tests", func() {
 			hco.Spec.Infra.NodePlacement = &testNodePlacement
 			hco.Spec.Workloads.NodePlacement = &testNodePlacement
 
-			mtq := NewMTQ(hco)
+			mtq, err := NewMTQ(hco)
+			Expect(err).ToNot(HaveOccurred())
 
 			Expect(mtq.Spec.Infra).Should(Equal(testNodePlacement))
 			Expect(mtq.Spec.Workloads).Should(Equal(testNodePlacement))
@@ -117,7 +119,8 @@ var _ = Describe("MTQ tests", func() {
 				},
 			}
 
-			mtq := NewMTQ(hco)
+			mtq, err := NewMTQ(hco)
+			Expect(err).ToNot(HaveOccurred())
 
 			Expect(mtq.Spec.CertConfig.CA).ShouldNot(BeNil())
 			Expect(mtq.Spec.CertConfig.CA.Duration).ShouldNot(BeNil())
@@ -129,6 +132,26 @@ var _ = Describe("MTQ tests", func() {
 			Expect(mtq.Spec.CertConfig.Server.Duration.Duration.String()).Should(Equal("36h0m0s"))
 			Expect(mtq.Spec.CertConfig.Server.RenewBefore.Duration.String()).Should(Equal("18h0m0s"))
 		})
+
+		It("should apply the jsonpatch annotation", func() {
+			hco.Annotations = map[string]string{
+				common.JSONPatchMTQAnnotationName: `[{"op": "replace", "path": "/spec/imagePullPolicy", "value": "Always"}]`,
+			}
+
+			mtq, err := NewMTQ(hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mtq.Spec.ImagePullPolicy).To(Equal(corev1.PullAlways))
+		})
+
+		It("should fail if the jsonpatch annotation is invalid", func() {
+			hco.Annotations = map[string]string{
+				common.JSONPatchMTQAnnotationName: `[{"op": "wrongOp", "path": "/spec/imagePullPolicy", "value": "Always"}]`,
+			}
+
+			mtq, err := NewMTQ(hco)
+			Expect(err).To(MatchError(ContainSubstring("invalid jsonPatch in the " + common.JSONPatchMTQAnnotationName)))
+			Expect(mtq).To(BeNil())
+		})
 	})
 
 	Context("check FG", func() {
@@ -150,7 +173,18 @@ var _ = Describe("MTQ tests", func() {
 		})
 
 		It("should delete MTQ if the FG is not set", func() {
-			mtq := NewMTQ(hco)
+			mtq, err := NewMTQ(hco)
+			Expect(err).ToNot(HaveOccurred())
+			hco.Status.OperandVersions = []v1beta1.Version{{Name: "MTQ", Version: "v1.1.0"}}
+			hco.Status.OperandConditions = []v1beta1.OperandCondition{{Component: "MTQ", Type: v1beta1.ConditionAvailable, Status: metav1.ConditionTrue}}
+			hco.Status.OperandObservedGenerations = []v1beta1.OperandObservedGeneration{{Name: "MTQ", ObservedGeneration: 1}}
+			hco.Status.ComponentsHealth = []v1beta1.ComponentHealth{{Component: "MTQ", Health: common.SystemHealthStatusHealthy}}
+			hco.Status.Progress = &v1beta1.DeploymentProgress{
+				ReadyComponents: 1,
+				TotalComponents: 1,
+				Message:         "1/1 components ready",
+				Components:      []v1beta1.ComponentProgress{{Name: "MTQ", Phase: v1beta1.ComponentPhaseReady}},
+			}
 			cl = commontestutils.InitClient([]client.Object{hco, mtq})
 
 			handler := newMtqHandler(cl, commontestutils.GetScheme())
@@ -166,6 +200,13 @@ var _ = Describe("MTQ tests", func() {
 			foundMTQs := &mtqv1alpha1.MTQList{}
 			Expect(cl.List(context.Background(), foundMTQs)).Should(Succeed())
 			Expect(foundMTQs.Items).Should(BeEmpty())
+
+			Expect(hco.Status.OperandVersions).To(BeEmpty())
+			Expect(hco.Status.OperandConditions).To(BeEmpty())
+			Expect(hco.Status.OperandObservedGenerations).To(BeEmpty())
+			Expect(hco.Status.ComponentsHealth).To(BeEmpty())
+			Expect(hco.Status.Progress.Components).To(BeEmpty())
+			Expect(hco.Status.Progress.Message).To(Equal("0/0 components ready"))
 		})
 
 		It("should create MTQ if the FG is set", func() {
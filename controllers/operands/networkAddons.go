@@ -55,9 +55,11 @@ func (h *cnaHooks) getEmptyCr() client.Object { return &networkaddonsv1.NetworkA
 func (h *cnaHooks) getConditions(cr runtime.Object) []metav1.Condition {
 	return osConditionsToK8s(cr.(*networkaddonsv1.NetworkAddonsConfig).Status.Conditions)
 }
+func (*cnaHooks) getObservedVersion(cr runtime.Object) string {
+	return cr.(*networkaddonsv1.NetworkAddonsConfig).Status.ObservedVersion
+}
 func (h *cnaHooks) checkComponentVersion(cr runtime.Object) bool {
-	found := cr.(*networkaddonsv1.NetworkAddonsConfig)
-	return checkComponentVersion(hcoutil.CnaoVersionEnvV, found.Status.ObservedVersion)
+	return checkComponentVersion(hcoutil.CnaoVersionEnvV, h.getObservedVersion(cr))
 }
 func (h *cnaHooks) reset() {
 	h.cache = nil
@@ -149,7 +151,8 @@ func NewNetworkAddons(hc *hcov1beta1.HyperConverged, opts ...string) (*networkad
 		return nil, err
 	}
 
-	if hc.Spec.FeatureGates.DeployKubeSecondaryDNS != nil && *hc.Spec.FeatureGates.DeployKubeSecondaryDNS {
+	if hc.Spec.FeatureGates.DeployKubeSecondaryDNS != nil && *hc.Spec.FeatureGates.DeployKubeSecondaryDNS &&
+		isFeatureGateSupported(deployKubeSecondaryDNSFeatureGate) {
 		baseDomain := hcoutil.GetClusterInfo().GetBaseDomain()
 		cnaoSpec.KubeSecondaryDNS = &networkaddonsshared.KubeSecondaryDNS{
 			Domain:       baseDomain,
@@ -158,7 +161,7 @@ func NewNetworkAddons(hc *hcov1beta1.HyperConverged, opts ...string) (*networkad
 	}
 
 	cnaoSpec.Ovs = hcoAnnotation2CnaoSpec(hc.ObjectMeta.Annotations)
-	cnaoInfra := hcoConfig2CnaoPlacement(hc.Spec.Infra.NodePlacement)
+	cnaoInfra := hcoConfig2CnaoPlacement(getInfraConfig(hc).NodePlacement)
 	cnaoWorkloads := hcoConfig2CnaoPlacement(hc.Spec.Workloads.NodePlacement)
 	if cnaoInfra != nil || cnaoWorkloads != nil {
 		cnaoSpec.PlacementConfiguration = &networkaddonsshared.PlacementConfiguration{
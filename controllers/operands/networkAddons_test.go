@@ -132,7 +132,7 @@ var _ = Describe("CNA Operand", func() {
 			existingResource, err := NewNetworkAddons(hco)
 			Expect(err).ToNot(HaveOccurred())
 
-			hco.Spec.Infra = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
+			hco.Spec.Infra = hcov1beta1.HyperConvergedInfraConfig{HyperConvergedConfig: hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}}
 			hco.Spec.Workloads = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
 
 			cl := commontestutils.InitClient([]client.Object{hco, existingResource})
@@ -165,7 +165,7 @@ var _ = Describe("CNA Operand", func() {
 		It("should remove node placement if missing in HCO CR", func() {
 
 			hcoNodePlacement := commontestutils.NewHco()
-			hcoNodePlacement.Spec.Infra = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
+			hcoNodePlacement.Spec.Infra = hcov1beta1.HyperConvergedInfraConfig{HyperConvergedConfig: hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}}
 			hcoNodePlacement.Spec.Workloads = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
 			existingResource, err := NewNetworkAddons(hcoNodePlacement)
 			Expect(err).ToNot(HaveOccurred())
@@ -192,7 +192,7 @@ var _ = Describe("CNA Operand", func() {
 
 		It("should modify node placement according to HCO CR", func() {
 
-			hco.Spec.Infra = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
+			hco.Spec.Infra = hcov1beta1.HyperConvergedInfraConfig{HyperConvergedConfig: hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}}
 			hco.Spec.Workloads = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
 			existingResource, err := NewNetworkAddons(hco)
 			Expect(err).ToNot(HaveOccurred())
@@ -231,7 +231,7 @@ var _ = Describe("CNA Operand", func() {
 		})
 
 		It("should overwrite node placement if directly set on CNAO CR", func() {
-			hco.Spec.Infra = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
+			hco.Spec.Infra = hcov1beta1.HyperConvergedInfraConfig{HyperConvergedConfig: hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}}
 			hco.Spec.Workloads = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
 			existingResource, err := NewNetworkAddons(hco)
 			Expect(err).ToNot(HaveOccurred())
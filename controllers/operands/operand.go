@@ -1,15 +1,19 @@
 package operands
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"reflect"
+	"sort"
 	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch/v5"
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -18,6 +22,7 @@ import (
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/metrics"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -59,6 +64,8 @@ type hcoOperandHooks interface {
 	getConditions(runtime.Object) []metav1.Condition
 	// on upgrade mode, check if the CR is already with the expected version
 	checkComponentVersion(runtime.Object) bool
+	// get the version of the operand, as reported in the status of the CR
+	getObservedVersion(runtime.Object) string
 }
 
 type reseter interface {
@@ -81,7 +88,7 @@ func (h *genericOperand) ensure(req *common.HcoRequest) *EnsureResult {
 	}
 
 	key := client.ObjectKeyFromObject(cr)
-	res.SetName(key.Name)
+	res.SetName(key.Name).SetNamespace(key.Namespace)
 	found := h.hooks.getEmptyCr()
 	err = h.Client.Get(req.Ctx, key, found)
 	if err != nil {
@@ -102,6 +109,10 @@ func (h *genericOperand) ensure(req *common.HcoRequest) *EnsureResult {
 	}
 
 	if res.Err == nil {
+		// the CR of a paused operand is not updated, but it is still created if it is missing
+		if _, isOperand := h.hooks.(hcoOperandHooks); isOperand && (res.Created || !isReconcilePaused(req.Instance, h.crType)) {
+			setOperandObservedGeneration(req, h.crType, req.Instance.Generation)
+		}
 		h.hooks.justBeforeComplete(req)
 	}
 
@@ -111,9 +122,31 @@ func (h *genericOperand) ensure(req *common.HcoRequest) *EnsureResult {
 func (h *genericOperand) handleExistingCr(req *common.HcoRequest, key client.ObjectKey, found client.Object, cr client.Object, res *EnsureResult) *EnsureResult {
 	req.Logger.Info(h.crType+" already exists", h.crType+".Namespace", key.Namespace, h.crType+".Name", key.Name)
 
-	updated, overwritten, err := h.hooks.updateCr(req, h.Client, found, cr)
-	if err != nil {
-		return res.Error(err)
+	// read the field manager before the update, that modifies the managed fields of the found object
+	fieldManager := hcoutil.GetLastFieldManager(found)
+	var (
+		updated, overwritten bool
+		err                  error
+	)
+	if isReconcilePaused(req.Instance, h.crType) {
+		req.Logger.Info("The reconciliation of the "+h.crType+" is paused by the "+common.PauseReconcileAnnotationName+" annotation; skipping its update", h.crType+".Name", key.Name)
+	} else {
+		// the handlers compare the required object with the found object, that must not contain the custom metadata
+		hasCustomMetadata := common.StripCustomMetadata(req.Instance, found)
+		cl := common.NewCustomMetadataClient(h.Client, req.Instance)
+
+		updated, overwritten, err = h.hooks.updateCr(req, cl, found, cr)
+		if err != nil {
+			return res.Error(err)
+		}
+
+		if !updated && !hasCustomMetadata {
+			req.Logger.Info("Adding the custom metadata to the "+h.crType, h.crType+".Name", key.Name)
+			if err = cl.Update(req.Ctx, found); err != nil {
+				return res.Error(err)
+			}
+			updated, overwritten = true, !req.HCOTriggered
+		}
 	}
 	if updated {
 		// refresh the object
@@ -130,7 +163,7 @@ func (h *genericOperand) handleExistingCr(req *common.HcoRequest, key client.Obj
 
 	if updated {
 		req.StatusDirty = true
-		return res.SetUpdated().SetOverwritten(overwritten)
+		return res.SetUpdated().SetOverwritten(overwritten).SetFieldManager(fieldManager)
 	}
 
 	if opr, ok := h.hooks.(hcoOperandHooks); ok { // for operands, perform some more checks
@@ -176,14 +209,22 @@ func (h *genericOperand) handleExistingCrSkipCache(req *common.HcoRequest, key c
 
 func (h *genericOperand) completeEnsureOperands(req *common.HcoRequest, opr hcoOperandHooks, found client.Object, res *EnsureResult) *EnsureResult {
 	// Handle KubeVirt resource conditions
-	isReady := handleComponentConditions(req, h.crType, opr.getConditions(found))
+	conds := opr.getConditions(found)
+	isReady := handleComponentConditions(req, h.crType, conds)
+
+	setOperandVersion(req, h.crType, opr.getObservedVersion(found))
 
 	versionUpdated := opr.checkComponentVersion(found)
 	if isReady && !versionUpdated {
 		req.Logger.Info(fmt.Sprintf("could not complete the upgrade process. %s is not with the expected version. Check %s observed version in the status field of its CR", h.crType, h.crType))
 	}
 
+	setComponentPhase(req, h.crType, getComponentPhase(req, conds, isReady, versionUpdated))
+
 	upgradeDone := req.UpgradeMode && isReady && versionUpdated
+	if req.UpgradeMode && !upgradeDone {
+		req.UpgradePendingComponents = append(req.UpgradePendingComponents, h.crType)
+	}
 	return res.SetUpgradeDone(upgradeDone)
 }
 
@@ -218,7 +259,7 @@ func (h *genericOperand) createNewCr(req *common.HcoRequest, cr client.Object, r
 	if cr.GetResourceVersion() != "" {
 		cr.SetResourceVersion("")
 	}
-	err := h.Client.Create(req.Ctx, cr)
+	err := common.NewCustomMetadataClient(h.Client, req.Instance).Create(req.Ctx, cr)
 	if err != nil {
 		req.Logger.Error(err, "Failed to create object for "+h.crType)
 		return res.Error(err)
@@ -235,6 +276,10 @@ func (h *genericOperand) reset() {
 // handleComponentConditions - read and process a sub-component conditions.
 // returns true if the the conditions indicates "ready" state and false if not.
 func handleComponentConditions(req *common.HcoRequest, component string, componentConds []metav1.Condition) bool {
+	setOperandConditionMetrics(req, component, componentConds)
+	setOperandConditions(req, component, componentConds)
+	setOperandHealth(req, getOperandHealth(component, componentConds))
+
 	if len(componentConds) == 0 {
 		getConditionsForNewCr(req, component)
 		return false
@@ -243,6 +288,202 @@ func handleComponentConditions(req *common.HcoRequest, component string, compone
 	return setConditionsByOperandConditions(req, component, componentConds)
 }
 
+// setOperandConditionMetrics exposes the Available, Progressing and Degraded conditions of the operand as metrics. An
+// operand with no conditions was just created, and so it is considered as progressing.
+func setOperandConditionMetrics(req *common.HcoRequest, component string, componentConds []metav1.Condition) {
+	for _, condType := range []string{hcov1beta1.ConditionAvailable, hcov1beta1.ConditionProgressing, hcov1beta1.ConditionDegraded} {
+		status := condType == hcov1beta1.ConditionProgressing && len(componentConds) == 0
+		if cond := meta.FindStatusCondition(componentConds, condType); cond != nil {
+			status = cond.Status == metav1.ConditionTrue
+		}
+
+		if err := metrics.HcoMetrics.SetHCOMetricOperandCondition(component, condType, status); err != nil {
+			req.Logger.Error(err, "failed to set the operand condition metric", "operand", component, "condition", condType)
+		}
+	}
+}
+
+// setOperandConditions replaces the conditions of the operand in the HyperConverged status with the conditions of the
+// operand CR, sorted by their type. The operands keep the order of their reconciliation.
+func setOperandConditions(req *common.HcoRequest, component string, componentConds []metav1.Condition) {
+	operandConds := make([]hcov1beta1.OperandCondition, 0, len(componentConds))
+	for _, cond := range componentConds {
+		operandConds = append(operandConds, hcov1beta1.OperandCondition{
+			Component: component,
+			Type:      cond.Type,
+			Status:    cond.Status,
+			Reason:    cond.Reason,
+			Message:   cond.Message,
+		})
+	}
+	sort.Slice(operandConds, func(i, j int) bool {
+		return operandConds[i].Type < operandConds[j].Type
+	})
+
+	var updated []hcov1beta1.OperandCondition
+	added := false
+	for _, cond := range req.Instance.Status.OperandConditions {
+		if cond.Component != component {
+			updated = append(updated, cond)
+		} else if !added {
+			updated = append(updated, operandConds...)
+			added = true
+		}
+	}
+	if !added {
+		updated = append(updated, operandConds...)
+	}
+
+	if len(updated) == 0 {
+		updated = nil
+	}
+
+	if !reflect.DeepEqual(updated, req.Instance.Status.OperandConditions) {
+		req.Instance.Status.OperandConditions = updated
+		req.StatusDirty = true
+	}
+}
+
+// getOperandHealth returns the health of the operand according to its conditions, with the reason and the message of the
+// condition that determined it.
+func getOperandHealth(component string, componentConds []metav1.Condition) hcov1beta1.ComponentHealth {
+	if len(componentConds) == 0 {
+		return hcov1beta1.ComponentHealth{
+			Component: component,
+			Health:    common.SystemHealthStatusWarning,
+			Reason:    component + "Conditions",
+			Message:   component + " resource has no conditions",
+		}
+	}
+
+	if cond := meta.FindStatusCondition(componentConds, hcov1beta1.ConditionAvailable); cond != nil && cond.Status == metav1.ConditionFalse {
+		return newOperandHealth(component, common.SystemHealthStatusError, cond)
+	}
+
+	if cond := meta.FindStatusCondition(componentConds, hcov1beta1.ConditionDegraded); cond != nil && cond.Status == metav1.ConditionTrue {
+		return newOperandHealth(component, common.SystemHealthStatusError, cond)
+	}
+
+	if cond := meta.FindStatusCondition(componentConds, hcov1beta1.ConditionProgressing); cond != nil && cond.Status == metav1.ConditionTrue {
+		return newOperandHealth(component, common.SystemHealthStatusWarning, cond)
+	}
+
+	return hcov1beta1.ComponentHealth{Component: component, Health: common.SystemHealthStatusHealthy}
+}
+
+func newOperandHealth(component, health string, cond *metav1.Condition) hcov1beta1.ComponentHealth {
+	return hcov1beta1.ComponentHealth{
+		Component: component,
+		Health:    health,
+		Reason:    cond.Reason,
+		Message:   cond.Message,
+	}
+}
+
+// setOperandHealth updates the health of the operand in the HyperConverged status, and in the component health status
+// metric.
+func setOperandHealth(req *common.HcoRequest, health hcov1beta1.ComponentHealth) {
+	if err := metrics.HcoMetrics.SetHCOMetricComponentHealthStatus(health.Component, common.GetNumericalHealthStatus(health.Health)); err != nil {
+		req.Logger.Error(err, "failed to set the component health status metric", "operand", health.Component)
+	}
+
+	for i := range req.Instance.Status.ComponentsHealth {
+		if req.Instance.Status.ComponentsHealth[i].Component == health.Component {
+			if req.Instance.Status.ComponentsHealth[i] != health {
+				req.Instance.Status.ComponentsHealth[i] = health
+				req.StatusDirty = true
+			}
+			return
+		}
+	}
+
+	req.Instance.Status.ComponentsHealth = append(req.Instance.Status.ComponentsHealth, health)
+	req.StatusDirty = true
+}
+
+// removeOperandHealth removes the health of an operand that is not deployed, from the HyperConverged status
+func removeOperandHealth(req *common.HcoRequest, component string) {
+	for i := range req.Instance.Status.ComponentsHealth {
+		if req.Instance.Status.ComponentsHealth[i].Component == component {
+			req.Instance.Status.ComponentsHealth = append(req.Instance.Status.ComponentsHealth[:i], req.Instance.Status.ComponentsHealth[i+1:]...)
+			req.StatusDirty = true
+			return
+		}
+	}
+}
+
+// getComponentPhase returns the deployment phase of the operand. During an upgrade, the operand is ready only if it
+// already reports the expected version.
+func getComponentPhase(req *common.HcoRequest, componentConds []metav1.Condition, isReady, versionUpdated bool) hcov1beta1.ComponentPhase {
+	switch {
+	case meta.IsStatusConditionTrue(componentConds, hcov1beta1.ConditionDegraded):
+		return hcov1beta1.ComponentPhaseDegraded
+	case isReady && (!req.UpgradeMode || versionUpdated):
+		return hcov1beta1.ComponentPhaseReady
+	case req.UpgradeMode:
+		return hcov1beta1.ComponentPhaseUpgrading
+	default:
+		return hcov1beta1.ComponentPhaseDeploying
+	}
+}
+
+// setComponentPhase updates the phase of the operand in the progress of the HyperConverged status
+func setComponentPhase(req *common.HcoRequest, component string, phase hcov1beta1.ComponentPhase) {
+	progress := req.Instance.Status.Progress
+	if progress == nil {
+		progress = &hcov1beta1.DeploymentProgress{}
+	} else {
+		progress = progress.DeepCopy()
+	}
+
+	found := false
+	for i := range progress.Components {
+		if progress.Components[i].Name == component {
+			progress.Components[i].Phase = phase
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		progress.Components = append(progress.Components, hcov1beta1.ComponentProgress{Name: component, Phase: phase})
+	}
+
+	updateProgress(req, progress)
+}
+
+// removeComponentPhase removes an operand that is not deployed, from the progress of the HyperConverged status
+func removeComponentPhase(req *common.HcoRequest, component string) {
+	if req.Instance.Status.Progress == nil {
+		return
+	}
+
+	progress := req.Instance.Status.Progress.DeepCopy()
+	for i := range progress.Components {
+		if progress.Components[i].Name == component {
+			progress.Components = append(progress.Components[:i], progress.Components[i+1:]...)
+			updateProgress(req, progress)
+			return
+		}
+	}
+}
+
+func updateProgress(req *common.HcoRequest, progress *hcov1beta1.DeploymentProgress) {
+	progress.ReadyComponents = 0
+	for _, comp := range progress.Components {
+		if comp.Phase == hcov1beta1.ComponentPhaseReady {
+			progress.ReadyComponents++
+		}
+	}
+	progress.TotalComponents = len(progress.Components)
+	progress.Message = fmt.Sprintf("%d/%d components ready", progress.ReadyComponents, progress.TotalComponents)
+
+	if !reflect.DeepEqual(progress, req.Instance.Status.Progress) {
+		req.Instance.Status.Progress = progress
+		req.StatusDirty = true
+	}
+}
+
 func setConditionsByOperandConditions(req *common.HcoRequest, component string, componentConds []metav1.Condition) bool {
 	isReady := true
 	foundAvailableCond := false
@@ -307,6 +548,12 @@ func handleOperandProgressingCond(req *common.HcoRequest, component string, cond
 			Message:            fmt.Sprintf("%s is progressing: %v", component, condition.Message),
 			ObservedGeneration: req.Instance.Generation,
 		})
+		// a not upgradeable operand has a more accurate reason than a progressing one
+		req.SetUpgradeBlocker(common.UpgradeBlocker{
+			Component: component,
+			Reason:    fmt.Sprintf("%sProgressing", component),
+			Message:   fmt.Sprintf("%s is progressing: %v", component, condition.Message),
+		}, false)
 
 		return false
 	}
@@ -315,8 +562,7 @@ func handleOperandProgressingCond(req *common.HcoRequest, component string, cond
 
 func handleOperandUpgradeableCond(req *common.HcoRequest, component string, condition metav1.Condition) {
 	if condition.Status == metav1.ConditionFalse {
-		req.Upgradeable = false
-		req.Logger.Info(fmt.Sprintf("%s is 'Progressing'", component))
+		req.Logger.Info(fmt.Sprintf("%s is not 'Upgradeable'", component))
 		req.Conditions.SetStatusCondition(metav1.Condition{
 			Type:               hcov1beta1.ConditionUpgradeable,
 			Status:             metav1.ConditionFalse,
@@ -324,6 +570,11 @@ func handleOperandUpgradeableCond(req *common.HcoRequest, component string, cond
 			Message:            fmt.Sprintf("%s is not upgradeable: %v", component, condition.Message),
 			ObservedGeneration: req.Instance.Generation,
 		})
+		req.SetUpgradeBlocker(common.UpgradeBlocker{
+			Component: component,
+			Reason:    fmt.Sprintf("%sNotUpgradeable", component),
+			Message:   fmt.Sprintf("%s is not upgradeable: %v", component, condition.Message),
+		}, true)
 	}
 }
 
@@ -361,6 +612,11 @@ func getConditionsForNewCr(req *common.HcoRequest, component string) {
 		Message:            message,
 		ObservedGeneration: req.Instance.Generation,
 	})
+	req.SetUpgradeBlocker(common.UpgradeBlocker{
+		Component: component,
+		Reason:    reason,
+		Message:   message,
+	}, false)
 }
 
 func componentNotAvailable(req *common.HcoRequest, component string, msg string) {
@@ -379,6 +635,56 @@ func checkComponentVersion(versionEnvName, actualVersion string) bool {
 	return expectedVersion != "" && expectedVersion == actualVersion
 }
 
+// setOperandVersion updates the version of the operand in the HyperConverged status. An operand that did not report its
+// version yet, is removed from the list.
+func setOperandVersion(req *common.HcoRequest, component, version string) {
+	versions := req.Instance.Status.OperandVersions
+	for i := range versions {
+		if versions[i].Name != component {
+			continue
+		}
+
+		if version == "" {
+			req.Instance.Status.OperandVersions = append(versions[:i], versions[i+1:]...)
+			req.StatusDirty = true
+		} else if versions[i].Version != version {
+			versions[i].Version = version
+			req.StatusDirty = true
+		}
+		return
+	}
+
+	if version != "" {
+		req.Instance.Status.OperandVersions = append(versions, hcov1beta1.Version{Name: component, Version: version})
+		req.StatusDirty = true
+	}
+}
+
+// setOperandObservedGeneration updates the HyperConverged generation that was last rendered into the operand, in the
+// HyperConverged status. A zero generation removes the operand from the list.
+func setOperandObservedGeneration(req *common.HcoRequest, component string, generation int64) {
+	generations := req.Instance.Status.OperandObservedGenerations
+	for i := range generations {
+		if generations[i].Name != component {
+			continue
+		}
+
+		if generation == 0 {
+			req.Instance.Status.OperandObservedGenerations = append(generations[:i], generations[i+1:]...)
+			req.StatusDirty = true
+		} else if generations[i].ObservedGeneration != generation {
+			generations[i].ObservedGeneration = generation
+			req.StatusDirty = true
+		}
+		return
+	}
+
+	if generation != 0 {
+		req.Instance.Status.OperandObservedGenerations = append(generations, hcov1beta1.OperandObservedGeneration{Name: component, ObservedGeneration: generation})
+		req.StatusDirty = true
+	}
+}
+
 func getNamespace(defaultNamespace string, opts []string) string {
 	if len(opts) > 0 {
 		return opts[0]
@@ -421,7 +727,11 @@ func applyAnnotationPatch(obj runtime.Object, annotation string) error {
 	if err != nil {
 		return err
 	}
-	return json.Unmarshal(patchedBytes, obj)
+
+	// reject unknown fields; e.g. a typo in the patch path. Otherwise, they are silently dropped.
+	decoder := json.NewDecoder(bytes.NewReader(patchedBytes))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(obj)
 }
 
 func applyPatchToSpec(hc *hcov1beta1.HyperConverged, annotationName string, obj runtime.Object) error {
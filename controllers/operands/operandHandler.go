@@ -49,25 +49,35 @@ type OperandHandler struct {
 	eventEmitter hcoutil.EventEmitter
 }
 
-func NewOperandHandler(client client.Client, scheme *runtime.Scheme, ci hcoutil.ClusterInfo, eventEmitter hcoutil.EventEmitter) *OperandHandler {
+func NewOperandHandler(client client.Client, apiReader client.Reader, scheme *runtime.Scheme, ci hcoutil.ClusterInfo, eventEmitter hcoutil.EventEmitter) *OperandHandler {
 	operands := []Operand{
 		(*genericOperand)(newKvPriorityClassHandler(client, scheme)),
-		(*genericOperand)(newKubevirtHandler(client, scheme)),
+		(*genericOperand)(newKubevirtHandlerWithEventEmitter(client, scheme, eventEmitter)),
 		(*genericOperand)(newCdiHandler(client, scheme)),
 		(*genericOperand)(newCnaHandler(client, scheme)),
 		newMtqHandler(client, scheme),
+		newTenantQuotaHandler(client, scheme, eventEmitter),
+		newMigrationPolicyHandler(client, scheme),
 	}
 
 	if ci.IsOpenshift() {
 		operands = append(operands, []Operand{
-			(*genericOperand)(newSspHandler(client, scheme)),
+			newCommonTemplatesNamespaceHandler(client, scheme),
+			(*genericOperand)(newSspHandler(client, apiReader, scheme)),
+			newTrustedCABundleHandler(client, apiReader, scheme, eventEmitter),
+		}...)
+	}
+
+	// the console integrations can't be deployed if the console is not installed; e.g. on a hosted control plane
+	if ci.IsConsoleAvailable() {
+		operands = append(operands, []Operand{
 			(*genericOperand)(newCliDownloadHandler(client, scheme)),
 			(*genericOperand)(newCliDownloadsRouteHandler(client, scheme)),
 			(*genericOperand)(newServiceHandler(client, scheme, NewCliDownloadsService)),
 		}...)
 	}
 
-	if ci.IsOpenshift() && ci.IsConsolePluginImageProvided() {
+	if ci.IsConsoleAvailable() && ci.IsConsolePluginImageProvided() {
 		operands = append(operands, newConsoleHandler(client))
 		operands = append(operands, (*genericOperand)(newServiceHandler(client, scheme, NewKvUIPluginSvc)))
 		operands = append(operands, (*genericOperand)(newServiceHandler(client, scheme, NewKvUIProxySvc)))
@@ -89,16 +99,22 @@ func NewOperandHandler(client client.Client, scheme *runtime.Scheme, ci hcoutil.
 // Initial operations that need to read/write from the cluster can only be done when the client is already working.
 func (h *OperandHandler) FirstUseInitiation(scheme *runtime.Scheme, ci hcoutil.ClusterInfo, hc *hcov1beta1.HyperConverged) {
 	h.objects = make([]client.Object, 0)
-	if ci.IsOpenshift() {
+	if ci.IsConsoleAvailable() {
 		h.addOperands(scheme, hc, getQuickStartHandlers)
-		h.addOperands(scheme, hc, getDashboardHandlers)
+	}
+
+	if ci.IsOpenshift() {
 		h.addOperands(scheme, hc, getImageStreamHandlers)
 		h.addOperands(scheme, hc, newVirtioWinCmHandler)
 		h.addOperands(scheme, hc, newVirtioWinCmReaderRoleHandler)
 		h.addOperands(scheme, hc, newVirtioWinCmReaderRoleBindingHandler)
 	}
 
-	if ci.IsOpenshift() && ci.IsConsolePluginImageProvided() {
+	if shouldDeployDashboards(ci) {
+		h.addOperands(scheme, hc, getDashboardHandlers)
+	}
+
+	if ci.IsConsoleAvailable() && ci.IsConsolePluginImageProvided() {
 		h.addOperands(scheme, hc, newKvUIPluginDeploymentHandler)
 		h.addOperands(scheme, hc, newKvUIProxyDeploymentHandler)
 		h.addOperands(scheme, hc, newKvUINginxCMHandler)
@@ -144,7 +160,10 @@ func (h *OperandHandler) addOperands(scheme *runtime.Scheme, hc *hcov1beta1.Hype
 
 func (h *OperandHandler) Ensure(req *common.HcoRequest) error {
 	for _, handler := range h.operands {
+		start := time.Now()
 		res := handler.ensure(req)
+		observeEnsureDuration(req, res, time.Since(start))
+
 		if res.Err != nil {
 			req.Logger.Error(res.Err, "failed to ensure an operand")
 
@@ -167,19 +186,41 @@ func (h *OperandHandler) Ensure(req *common.HcoRequest) error {
 			h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Killing", fmt.Sprintf("Removed %s %s", res.Type, res.Name))
 		}
 
+		if req.ForceResync && (res.Created || res.Updated) {
+			req.Rewritten = append(req.Rewritten, fmt.Sprintf("%s %s", res.Type, res.Name))
+		}
+
 		req.ComponentUpgradeInProgress = req.ComponentUpgradeInProgress && res.UpgradeDone
 	}
 	return nil
 
 }
 
+func observeEnsureDuration(req *common.HcoRequest, res *EnsureResult, duration time.Duration) {
+	outcome := metrics.EnsureOutcomeUnchanged
+	switch {
+	case res.Err != nil:
+		outcome = metrics.EnsureOutcomeError
+	case res.Created:
+		outcome = metrics.EnsureOutcomeCreated
+	case res.Updated:
+		outcome = metrics.EnsureOutcomeUpdated
+	case res.Deleted:
+		outcome = metrics.EnsureOutcomeDeleted
+	}
+
+	if err := metrics.HcoMetrics.ObserveHCOMetricOperandEnsureDuration(res.Type, outcome, duration); err != nil {
+		req.Logger.Error(err, "couldn't update the operand ensure duration metric")
+	}
+}
+
 func (h *OperandHandler) handleUpdatedOperand(req *common.HcoRequest, res *EnsureResult) {
 	if !res.Overwritten {
 		h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Updated", fmt.Sprintf("Updated %s %s", res.Type, res.Name))
 	} else {
 		h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, "Overwritten", fmt.Sprintf("Overwritten %s %s", res.Type, res.Name))
 		if !req.UpgradeMode {
-			err := metrics.HcoMetrics.IncOverwrittenModifications(res.Type, res.Name)
+			err := metrics.HcoMetrics.IncOverwrittenModifications(res.Type, res.Name, res.Namespace, res.FieldManager)
 			if err != nil {
 				req.Logger.Error(err, "couldn't update 'OverwrittenModifications' metric")
 			}
@@ -201,6 +242,24 @@ func (h *OperandHandler) EnsureDeleted(req *common.HcoRequest) error {
 		NewMTQWithNameOnly(req.Instance),
 	}
 
+	for _, policy := range NewMigrationPolicies(req.Instance) {
+		resources = append(resources, policy)
+	}
+
+	// the quotas are in the tenant namespaces, so they are not removed by the garbage collector
+	for _, quota := range NewTenantQuotas(req.Instance) {
+		resources = append(resources, quota)
+	}
+
+	// the copies of the trusted CA bundle are in other namespaces, so they are not removed by the garbage collector
+	trustedCABundles, err := listTrustedCABundleConfigMaps(tCtx, h.client, req.Instance)
+	if err != nil {
+		return err
+	}
+	for _, cm := range trustedCABundles {
+		resources = append(resources, cm)
+	}
+
 	resources = append(resources, h.objects...)
 
 	eg, egCtx := errgroup.WithContext(tCtx)
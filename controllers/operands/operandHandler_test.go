@@ -8,17 +8,22 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
 	consolev1 "github.com/openshift/api/console/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	networkaddonsv1 "github.com/kubevirt/cluster-network-addons-operator/pkg/apis/networkaddonsoperator/v1"
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/metrics"
 	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	mtqv1alpha1 "kubevirt.io/managed-tenant-quota/staging/src/kubevirt.io/managed-tenant-quota-api/pkg/apis/core/v1alpha1"
 )
 
 var _ = Describe("Test operandHandler", func() {
@@ -43,7 +48,7 @@ var _ = Describe("Test operandHandler", func() {
 
 			eventEmitter := commontestutils.NewEventEmitterMock()
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
 
 			req := commontestutils.NewReq(hco)
@@ -133,6 +138,37 @@ var _ = Describe("Test operandHandler", func() {
 			})
 		})
 
+		It("should not create the console integrations if the console is not available", func() {
+			hco := commontestutils.NewHco()
+			ci := noConsoleClusterInfo{}
+			cli := commontestutils.InitClient([]client.Object{hcoNamespace, qsCrd, hco, ci.GetCSV()})
+
+			eventEmitter := commontestutils.NewEventEmitterMock()
+
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
+
+			req := commontestutils.NewReq(hco)
+
+			Expect(handler.Ensure(req)).To(Succeed())
+
+			qsList := consolev1.ConsoleQuickStartList{}
+			Expect(cli.List(req.Ctx, &qsList)).To(Succeed())
+			Expect(qsList.Items).To(BeEmpty())
+
+			cliDownloadList := consolev1.ConsoleCLIDownloadList{}
+			Expect(cli.List(req.Ctx, &cliDownloadList)).To(Succeed())
+			Expect(cliDownloadList.Items).To(BeEmpty())
+
+			pluginList := consolev1.ConsolePluginList{}
+			Expect(cli.List(req.Ctx, &pluginList)).To(Succeed())
+			Expect(pluginList.Items).To(BeEmpty())
+
+			kvList := kubevirtcorev1.KubeVirtList{}
+			Expect(cli.List(req.Ctx, &kvList)).To(Succeed())
+			Expect(kvList.Items).To(HaveLen(1))
+		})
+
 		It("should handle errors on ensure loop", func() {
 			hco := commontestutils.NewHco()
 			cli := commontestutils.InitClient([]client.Object{hcoNamespace, qsCrd, hco})
@@ -140,7 +176,7 @@ var _ = Describe("Test operandHandler", func() {
 			eventEmitter := commontestutils.NewEventEmitterMock()
 			ci := commontestutils.ClusterInfoMock{}
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
 
 			req := commontestutils.NewReq(hco)
@@ -175,6 +211,40 @@ var _ = Describe("Test operandHandler", func() {
 			})
 		})
 
+		It("should record the ensure duration of the operands", func() {
+			hco := commontestutils.NewHco()
+			ci := commontestutils.ClusterInfoMock{}
+			cli := commontestutils.InitClient([]client.Object{hcoNamespace, qsCrd, hco, ci.GetCSV()})
+
+			eventEmitter := commontestutils.NewEventEmitterMock()
+
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
+
+			createdKVs, err := metrics.HcoMetrics.GetHCOMetricOperandEnsureDurationCount("KubeVirt", metrics.EnsureOutcomeCreated)
+			Expect(err).ToNot(HaveOccurred())
+			unchangedKVs, err := metrics.HcoMetrics.GetHCOMetricOperandEnsureDurationCount("KubeVirt", metrics.EnsureOutcomeUnchanged)
+			Expect(err).ToNot(HaveOccurred())
+			failedCDIs, err := metrics.HcoMetrics.GetHCOMetricOperandEnsureDurationCount("CDI", metrics.EnsureOutcomeError)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(handler.Ensure(commontestutils.NewReq(hco))).To(Succeed())
+			Expect(metrics.HcoMetrics.GetHCOMetricOperandEnsureDurationCount("KubeVirt", metrics.EnsureOutcomeCreated)).To(Equal(createdKVs + 1))
+
+			Expect(handler.Ensure(commontestutils.NewReq(hco))).To(Succeed())
+			Expect(metrics.HcoMetrics.GetHCOMetricOperandEnsureDurationCount("KubeVirt", metrics.EnsureOutcomeUnchanged)).To(Equal(unchangedKVs + 1))
+
+			cli.InitiateGetErrors(func(key client.ObjectKey) error {
+				if key.Name == "cdi-kubevirt-hyperconverged" {
+					return fmt.Errorf("fake get CDI error")
+				}
+				return nil
+			})
+
+			Expect(handler.Ensure(commontestutils.NewReq(hco))).ToNot(Succeed())
+			Expect(metrics.HcoMetrics.GetHCOMetricOperandEnsureDurationCount("CDI", metrics.EnsureOutcomeError)).To(Equal(failedCDIs + 1))
+		})
+
 		It("make sure the all objects are deleted", func() {
 			hco := commontestutils.NewHco()
 			ci := commontestutils.ClusterInfoMock{}
@@ -182,7 +252,7 @@ var _ = Describe("Test operandHandler", func() {
 
 			eventEmitter := commontestutils.NewEventEmitterMock()
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
 
 			req := commontestutils.NewReq(hco)
@@ -263,6 +333,120 @@ var _ = Describe("Test operandHandler", func() {
 			})
 		})
 
+		It("should delete the MigrationPolicies", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.MigrationPolicies = []hcov1beta1.MigrationPolicyTemplate{
+				{
+					Name: "large-vms",
+					Spec: migrationsv1alpha1.MigrationPolicySpec{
+						AllowPostCopy: ptr.To(true),
+					},
+				},
+			}
+			ci := commontestutils.ClusterInfoMock{}
+			cli := commontestutils.InitClient([]client.Object{hcoNamespace, qsCrd, hco, ci.GetCSV()})
+
+			eventEmitter := commontestutils.NewEventEmitterMock()
+
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
+
+			req := commontestutils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(Succeed())
+
+			policies := migrationsv1alpha1.MigrationPolicyList{}
+			Expect(cli.List(req.Ctx, &policies)).To(Succeed())
+			Expect(policies.Items).To(HaveLen(1))
+
+			Expect(handler.EnsureDeleted(req)).To(Succeed())
+
+			Expect(cli.List(req.Ctx, &policies)).To(Succeed())
+			Expect(policies.Items).To(BeEmpty())
+		})
+
+		It("should delete the tenant quotas", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.FeatureGates.EnableManagedTenantQuota = ptr.To(true)
+			hco.Spec.TenantQuotaTemplates = []hcov1beta1.TenantQuotaTemplate{
+				{
+					Name:       "default-quota",
+					Namespaces: []string{"ns1", "ns2"},
+				},
+			}
+			ci := commontestutils.ClusterInfoMock{}
+			objects := []client.Object{hcoNamespace, qsCrd, hco, ci.GetCSV()}
+			for _, quota := range NewTenantQuotas(hco) {
+				objects = append(objects, quota)
+			}
+			cli := commontestutils.InitClient(objects)
+
+			eventEmitter := commontestutils.NewEventEmitterMock()
+
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
+
+			req := commontestutils.NewReq(hco)
+
+			quotas := mtqv1alpha1.VirtualMachineMigrationResourceQuotaList{}
+			Expect(cli.List(req.Ctx, &quotas)).To(Succeed())
+			Expect(quotas.Items).To(HaveLen(2))
+
+			Expect(handler.EnsureDeleted(req)).To(Succeed())
+
+			Expect(cli.List(req.Ctx, &quotas)).To(Succeed())
+			Expect(quotas.Items).To(BeEmpty())
+		})
+
+		It("should delete the copies of the trusted CA bundle", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.TrustedCABundle = ptr.To("my-ca-bundle")
+			hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "custom-image", Namespace: "custom-ns"},
+					Spec: &cdiv1beta1.DataImportCronSpec{
+						Schedule: "1 */12 * * *",
+						Template: cdiv1beta1.DataVolume{
+							Spec: cdiv1beta1.DataVolumeSpec{
+								Source: &cdiv1beta1.DataVolumeSource{
+									Registry: &cdiv1beta1.DataVolumeSourceRegistry{
+										URL: ptr.To("docker://mirror.example.com/containerdisks/fedora:latest"),
+									},
+								},
+							},
+						},
+						ManagedDataSource: "custom-image",
+					},
+				},
+			}
+			source := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-ca-bundle", Namespace: hco.Namespace},
+				Data:       map[string]string{"ca.crt": "ca-bundle"},
+			}
+			ci := commontestutils.ClusterInfoMock{}
+			cli := commontestutils.InitClient([]client.Object{hcoNamespace, qsCrd, hco, ci.GetCSV(), source})
+
+			eventEmitter := commontestutils.NewEventEmitterMock()
+
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
+
+			req := commontestutils.NewReq(hco)
+			Expect(handler.Ensure(req)).To(Succeed())
+
+			copies, err := listTrustedCABundleConfigMaps(req.Ctx, cli, hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(copies).ToNot(BeEmpty())
+
+			Expect(handler.EnsureDeleted(req)).To(Succeed())
+
+			copies, err = listTrustedCABundleConfigMaps(req.Ctx, cli, hco)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(copies).To(BeEmpty())
+
+			By("not deleting the source ConfigMap")
+			Expect(cli.Get(req.Ctx, client.ObjectKeyFromObject(source), &corev1.ConfigMap{})).To(Succeed())
+		})
+
 		It("delete KV error handling", func() {
 			hco := commontestutils.NewHco()
 			ci := commontestutils.ClusterInfoMock{}
@@ -270,7 +454,7 @@ var _ = Describe("Test operandHandler", func() {
 
 			eventEmitter := commontestutils.NewEventEmitterMock()
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
 
 			req := commontestutils.NewReq(hco)
@@ -319,7 +503,7 @@ var _ = Describe("Test operandHandler", func() {
 
 			eventEmitter := commontestutils.NewEventEmitterMock()
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
 
 			req := commontestutils.NewReq(hco)
@@ -370,7 +554,7 @@ var _ = Describe("Test operandHandler", func() {
 			fakeError := fmt.Errorf("fake CNA deletion error")
 			eventEmitter := commontestutils.NewEventEmitterMock()
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
 
 			req := commontestutils.NewReq(hco)
@@ -419,7 +603,7 @@ var _ = Describe("Test operandHandler", func() {
 
 			eventEmitter := commontestutils.NewEventEmitterMock()
 
-			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler := NewOperandHandler(cli, cli, commontestutils.GetScheme(), ci, eventEmitter)
 			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
 
 			req := commontestutils.NewReq(hco)
@@ -457,3 +641,16 @@ var _ = Describe("Test operandHandler", func() {
 		})
 	})
 })
+
+// noConsoleClusterInfo simulates an OpenShift cluster without the console; e.g. a hosted control plane cluster
+type noConsoleClusterInfo struct {
+	commontestutils.ClusterInfoMock
+}
+
+func (noConsoleClusterInfo) GetControlPlaneTopology() openshiftconfigv1.TopologyMode {
+	return openshiftconfigv1.ExternalTopologyMode
+}
+
+func (noConsoleClusterInfo) IsConsoleAvailable() bool {
+	return false
+}
@@ -11,7 +11,10 @@ import (
 	"k8s.io/client-go/tools/reference"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/metrics"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -75,6 +78,25 @@ var _ = Describe("Test operator.go", func() {
 			fmt.Fprintf(GinkgoWriter, "Expected error: %v\n", err)
 		})
 
+		It("Should fail for adding an unknown field", func() {
+			obj := &cdiv1beta1.CDI{
+				Spec: cdiv1beta1.CDISpec{
+					Config: &cdiv1beta1.CDIConfigSpec{},
+				},
+			}
+
+			err := applyAnnotationPatch(obj, `[{"op": "add", "path": "/spec/config/overhead", "value": {"global": "55"}}]`)
+			Expect(err).To(MatchError(ContainSubstring(`unknown field "overhead"`)))
+		})
+
+		It("Should fail for a wrong value type", func() {
+			obj := &cdiv1beta1.CDI{}
+
+			err := applyAnnotationPatch(obj, `[{"op": "add", "path": "/spec/config", "value": {"featureGates": "fg1"}}]`)
+			Expect(err).To(HaveOccurred())
+			fmt.Fprintf(GinkgoWriter, "Expected error: %v\n", err)
+		})
+
 		It("Should apply annotation if everything is corrct", func() {
 			obj := &cdiv1beta1.CDI{
 				Spec: cdiv1beta1.CDISpec{
@@ -245,4 +267,290 @@ var _ = Describe("Test operator.go", func() {
 
 	})
 
+	Context("Test the operand condition metrics", func() {
+		expectOperandCondition := func(operand, condType string, expected float64) {
+			value, err := metrics.HcoMetrics.GetHCOMetricOperandCondition(operand, condType)
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			ExpectWithOffset(1, value).To(Equal(expected))
+		}
+
+		It("should report a new operand as progressing", func() {
+			req := commontestutils.NewReq(commontestutils.NewHco())
+
+			handleComponentConditions(req, "TestNewOperand", nil)
+
+			expectOperandCondition("TestNewOperand", hcov1beta1.ConditionAvailable, metrics.OperandConditionFalse)
+			expectOperandCondition("TestNewOperand", hcov1beta1.ConditionProgressing, metrics.OperandConditionTrue)
+			expectOperandCondition("TestNewOperand", hcov1beta1.ConditionDegraded, metrics.OperandConditionFalse)
+		})
+
+		It("should report the operand conditions", func() {
+			req := commontestutils.NewReq(commontestutils.NewHco())
+
+			handleComponentConditions(req, "TestOperand", []metav1.Condition{
+				{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionTrue},
+				{Type: hcov1beta1.ConditionProgressing, Status: metav1.ConditionFalse},
+				{Type: hcov1beta1.ConditionDegraded, Status: metav1.ConditionFalse},
+			})
+
+			expectOperandCondition("TestOperand", hcov1beta1.ConditionAvailable, metrics.OperandConditionTrue)
+			expectOperandCondition("TestOperand", hcov1beta1.ConditionProgressing, metrics.OperandConditionFalse)
+			expectOperandCondition("TestOperand", hcov1beta1.ConditionDegraded, metrics.OperandConditionFalse)
+
+			handleComponentConditions(req, "TestOperand", []metav1.Condition{
+				{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionFalse},
+				{Type: hcov1beta1.ConditionProgressing, Status: metav1.ConditionTrue},
+				{Type: hcov1beta1.ConditionDegraded, Status: metav1.ConditionTrue},
+			})
+
+			expectOperandCondition("TestOperand", hcov1beta1.ConditionAvailable, metrics.OperandConditionFalse)
+			expectOperandCondition("TestOperand", hcov1beta1.ConditionProgressing, metrics.OperandConditionTrue)
+			expectOperandCondition("TestOperand", hcov1beta1.ConditionDegraded, metrics.OperandConditionTrue)
+		})
+
+		It("should report a missing condition as false", func() {
+			req := commontestutils.NewReq(commontestutils.NewHco())
+
+			handleComponentConditions(req, "TestPartialOperand", []metav1.Condition{
+				{Type: hcov1beta1.ConditionDegraded, Status: metav1.ConditionFalse},
+			})
+
+			expectOperandCondition("TestPartialOperand", hcov1beta1.ConditionAvailable, metrics.OperandConditionFalse)
+			expectOperandCondition("TestPartialOperand", hcov1beta1.ConditionProgressing, metrics.OperandConditionFalse)
+			expectOperandCondition("TestPartialOperand", hcov1beta1.ConditionDegraded, metrics.OperandConditionFalse)
+		})
+	})
+
+	Context("Test setOperandConditions", func() {
+		It("should add, update and remove the operand conditions", func() {
+			req := commontestutils.NewReq(commontestutils.NewHco())
+
+			By("adding the conditions of the operands, sorted by type")
+			handleComponentConditions(req, "KubeVirt", []metav1.Condition{
+				{Type: hcov1beta1.ConditionProgressing, Status: metav1.ConditionFalse, Reason: "AllComponentsReady"},
+				{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionTrue, Reason: "AllComponentsReady"},
+			})
+			handleComponentConditions(req, "CDI", []metav1.Condition{
+				{Type: hcov1beta1.ConditionDegraded, Status: metav1.ConditionTrue, Reason: "Failed", Message: "failed to deploy"},
+			})
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(req.Instance.Status.OperandConditions).To(Equal([]hcov1beta1.OperandCondition{
+				{Component: "KubeVirt", Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionTrue, Reason: "AllComponentsReady"},
+				{Component: "KubeVirt", Type: hcov1beta1.ConditionProgressing, Status: metav1.ConditionFalse, Reason: "AllComponentsReady"},
+				{Component: "CDI", Type: hcov1beta1.ConditionDegraded, Status: metav1.ConditionTrue, Reason: "Failed", Message: "failed to deploy"},
+			}))
+
+			By("not modifying the status if the conditions were not changed")
+			req.StatusDirty = false
+			setOperandConditions(req, "KubeVirt", []metav1.Condition{
+				{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionTrue, Reason: "AllComponentsReady"},
+				{Type: hcov1beta1.ConditionProgressing, Status: metav1.ConditionFalse, Reason: "AllComponentsReady"},
+			})
+			Expect(req.StatusDirty).To(BeFalse())
+
+			By("replacing the conditions of an operand, keeping its place")
+			setOperandConditions(req, "KubeVirt", []metav1.Condition{
+				{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionFalse, Reason: "Deploying"},
+			})
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(req.Instance.Status.OperandConditions).To(Equal([]hcov1beta1.OperandCondition{
+				{Component: "KubeVirt", Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionFalse, Reason: "Deploying"},
+				{Component: "CDI", Type: hcov1beta1.ConditionDegraded, Status: metav1.ConditionTrue, Reason: "Failed", Message: "failed to deploy"},
+			}))
+
+			By("removing the conditions of an operand with no conditions")
+			handleComponentConditions(req, "CDI", nil)
+			Expect(req.Instance.Status.OperandConditions).To(Equal([]hcov1beta1.OperandCondition{
+				{Component: "KubeVirt", Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionFalse, Reason: "Deploying"},
+			}))
+
+			setOperandConditions(req, "KubeVirt", nil)
+			Expect(req.Instance.Status.OperandConditions).To(BeNil())
+		})
+	})
+
+	Context("Test the operand health", func() {
+		DescribeTable("should get the operand health from its conditions", func(conds []metav1.Condition, expected hcov1beta1.ComponentHealth) {
+			Expect(getOperandHealth("CDI", conds)).To(Equal(expected))
+		},
+			Entry("no conditions", nil,
+				hcov1beta1.ComponentHealth{Component: "CDI", Health: common.SystemHealthStatusWarning, Reason: "CDIConditions", Message: "CDI resource has no conditions"}),
+			Entry("available", []metav1.Condition{
+				{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionTrue, Reason: "Ready"},
+				{Type: hcov1beta1.ConditionProgressing, Status: metav1.ConditionFalse},
+				{Type: hcov1beta1.ConditionDegraded, Status: metav1.ConditionFalse},
+			}, hcov1beta1.ComponentHealth{Component: "CDI", Health: common.SystemHealthStatusHealthy}),
+			Entry("progressing", []metav1.Condition{
+				{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionTrue},
+				{Type: hcov1beta1.ConditionProgressing, Status: metav1.ConditionTrue, Reason: "Upgrading", Message: "upgrading CDI"},
+			}, hcov1beta1.ComponentHealth{Component: "CDI", Health: common.SystemHealthStatusWarning, Reason: "Upgrading", Message: "upgrading CDI"}),
+			Entry("degraded", []metav1.Condition{
+				{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionTrue},
+				{Type: hcov1beta1.ConditionProgressing, Status: metav1.ConditionTrue, Reason: "Upgrading"},
+				{Type: hcov1beta1.ConditionDegraded, Status: metav1.ConditionTrue, Reason: "Failed", Message: "failed to deploy"},
+			}, hcov1beta1.ComponentHealth{Component: "CDI", Health: common.SystemHealthStatusError, Reason: "Failed", Message: "failed to deploy"}),
+			Entry("not available", []metav1.Condition{
+				{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionFalse, Reason: "NotReady", Message: "not ready"},
+				{Type: hcov1beta1.ConditionDegraded, Status: metav1.ConditionTrue, Reason: "Failed"},
+			}, hcov1beta1.ComponentHealth{Component: "CDI", Health: common.SystemHealthStatusError, Reason: "NotReady", Message: "not ready"}),
+		)
+
+		It("should report the operand health in the status and in the metric", func() {
+			req := commontestutils.NewReq(commontestutils.NewHco())
+
+			handleComponentConditions(req, "TestHealthOperand", []metav1.Condition{
+				{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionTrue},
+			})
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(req.Instance.Status.ComponentsHealth).To(Equal([]hcov1beta1.ComponentHealth{
+				{Component: "TestHealthOperand", Health: common.SystemHealthStatusHealthy},
+			}))
+			value, err := metrics.HcoMetrics.GetHCOMetricComponentHealthStatus("TestHealthOperand")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(metrics.SystemHealthStatusHealthy))
+
+			By("not modifying the status if the health was not changed")
+			req.StatusDirty = false
+			handleComponentConditions(req, "TestHealthOperand", []metav1.Condition{
+				{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionTrue},
+			})
+			Expect(req.StatusDirty).To(BeFalse())
+
+			By("updating the health of the operand")
+			handleComponentConditions(req, "TestHealthOperand", []metav1.Condition{
+				{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionFalse, Reason: "NotReady"},
+			})
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(req.Instance.Status.ComponentsHealth).To(Equal([]hcov1beta1.ComponentHealth{
+				{Component: "TestHealthOperand", Health: common.SystemHealthStatusError, Reason: "NotReady"},
+			}))
+			value, err = metrics.HcoMetrics.GetHCOMetricComponentHealthStatus("TestHealthOperand")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(metrics.SystemHealthStatusError))
+
+			By("removing the health of an operand")
+			removeOperandHealth(req, "TestHealthOperand")
+			Expect(req.Instance.Status.ComponentsHealth).To(BeEmpty())
+		})
+	})
+
+	Context("Test the deployment progress", func() {
+		availableConds := []metav1.Condition{
+			{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionTrue},
+			{Type: hcov1beta1.ConditionProgressing, Status: metav1.ConditionFalse},
+			{Type: hcov1beta1.ConditionDegraded, Status: metav1.ConditionFalse},
+		}
+
+		degradedConds := []metav1.Condition{
+			{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionTrue},
+			{Type: hcov1beta1.ConditionProgressing, Status: metav1.ConditionFalse},
+			{Type: hcov1beta1.ConditionDegraded, Status: metav1.ConditionTrue},
+		}
+
+		DescribeTable("should get the phase of the operand", func(upgradeMode bool, conds []metav1.Condition, isReady, versionUpdated bool, expected hcov1beta1.ComponentPhase) {
+			req := commontestutils.NewReq(commontestutils.NewHco())
+			req.SetUpgradeMode(upgradeMode)
+
+			Expect(getComponentPhase(req, conds, isReady, versionUpdated)).To(Equal(expected))
+		},
+			Entry("not ready", false, nil, false, true, hcov1beta1.ComponentPhaseDeploying),
+			Entry("ready", false, availableConds, true, true, hcov1beta1.ComponentPhaseReady),
+			Entry("ready, with an unexpected version, not upgrading", false, availableConds, true, false, hcov1beta1.ComponentPhaseReady),
+			Entry("degraded", false, degradedConds, false, true, hcov1beta1.ComponentPhaseDegraded),
+			Entry("upgrading, not ready", true, nil, false, false, hcov1beta1.ComponentPhaseUpgrading),
+			Entry("upgrading, ready with the old version", true, availableConds, true, false, hcov1beta1.ComponentPhaseUpgrading),
+			Entry("upgrading, ready with the new version", true, availableConds, true, true, hcov1beta1.ComponentPhaseReady),
+			Entry("upgrading, degraded", true, degradedConds, false, false, hcov1beta1.ComponentPhaseDegraded),
+		)
+
+		It("should count the ready operands", func() {
+			req := commontestutils.NewReq(commontestutils.NewHco())
+
+			setComponentPhase(req, "KubeVirt", hcov1beta1.ComponentPhaseDeploying)
+			setComponentPhase(req, "CDI", hcov1beta1.ComponentPhaseReady)
+			setComponentPhase(req, "MTQ", hcov1beta1.ComponentPhaseReady)
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(req.Instance.Status.Progress).To(Equal(&hcov1beta1.DeploymentProgress{
+				ReadyComponents: 2,
+				TotalComponents: 3,
+				Message:         "2/3 components ready",
+				Components: []hcov1beta1.ComponentProgress{
+					{Name: "KubeVirt", Phase: hcov1beta1.ComponentPhaseDeploying},
+					{Name: "CDI", Phase: hcov1beta1.ComponentPhaseReady},
+					{Name: "MTQ", Phase: hcov1beta1.ComponentPhaseReady},
+				},
+			}))
+
+			By("not modifying the status if the phase was not changed")
+			req.StatusDirty = false
+			setComponentPhase(req, "CDI", hcov1beta1.ComponentPhaseReady)
+			Expect(req.StatusDirty).To(BeFalse())
+
+			By("updating the phase of an operand")
+			setComponentPhase(req, "KubeVirt", hcov1beta1.ComponentPhaseReady)
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(req.Instance.Status.Progress.ReadyComponents).To(Equal(3))
+			Expect(req.Instance.Status.Progress.Message).To(Equal("3/3 components ready"))
+
+			By("removing an operand")
+			req.StatusDirty = false
+			removeComponentPhase(req, "MTQ")
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(req.Instance.Status.Progress).To(Equal(&hcov1beta1.DeploymentProgress{
+				ReadyComponents: 2,
+				TotalComponents: 2,
+				Message:         "2/2 components ready",
+				Components: []hcov1beta1.ComponentProgress{
+					{Name: "KubeVirt", Phase: hcov1beta1.ComponentPhaseReady},
+					{Name: "CDI", Phase: hcov1beta1.ComponentPhaseReady},
+				},
+			}))
+
+			By("not modifying the status when removing a missing operand")
+			req.StatusDirty = false
+			removeComponentPhase(req, "MTQ")
+			Expect(req.StatusDirty).To(BeFalse())
+		})
+	})
+
+	Context("Test setOperandVersion", func() {
+		It("should add, update and remove the operand versions", func() {
+			req := commontestutils.NewReq(commontestutils.NewHco())
+
+			By("not adding an operand with no version")
+			setOperandVersion(req, "CDI", "")
+			Expect(req.Instance.Status.OperandVersions).To(BeEmpty())
+			Expect(req.StatusDirty).To(BeFalse())
+
+			By("adding the operands by the order of their reconciliation")
+			setOperandVersion(req, "KubeVirt", "v1.1.0")
+			setOperandVersion(req, "CDI", "v1.58.0")
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(req.Instance.Status.OperandVersions).To(Equal([]hcov1beta1.Version{
+				{Name: "KubeVirt", Version: "v1.1.0"},
+				{Name: "CDI", Version: "v1.58.0"},
+			}))
+
+			By("not modifying the status if the version was not changed")
+			req.StatusDirty = false
+			setOperandVersion(req, "KubeVirt", "v1.1.0")
+			Expect(req.StatusDirty).To(BeFalse())
+
+			By("updating the version of an operand")
+			setOperandVersion(req, "KubeVirt", "v1.2.0")
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(req.Instance.Status.OperandVersions).To(Equal([]hcov1beta1.Version{
+				{Name: "KubeVirt", Version: "v1.2.0"},
+				{Name: "CDI", Version: "v1.58.0"},
+			}))
+
+			By("removing an operand with no version")
+			req.StatusDirty = false
+			setOperandVersion(req, "KubeVirt", "")
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(req.Instance.Status.OperandVersions).To(Equal([]hcov1beta1.Version{
+				{Name: "CDI", Version: "v1.58.0"},
+			}))
+		})
+	})
 })
@@ -0,0 +1,59 @@
+package operands
+
+import (
+	"strings"
+
+	"k8s.io/utils/strings/slices"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+// PausableOperands are the operand names that are accepted in the pause-reconcile annotation, in a stable order
+var PausableOperands = []string{"kubevirt", "cdi", "cnao", "ssp", "mtq"}
+
+// pausableOperandTypes maps the operand names of the pause-reconcile annotation to the crType of their handlers
+var pausableOperandTypes = map[string]string{
+	"kubevirt": "KubeVirt",
+	"cdi":      "CDI",
+	"cnao":     "NetworkAddonsConfig",
+	"ssp":      "SSP",
+	"mtq":      "MTQ",
+}
+
+// GetPausedOperands parses the pause-reconcile annotation of the HyperConverged CR. It returns the names of the
+// operands that their reconciliation is paused, and the names that are not supported.
+func GetPausedOperands(hc *hcov1beta1.HyperConverged) ([]string, []string) {
+	annotation, ok := hc.Annotations[common.PauseReconcileAnnotationName]
+	if !ok {
+		return nil, nil
+	}
+
+	var paused, unknown []string
+	for _, name := range strings.Split(annotation, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if len(name) == 0 {
+			continue
+		}
+
+		if _, supported := pausableOperandTypes[name]; !supported {
+			unknown = append(unknown, name)
+		} else if !slices.Contains(paused, name) {
+			paused = append(paused, name)
+		}
+	}
+
+	return paused, unknown
+}
+
+// isReconcilePaused returns true if the pause-reconcile annotation pauses the reconciliation of the operand CR
+func isReconcilePaused(hc *hcov1beta1.HyperConverged, crType string) bool {
+	paused, _ := GetPausedOperands(hc)
+	for _, name := range paused {
+		if pausableOperandTypes[name] == crType {
+			return true
+		}
+	}
+
+	return false
+}
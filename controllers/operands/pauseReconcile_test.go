@@ -0,0 +1,131 @@
+package operands
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mtqv1alpha1 "kubevirt.io/managed-tenant-quota/staging/src/kubevirt.io/managed-tenant-quota-api/pkg/apis/core/v1alpha1"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Pause reconcile annotation tests", func() {
+	var (
+		hco *v1beta1.HyperConverged
+		req *common.HcoRequest
+	)
+
+	getClusterInfo := hcoutil.GetClusterInfo
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		req = commontestutils.NewReq(hco)
+		hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+			return &commontestutils.ClusterInfoMock{}
+		}
+	})
+
+	AfterEach(func() {
+		hcoutil.GetClusterInfo = getClusterInfo
+	})
+
+	Context("test GetPausedOperands", func() {
+		It("should return nothing if the annotation is missing", func() {
+			paused, unknown := GetPausedOperands(hco)
+			Expect(paused).To(BeEmpty())
+			Expect(unknown).To(BeEmpty())
+		})
+
+		DescribeTable("should parse the annotation",
+			func(annotation string, expectedPaused, expectedUnknown []string) {
+				hco.Annotations = map[string]string{common.PauseReconcileAnnotationName: annotation}
+
+				paused, unknown := GetPausedOperands(hco)
+				Expect(paused).To(Equal(expectedPaused))
+				Expect(unknown).To(Equal(expectedUnknown))
+			},
+			Entry("empty annotation", "", nil, nil),
+			Entry("single operand", "kubevirt", []string{"kubevirt"}, nil),
+			Entry("list of operands", "kubevirt,cdi,cnao,ssp,mtq", []string{"kubevirt", "cdi", "cnao", "ssp", "mtq"}, nil),
+			Entry("spaces and upper case", " KubeVirt , CDI ", []string{"kubevirt", "cdi"}, nil),
+			Entry("duplicates and empty items", "ssp,,ssp,", []string{"ssp"}, nil),
+			Entry("unknown operands", "kubevirt,hpp,virt", []string{"kubevirt"}, []string{"hpp", "virt"}),
+		)
+	})
+
+	Context("test the reconciliation of a paused operand", func() {
+		var wrongPC = mtqv1alpha1.MTQPriorityClass("wrongPC")
+
+		BeforeEach(func() {
+			hco.Spec.FeatureGates.EnableManagedTenantQuota = ptr.To(true)
+		})
+
+		It("should not update the CR if its reconciliation is paused", func() {
+			hco.Annotations = map[string]string{common.PauseReconcileAnnotationName: "kubevirt,mtq"}
+
+			mtq := NewMTQWithNameOnly(hco)
+			mtq.Spec.PriorityClass = &wrongPC
+
+			cl := commontestutils.InitClient([]client.Object{hco, mtq})
+			handler := newMtqHandler(cl, commontestutils.GetScheme())
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeFalse())
+			Expect(res.Overwritten).To(BeFalse())
+
+			foundMTQ := &mtqv1alpha1.MTQ{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Name: res.Name}, foundMTQ)).To(Succeed())
+			Expect(foundMTQ.Spec.PriorityClass).To(HaveValue(Equal(wrongPC)))
+
+			Expect(req.Instance.Status.RelatedObjects).To(ContainElement(HaveField("Name", foundMTQ.Name)))
+
+			By("not reporting the generation of the HyperConverged CR as observed by the paused operand")
+			Expect(req.Instance.Status.OperandObservedGenerations).To(BeEmpty())
+		})
+
+		It("should update the CR if the reconciliation of another operand is paused", func() {
+			hco.Annotations = map[string]string{common.PauseReconcileAnnotationName: "kubevirt"}
+
+			mtq := NewMTQWithNameOnly(hco)
+			mtq.Spec.PriorityClass = &wrongPC
+
+			cl := commontestutils.InitClient([]client.Object{hco, mtq})
+			handler := newMtqHandler(cl, commontestutils.GetScheme())
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			foundMTQ := &mtqv1alpha1.MTQ{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Name: res.Name}, foundMTQ)).To(Succeed())
+			Expect(foundMTQ.Spec.PriorityClass).To(HaveValue(Equal(mtqv1alpha1.MTQPriorityClass(kvPriorityClass))))
+		})
+
+		It("should still create a missing CR if its reconciliation is paused", func() {
+			hco.Annotations = map[string]string{common.PauseReconcileAnnotationName: "mtq"}
+			hco.Generation = 2
+
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := newMtqHandler(cl, commontestutils.GetScheme())
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+
+			foundMTQ := &mtqv1alpha1.MTQ{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Name: res.Name}, foundMTQ)).To(Succeed())
+
+			Expect(req.Instance.Status.OperandObservedGenerations).To(Equal([]v1beta1.OperandObservedGeneration{
+				{Name: "MTQ", ObservedGeneration: 2},
+			}))
+		})
+	})
+})
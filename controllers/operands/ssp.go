@@ -11,8 +11,11 @@ import (
 	"sort"
 	"strings"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
@@ -34,6 +37,9 @@ const (
 
 	dataImportCronTemplatesFileLocation = "./dataImportCronTemplates"
 
+	// defaultGoldenImagesNamespace is the namespace where SSP creates the DataImportCrons for templates with no namespace
+	defaultGoldenImagesNamespace = "kubevirt-os-images"
+
 	CDIImmediateBindAnnotation = "cdi.kubevirt.io/storage.bind.immediate.requested"
 )
 
@@ -51,17 +57,21 @@ func init() {
 
 type sspHandler genericOperand
 
-func newSspHandler(Client client.Client, Scheme *runtime.Scheme) *sspHandler {
+func newSspHandler(Client client.Client, APIReader client.Reader, Scheme *runtime.Scheme) *sspHandler {
 	return &sspHandler{
 		Client:                 Client,
 		Scheme:                 Scheme,
 		crType:                 "SSP",
 		setControllerReference: false,
-		hooks:                  &sspHooks{},
+		hooks:                  &sspHooks{Client: Client, APIReader: APIReader},
 	}
 }
 
 type sspHooks struct {
+	Client client.Client
+	// APIReader reads the DataImportCrons directly from the API server. The DataImportCron CRD is deployed by CDI, so
+	// it can't be added to the cache of HCO, that is created before CDI is deployed.
+	APIReader    client.Reader
 	cache        *sspv1beta2.SSP
 	dictStatuses []hcov1beta1.DataImportCronTemplateStatus
 }
@@ -82,9 +92,11 @@ func (*sspHooks) getEmptyCr() client.Object { return &sspv1beta2.SSP{} }
 func (*sspHooks) getConditions(cr runtime.Object) []metav1.Condition {
 	return osConditionsToK8s(cr.(*sspv1beta2.SSP).Status.Conditions)
 }
-func (*sspHooks) checkComponentVersion(cr runtime.Object) bool {
-	found := cr.(*sspv1beta2.SSP)
-	return checkComponentVersion(hcoutil.SspVersionEnvV, found.Status.ObservedVersion)
+func (*sspHooks) getObservedVersion(cr runtime.Object) string {
+	return cr.(*sspv1beta2.SSP).Status.ObservedVersion
+}
+func (h *sspHooks) checkComponentVersion(cr runtime.Object) bool {
+	return checkComponentVersion(hcoutil.SspVersionEnvV, h.getObservedVersion(cr))
 }
 func (h *sspHooks) reset() {
 	h.cache = nil
@@ -116,14 +128,70 @@ func (*sspHooks) updateCr(req *common.HcoRequest, client client.Client, exists r
 }
 
 func (h *sspHooks) justBeforeComplete(req *common.HcoRequest) {
-	if !reflect.DeepEqual(h.dictStatuses, req.Instance.Status.DataImportCronTemplates) {
-		req.Instance.Status.DataImportCronTemplates = h.dictStatuses
+	dictStatuses := h.getDictStatusesWithImportStatus(req)
+	if !reflect.DeepEqual(dictStatuses, req.Instance.Status.DataImportCronTemplates) {
+		req.Instance.Status.DataImportCronTemplates = dictStatuses
 		req.StatusDirty = true
 	}
 }
 
+// getDictStatusesWithImportStatus returns a copy of the DataImportCronTemplate statuses, with the import status of
+// the DataImportCron that SSP created from each template
+func (h *sspHooks) getDictStatusesWithImportStatus(req *common.HcoRequest) []hcov1beta1.DataImportCronTemplateStatus {
+	if h.dictStatuses == nil {
+		return nil
+	}
+
+	dictStatuses := make([]hcov1beta1.DataImportCronTemplateStatus, len(h.dictStatuses))
+	for i, dictStatus := range h.dictStatuses {
+		dictStatus.DeepCopyInto(&dictStatuses[i])
+		dictStatuses[i].Status.Import = h.getDataImportCronImportStatus(req, dictStatus.DataImportCronTemplate)
+	}
+
+	return dictStatuses
+}
+
+func (h *sspHooks) getDataImportCronImportStatus(req *common.HcoRequest, dict hcov1beta1.DataImportCronTemplate) *hcov1beta1.DataImportCronImportStatus {
+	key := client.ObjectKey{Name: dict.Name, Namespace: dict.Namespace}
+	if key.Namespace == "" {
+		key.Namespace = defaultGoldenImagesNamespace
+	}
+
+	dic := &cdiv1beta1.DataImportCron{}
+	if err := h.APIReader.Get(req.Ctx, key, dic); err != nil {
+		// the DataImportCron is not created yet, or the DataImportCron CRD is not deployed yet
+		if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			req.Logger.Error(err, "failed to read the DataImportCron", "name", key.Name, "namespace", key.Namespace)
+		}
+		return nil
+	}
+
+	importStatus := &hcov1beta1.DataImportCronImportStatus{
+		LastImportTimestamp: dic.Status.LastImportTimestamp.DeepCopy(),
+	}
+
+	for _, cond := range dic.Status.Conditions {
+		if cond.Type == cdiv1beta1.DataImportCronUpToDate {
+			importStatus.UpToDate = metav1.ConditionStatus(cond.Status)
+			importStatus.Reason = cond.Reason
+			importStatus.Message = cond.Message
+			if !cond.LastTransitionTime.IsZero() {
+				importStatus.LastTransitionTime = cond.LastTransitionTime.DeepCopy()
+			}
+			break
+		}
+	}
+
+	return importStatus
+}
+
 func NewSSP(hc *hcov1beta1.HyperConverged, opts ...string) (*sspv1beta2.SSP, []hcov1beta1.DataImportCronTemplateStatus, error) {
 	replicas := int32(defaultTemplateValidatorReplicas)
+	if hc.Spec.Infra.Replicas != nil {
+		replicas = int32(*hc.Spec.Infra.Replicas)
+	} else if !isInfraPlacementHighlyAvailable(hc) {
+		replicas = 1
+	}
 	templatesNamespace := defaultCommonTemplatesNamespace
 
 	if hc.Spec.CommonTemplatesNamespace != nil {
@@ -164,7 +232,8 @@ func NewSSP(hc *hcov1beta1.HyperConverged, opts ...string) (*sspv1beta2.SSP, []h
 	}
 
 	if hc.Spec.FeatureGates.DeployVMConsoleProxy != nil {
-		spec.FeatureGates.DeployVmConsoleProxy = *hc.Spec.FeatureGates.DeployVMConsoleProxy
+		spec.FeatureGates.DeployVmConsoleProxy = *hc.Spec.FeatureGates.DeployVMConsoleProxy &&
+			isFeatureGateSupported(deployVMConsoleProxyFeatureGate)
 	}
 
 	// Default value is the operator namespace
@@ -183,8 +252,14 @@ func NewSSP(hc *hcov1beta1.HyperConverged, opts ...string) (*sspv1beta2.SSP, []h
 
 	spec.TektonTasks.Namespace = tasksNamespace
 
-	if hc.Spec.Infra.NodePlacement != nil {
-		spec.TemplateValidator.Placement = hc.Spec.Infra.NodePlacement.DeepCopy()
+	if hc.Spec.CommonInstancetypes != nil && hc.Spec.CommonInstancetypes.URL != nil {
+		spec.CommonInstancetypes = &sspv1beta2.CommonInstancetypes{
+			URL: ptr.To(*hc.Spec.CommonInstancetypes.URL),
+		}
+	}
+
+	if infraNodePlacement := getInfraConfig(hc).NodePlacement; infraNodePlacement != nil {
+		spec.TemplateValidator.Placement = infraNodePlacement.DeepCopy()
 	}
 
 	ssp := NewSSPWithNameOnly(hc)
@@ -260,6 +335,12 @@ func getDataImportCronTemplates(hc *hcov1beta1.HyperConverged) ([]hcov1beta1.Dat
 	}
 	dictList = getCustomDicts(dictList, crDicts)
 
+	if hc.Spec.TrustedCABundle != nil {
+		for i := range dictList {
+			setDictCertConfigMap(&dictList[i].DataImportCronTemplate)
+		}
+	}
+
 	sort.Sort(dataImportTemplateSlice(dictList))
 
 	return dictList, nil
@@ -283,11 +364,31 @@ func getCommonDicts(list []hcov1beta1.DataImportCronTemplateStatus, crDicts map[
 			if len(crDict.Spec.Schedule) == 0 {
 				crDict.Spec.Schedule = targetDict.Spec.Schedule
 			}
-			targetDict.Spec = crDict.Spec.DeepCopy()
+
+			base := getCommonDictBase(hc, dictName)
+			if base == nil {
+				base = commonDict.Spec
+			}
+
+			merged, conflicts, err := mergeCommonDict(base, commonDict.Spec, crDict.Spec)
+			if err != nil {
+				logger.Error(err, "failed to merge the modified common DataImportCronTemplate; using the modified template as is", "name", dictName)
+				merged, conflicts = crDict.Spec.DeepCopy(), nil
+			}
+
+			targetDict.Spec = merged
 			targetDict.ObjectMeta.Namespace = crDict.Namespace
 			targetDict.Status.Modified = true
-		} else if ns := hc.Spec.CommonBootImageNamespace; ns != nil && len(*ns) > 0 {
-			targetDict.ObjectMeta.Namespace = *ns
+			targetDict.Status.CommonTemplateSpec = base.DeepCopy()
+			targetDict.Status.Conflicts = conflicts
+		} else {
+			if ns := hc.Spec.CommonBootImageNamespace; ns != nil && len(*ns) > 0 {
+				targetDict.ObjectMeta.Namespace = *ns
+			}
+
+			if registry := hc.Spec.CommonBootImageRegistryOverride; registry != nil && len(*registry) > 0 {
+				overrideDictRegistry(&targetDict.DataImportCronTemplate, *registry)
+			}
 		}
 
 		list = append(list, targetDict)
@@ -296,6 +397,45 @@ func getCommonDicts(list []hcov1beta1.DataImportCronTemplateStatus, crDicts map[
 	return list
 }
 
+// overrideDictRegistry replaces the registry in the source URL of the dict, keeping the URL scheme and the image
+// repository and tag; e.g. with the "mirror.example.com:5000" registry, docker://quay.io/containerdisks/fedora:latest
+// is replaced with docker://mirror.example.com:5000/containerdisks/fedora:latest
+func overrideDictRegistry(dict *hcov1beta1.DataImportCronTemplate, registry string) {
+	if dict.Spec == nil || dict.Spec.Template.Spec.Source == nil || dict.Spec.Template.Spec.Source.Registry == nil {
+		return
+	}
+
+	source := dict.Spec.Template.Spec.Source.Registry
+	if source.URL == nil {
+		return
+	}
+
+	scheme, image, found := strings.Cut(*source.URL, "://")
+	if !found {
+		return
+	}
+
+	_, repository, found := strings.Cut(image, "/")
+	if !found {
+		return
+	}
+
+	source.URL = ptr.To(fmt.Sprintf("%s://%s/%s", scheme, strings.TrimSuffix(registry, "/"), repository))
+}
+
+// setDictCertConfigMap sets the copy of the trusted CA bundle as the certConfigMap of the registry source of the dict,
+// unless the dict already sets its own certConfigMap
+func setDictCertConfigMap(dict *hcov1beta1.DataImportCronTemplate) {
+	if dict.Spec == nil || dict.Spec.Template.Spec.Source == nil || dict.Spec.Template.Spec.Source.Registry == nil {
+		return
+	}
+
+	source := dict.Spec.Template.Spec.Source.Registry
+	if source.CertConfigMap == nil || len(*source.CertConfigMap) == 0 {
+		source.CertConfigMap = ptr.To(trustedCABundleConfigMapName)
+	}
+}
+
 func isDataImportCronTemplateEnabled(dict hcov1beta1.DataImportCronTemplate) bool {
 	annotationVal, found := dict.Annotations[hcoutil.DataImportCronEnabledAnnotation]
 	return !found || strings.ToLower(annotationVal) == "true"
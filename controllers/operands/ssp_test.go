@@ -48,7 +48,7 @@ var _ = Describe("SSP Operands", func() {
 			expectedResource, _, err := NewSSP(hco)
 			Expect(err).ToNot(HaveOccurred())
 			cl := commontestutils.InitClient([]client.Object{})
-			handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+			handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 			res := handler.ensure(req)
 			Expect(res.Created).To(BeTrue())
 			Expect(res.Updated).To(BeFalse())
@@ -71,7 +71,7 @@ var _ = Describe("SSP Operands", func() {
 			expectedResource, _, err := NewSSP(hco)
 			Expect(err).ToNot(HaveOccurred())
 			cl := commontestutils.InitClient([]client.Object{hco, expectedResource})
-			handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+			handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 			res := handler.ensure(req)
 			Expect(res.Created).To(BeFalse())
 			Expect(res.Updated).To(BeFalse())
@@ -87,6 +87,44 @@ var _ = Describe("SSP Operands", func() {
 			Expect(hco.Status.RelatedObjects).To(ContainElement(*objectRef))
 		})
 
+		Context("template validator replicas", func() {
+			getClusterInfo := hcoutil.GetClusterInfo
+
+			AfterEach(func() {
+				hcoutil.GetClusterInfo = getClusterInfo
+			})
+
+			It("should set the template validator replicas from the infra configuration", func() {
+				hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+					return commontestutils.ClusterInfoMock{}
+				}
+
+				expectedResource, _, err := NewSSP(hco)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(expectedResource.Spec.TemplateValidator.Replicas).To(HaveValue(Equal(int32(defaultTemplateValidatorReplicas))))
+
+				hco.Spec.Infra.Replicas = ptr.To[uint8](3)
+				expectedResource, _, err = NewSSP(hco)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(expectedResource.Spec.TemplateValidator.Replicas).To(HaveValue(Equal(int32(3))))
+			})
+
+			It("should deploy a single template validator replica on a single worker cluster", func() {
+				hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+					return commontestutils.ClusterInfoSNOMock{}
+				}
+
+				expectedResource, _, err := NewSSP(hco)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(expectedResource.Spec.TemplateValidator.Replicas).To(HaveValue(Equal(int32(1))))
+
+				hco.Spec.Infra.Replicas = ptr.To[uint8](2)
+				expectedResource, _, err = NewSSP(hco)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(expectedResource.Spec.TemplateValidator.Replicas).To(HaveValue(Equal(int32(2))))
+			})
+		})
+
 		It("should reconcile to default", func() {
 			cTNamespace := "nonDefault"
 			hco.Spec.CommonTemplatesNamespace = &cTNamespace
@@ -100,7 +138,7 @@ var _ = Describe("SSP Operands", func() {
 			req.HCOTriggered = false // mock a reconciliation triggered by a change in NewKubeVirtCommonTemplateBundle CR
 
 			cl := commontestutils.InitClient([]client.Object{hco, existingResource})
-			handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+			handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 			res := handler.ensure(req)
 			Expect(res.Created).To(BeFalse())
 			Expect(res.Updated).To(BeTrue())
@@ -127,6 +165,47 @@ var _ = Describe("SSP Operands", func() {
 			Expect(hco.Status.RelatedObjects).To(ContainElement(*objectRefFound))
 		})
 
+		It("should create ssp with deployTektonTaskResources feature gate enabled", func() {
+			hco.Spec.FeatureGates.DeployTektonTaskResources = ptr.To(true)
+
+			expectedResource, _, err := NewSSP(hco)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(expectedResource.Spec.FeatureGates.DeployTektonTaskResources).To(BeTrue())
+			Expect(expectedResource.Spec.TektonTasks.Namespace).To(Equal(hco.Namespace))
+			Expect(expectedResource.Spec.TektonPipelines.Namespace).To(Equal(hco.Namespace))
+		})
+
+		It("should create ssp with the tekton namespaces from the HyperConverged CR", func() {
+			hco.Spec.FeatureGates.DeployTektonTaskResources = ptr.To(true)
+			hco.Spec.TektonTasksNamespace = ptr.To("tasks-namespace")
+			hco.Spec.TektonPipelinesNamespace = ptr.To("pipelines-namespace")
+
+			expectedResource, _, err := NewSSP(hco)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(expectedResource.Spec.TektonTasks.Namespace).To(Equal("tasks-namespace"))
+			Expect(expectedResource.Spec.TektonPipelines.Namespace).To(Equal("pipelines-namespace"))
+		})
+
+		It("should disable the tekton resources in SSP when the feature gate is turned off", func() {
+			hco.Spec.FeatureGates.DeployTektonTaskResources = ptr.To(true)
+			existingResource, _, err := NewSSP(hco)
+			Expect(err).ToNot(HaveOccurred())
+
+			hco.Spec.FeatureGates.DeployTektonTaskResources = ptr.To(false)
+
+			cl := commontestutils.InitClient([]client.Object{hco, existingResource})
+			handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
+			res := handler.ensure(req)
+			Expect(res.Updated).To(BeTrue())
+			Expect(res.Err).ToNot(HaveOccurred())
+
+			foundResource := &sspv1beta2.SSP{}
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(existingResource), foundResource)).To(Succeed())
+			Expect(foundResource.Spec.FeatureGates.DeployTektonTaskResources).To(BeFalse())
+		})
+
 		It("should create ssp with deployVmConsoleProxy feature gate enabled", func() {
 			hco := commontestutils.NewHco()
 			hco.Spec.FeatureGates.DeployVMConsoleProxy = ptr.To(true)
@@ -137,6 +216,60 @@ var _ = Describe("SSP Operands", func() {
 			Expect(expectedResource.Spec.FeatureGates.DeployVmConsoleProxy).To(BeTrue())
 		})
 
+		It("should not set the common-instancetypes URL by default", func() {
+			expectedResource, _, err := NewSSP(hco)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(expectedResource.Spec.CommonInstancetypes).To(BeNil())
+		})
+
+		It("should create ssp with the common-instancetypes URL from the HyperConverged CR", func() {
+			const url = "https://github.com/kubevirt/common-instancetypes/VirtualMachineClusterInstancetypes?ref=v0.3.4"
+			hco.Spec.CommonInstancetypes = &hcov1beta1.CommonInstancetypesConfig{
+				URL: ptr.To(url),
+			}
+
+			expectedResource, _, err := NewSSP(hco)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(expectedResource.Spec.CommonInstancetypes).ToNot(BeNil())
+			Expect(expectedResource.Spec.CommonInstancetypes.URL).To(HaveValue(Equal(url)))
+		})
+
+		It("should reconcile the common-instancetypes URL", func() {
+			const url = "https://github.com/kubevirt/common-instancetypes/VirtualMachineClusterInstancetypes?ref=v0.3.4"
+			existingResource, _, err := NewSSP(hco)
+			Expect(err).ToNot(HaveOccurred())
+			existingResource.Spec.CommonInstancetypes = &sspv1beta2.CommonInstancetypes{
+				URL: ptr.To("https://example.com/my-instancetypes?ref=main"),
+			}
+
+			hco.Spec.CommonInstancetypes = &hcov1beta1.CommonInstancetypesConfig{
+				URL: ptr.To(url),
+			}
+
+			cl := commontestutils.InitClient([]client.Object{hco, existingResource})
+			handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
+			res := handler.ensure(req)
+			Expect(res.Updated).To(BeTrue())
+			Expect(res.Err).ToNot(HaveOccurred())
+
+			foundResource := &sspv1beta2.SSP{}
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(existingResource), foundResource)).To(Succeed())
+			Expect(foundResource.Spec.CommonInstancetypes).ToNot(BeNil())
+			Expect(foundResource.Spec.CommonInstancetypes.URL).To(HaveValue(Equal(url)))
+
+			By("remove the URL when it is removed from the HyperConverged CR")
+			hco.Spec.CommonInstancetypes = nil
+			handler.reset()
+			res = handler.ensure(req)
+			Expect(res.Updated).To(BeTrue())
+			Expect(res.Err).ToNot(HaveOccurred())
+
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(existingResource), foundResource)).To(Succeed())
+			Expect(foundResource.Spec.CommonInstancetypes).To(BeNil())
+		})
+
 		Context("Node placement", func() {
 
 			It("should add node placement if missing", func() {
@@ -147,7 +280,7 @@ var _ = Describe("SSP Operands", func() {
 				hco.Spec.Infra.NodePlacement = commontestutils.NewOtherNodePlacement()
 
 				cl := commontestutils.InitClient([]client.Object{hco, existingResource})
-				handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+				handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 				res := handler.ensure(req)
 				Expect(res.Created).To(BeFalse())
 				Expect(res.Updated).To(BeTrue())
@@ -177,7 +310,7 @@ var _ = Describe("SSP Operands", func() {
 				Expect(err).ToNot(HaveOccurred())
 
 				cl := commontestutils.InitClient([]client.Object{hco, existingResource})
-				handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+				handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 				res := handler.ensure(req)
 				Expect(res.Created).To(BeFalse())
 				Expect(res.Updated).To(BeTrue())
@@ -218,7 +351,7 @@ var _ = Describe("SSP Operands", func() {
 				hco.Spec.Infra.NodePlacement.NodeSelector["key3"] = "something entirely else"
 
 				cl := commontestutils.InitClient([]client.Object{hco, existingResource})
-				handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+				handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 				res := handler.ensure(req)
 				Expect(res.Created).To(BeFalse())
 				Expect(res.Updated).To(BeTrue())
@@ -246,7 +379,7 @@ var _ = Describe("SSP Operands", func() {
 
 			It("should overwrite node placement if directly set on SSP CR", func() {
 				hco.Spec.Workloads = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewNodePlacement()}
-				hco.Spec.Infra = hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewOtherNodePlacement()}
+				hco.Spec.Infra = hcov1beta1.HyperConvergedInfraConfig{HyperConvergedConfig: hcov1beta1.HyperConvergedConfig{NodePlacement: commontestutils.NewOtherNodePlacement()}}
 				existingResource, _, err := NewSSP(hco, commontestutils.Namespace)
 				Expect(err).ToNot(HaveOccurred())
 
@@ -260,7 +393,7 @@ var _ = Describe("SSP Operands", func() {
 				existingResource.Spec.TemplateValidator.Placement.NodeSelector["key3"] = "BADvalue3"
 
 				cl := commontestutils.InitClient([]client.Object{hco, existingResource})
-				handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+				handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 				res := handler.ensure(req)
 				Expect(res.UpgradeDone).To(BeFalse())
 				Expect(res.Updated).To(BeTrue())
@@ -325,7 +458,7 @@ var _ = Describe("SSP Operands", func() {
 
 				expectedResource := NewSSPWithNameOnly(hco)
 				cl := commontestutils.InitClient([]client.Object{})
-				handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+				handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 				res := handler.ensure(req)
 				Expect(res.UpgradeDone).To(BeFalse())
 				Expect(res.Err).ToNot(HaveOccurred())
@@ -353,7 +486,7 @@ var _ = Describe("SSP Operands", func() {
 
 				expectedResource := NewSSPWithNameOnly(hco)
 				cl := commontestutils.InitClient([]client.Object{})
-				handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+				handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 				res := handler.ensure(req)
 				Expect(res.Err).To(HaveOccurred())
 
@@ -381,7 +514,7 @@ var _ = Describe("SSP Operands", func() {
 
 				cl := commontestutils.InitClient([]client.Object{hco, existsSsp})
 
-				handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+				handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 				res := handler.ensure(req)
 				Expect(res.Err).ToNot(HaveOccurred())
 				Expect(res.Updated).To(BeTrue())
@@ -414,7 +547,7 @@ var _ = Describe("SSP Operands", func() {
 
 				cl := commontestutils.InitClient([]client.Object{hco, existsSsp})
 
-				handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+				handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 				res := handler.ensure(req)
 				Expect(res.Err).To(HaveOccurred())
 
@@ -428,13 +561,13 @@ var _ = Describe("SSP Operands", func() {
 				).To(Succeed())
 
 				Expect(ssp.Spec.TemplateValidator.Replicas).Should(Not(BeNil()))
-				Expect(*ssp.Spec.TemplateValidator.Replicas).Should(Equal(int32(defaultTemplateValidatorReplicas)))
+				Expect(*ssp.Spec.TemplateValidator.Replicas).Should(Equal(*existsSsp.Spec.TemplateValidator.Replicas))
 			})
 		})
 
 		Context("Cache", func() {
 			cl := commontestutils.InitClient([]client.Object{})
-			handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+			handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 
 			It("should start with empty cache", func() {
 				Expect(handler.hooks.(*sspHooks).cache).To(BeNil())
@@ -698,6 +831,7 @@ var _ = Describe("SSP Operands", func() {
 
 					statusImage2Enabled := statusImage2.DeepCopy()
 					statusImage2Enabled.Status.Modified = true
+					statusImage2Enabled.Status.CommonTemplateSpec = image2.Spec.DeepCopy()
 
 					Expect(goldenImageList).To(ContainElements(*statusImage2Enabled, statusImage3, statusImage4))
 				})
@@ -1180,6 +1314,229 @@ var _ = Describe("SSP Operands", func() {
 
 					Expect(ssp.Spec.CommonTemplates.DataImportCronTemplates).Should(ContainElements(hcoDictSliceToSSP(commonImages)))
 				})
+
+				It("should override the registry of the common dicts, if defined in the hyperConverged CR", func() {
+					Expect(os.Mkdir(dir, os.ModePerm)).To(Succeed())
+					defer func() { _ = os.RemoveAll(dir) }()
+					destFile := path.Join(dir, "dataImportCronTemplates.yaml")
+
+					Expect(commontestutils.CopyFile(destFile, path.Join(testFilesLocation, "dataImportCronTemplates.yaml"))).To(Succeed())
+					defer os.Remove(destFile)
+					Expect(readDataImportCronTemplatesFromFile()).To(Succeed())
+
+					hco := commontestutils.NewHco()
+					hco.Spec.FeatureGates.EnableCommonBootImageImport = ptr.To(true)
+					hco.Spec.CommonBootImageRegistryOverride = ptr.To("mirror.example.com:5000")
+					ssp, dictStatuses, err := NewSSP(hco)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(ssp.Spec.CommonTemplates.DataImportCronTemplates).Should(HaveLen(2))
+					for _, dict := range ssp.Spec.CommonTemplates.DataImportCronTemplates {
+						Expect(dict.Spec.Template.Spec.Source.Registry.URL).To(HaveValue(HavePrefix("docker://mirror.example.com:5000/kubevirt/")))
+					}
+
+					Expect(dictStatuses).Should(HaveLen(2))
+					for _, dict := range dictStatuses {
+						Expect(dict.Spec.Template.Spec.Source.Registry.URL).To(HaveValue(HavePrefix("docker://mirror.example.com:5000/kubevirt/")))
+						Expect(dict.Status.Modified).To(BeFalse())
+					}
+
+					for _, dict := range dataImportCronTemplateHardCodedMap {
+						Expect(dict.Spec.Template.Spec.Source.Registry.URL).To(HaveValue(HavePrefix("docker://quay.io/")))
+					}
+				})
+
+				It("should not override the registry of modified common dicts and custom dicts", func() {
+					Expect(os.Mkdir(dir, os.ModePerm)).To(Succeed())
+					defer func() { _ = os.RemoveAll(dir) }()
+					destFile := path.Join(dir, "dataImportCronTemplates.yaml")
+
+					Expect(commontestutils.CopyFile(destFile, path.Join(testFilesLocation, "dataImportCronTemplates.yaml"))).To(Succeed())
+					defer os.Remove(destFile)
+					Expect(readDataImportCronTemplatesFromFile()).To(Succeed())
+
+					modifiedFedora := dataImportCronTemplateHardCodedMap["fedora-image-cron"]
+					modifiedFedora = *modifiedFedora.DeepCopy()
+					modifiedFedora.Spec.Template.Spec.Source.Registry.URL = ptr.To("docker://quay.io/kubevirt/fedora:39")
+
+					hco := commontestutils.NewHco()
+					hco.Spec.FeatureGates.EnableCommonBootImageImport = ptr.To(true)
+					hco.Spec.CommonBootImageRegistryOverride = ptr.To("mirror.example.com/containerdisks")
+					hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{modifiedFedora, image3}
+					_, dictStatuses, err := NewSSP(hco)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(dictStatuses).Should(HaveLen(3))
+					for _, dict := range dictStatuses {
+						switch dict.Name {
+						case "fedora-image-cron":
+							Expect(dict.Spec.Template.Spec.Source.Registry.URL).To(HaveValue(Equal("docker://quay.io/kubevirt/fedora:39")))
+						case "centos8-image-cron":
+							Expect(dict.Spec.Template.Spec.Source.Registry.URL).To(HaveValue(Equal("docker://mirror.example.com/containerdisks/kubevirt/centos8")))
+						case image3.Name:
+							Expect(dict.Spec.Template.Spec.Source.Registry.URL).To(HaveValue(Equal(url3)))
+						default:
+							Fail("unexpected dict " + dict.Name)
+						}
+					}
+				})
+			})
+
+			Context("test overrideDictRegistry", func() {
+				const registry = "mirror.example.com:5000"
+
+				It("should not change a dict with no registry source", func() {
+					dict := hcov1beta1.DataImportCronTemplate{
+						ObjectMeta: metav1.ObjectMeta{Name: "image-stream"},
+						Spec: &cdiv1beta1.DataImportCronSpec{
+							Template: cdiv1beta1.DataVolume{
+								Spec: cdiv1beta1.DataVolumeSpec{
+									Source: &cdiv1beta1.DataVolumeSource{
+										Registry: &cdiv1beta1.DataVolumeSourceRegistry{ImageStream: ptr.To("centos8")},
+									},
+								},
+							},
+						},
+					}
+					expected := dict.DeepCopy()
+
+					overrideDictRegistry(&dict, registry)
+					Expect(dict).To(Equal(*expected))
+
+					dict.Spec.Template.Spec.Source = nil
+					overrideDictRegistry(&dict, registry)
+					Expect(dict.Spec.Template.Spec.Source).To(BeNil())
+				})
+
+				DescribeTable("should replace the registry in the source URL", func(url, expected string) {
+					dict := hcov1beta1.DataImportCronTemplate{
+						Spec: &cdiv1beta1.DataImportCronSpec{
+							Template: cdiv1beta1.DataVolume{
+								Spec: cdiv1beta1.DataVolumeSpec{
+									Source: &cdiv1beta1.DataVolumeSource{
+										Registry: &cdiv1beta1.DataVolumeSourceRegistry{URL: ptr.To(url)},
+									},
+								},
+							},
+						},
+					}
+
+					overrideDictRegistry(&dict, registry)
+					Expect(dict.Spec.Template.Spec.Source.Registry.URL).To(HaveValue(Equal(expected)))
+				},
+					Entry("docker URL with a tag", "docker://quay.io/containerdisks/fedora:latest", "docker://mirror.example.com:5000/containerdisks/fedora:latest"),
+					Entry("docker URL with a registry port", "docker://registry:5000/fedora", "docker://mirror.example.com:5000/fedora"),
+					Entry("oci-archive URL", "oci-archive://quay.io/containerdisks/fedora", "oci-archive://mirror.example.com:5000/containerdisks/fedora"),
+					Entry("URL with no scheme", "quay.io/containerdisks/fedora", "quay.io/containerdisks/fedora"),
+					Entry("URL with no repository", "docker://fedora", "docker://fedora"),
+				)
+			})
+
+			Context("test the merge of the modified common templates", func() {
+				origList := dataImportCronTemplateHardCodedMap
+				newURL := "docker://someregistry/image1-new"
+
+				var (
+					hco        *hcov1beta1.HyperConverged
+					customized *hcov1beta1.DataImportCronTemplate
+				)
+
+				BeforeEach(func() {
+					hco = commontestutils.NewHco()
+					hco.Spec.FeatureGates.EnableCommonBootImageImport = ptr.To(true)
+
+					customized = image1.DeepCopy()
+					customized.Spec.Schedule = "5 */6 * * *"
+					customized.Spec.Template.Spec.Storage = &cdiv1beta1.StorageSpec{StorageClassName: ptr.To("fast")}
+					hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{*customized}
+
+					// the common template was customized when the common template was image1
+					hco.Status.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplateStatus{
+						{
+							DataImportCronTemplate: *customized.DeepCopy(),
+							Status: hcov1beta1.DataImportCronStatus{
+								CommonTemplate:     true,
+								Modified:           true,
+								CommonTemplateSpec: image1.Spec.DeepCopy(),
+							},
+						},
+					}
+
+					newImage1 := image1.DeepCopy()
+					newImage1.Spec.Template.Spec.Source.Registry.URL = ptr.To(newURL)
+					dataImportCronTemplateHardCodedMap = map[string]hcov1beta1.DataImportCronTemplate{
+						newImage1.Name: *newImage1,
+					}
+				})
+
+				AfterEach(func() {
+					dataImportCronTemplateHardCodedMap = origList
+				})
+
+				It("should use the modified template as is, if the common template was not changed", func() {
+					dataImportCronTemplateHardCodedMap = map[string]hcov1beta1.DataImportCronTemplate{
+						image1.Name: image1,
+					}
+
+					dicts, err := getDataImportCronTemplates(hco)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dicts).To(HaveLen(1))
+					Expect(dicts[0].Spec).To(Equal(customized.Spec))
+					Expect(dicts[0].Status.CommonTemplateSpec).To(Equal(image1.Spec))
+					Expect(dicts[0].Status.Conflicts).To(BeEmpty())
+				})
+
+				It("should keep the current common template as the base, if the base is missing", func() {
+					hco.Status.DataImportCronTemplates = nil
+
+					dicts, err := getDataImportCronTemplates(hco)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dicts).To(HaveLen(1))
+					Expect(dicts[0].Spec).To(Equal(customized.Spec))
+					Expect(dicts[0].Status.CommonTemplateSpec).To(Equal(dataImportCronTemplateHardCodedMap[image1.Name].Spec))
+				})
+
+				It("should merge the customization with the new version of the common template", func() {
+					dicts, err := getDataImportCronTemplates(hco)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dicts).To(HaveLen(1))
+
+					dict := dicts[0]
+					Expect(dict.Status.Modified).To(BeTrue())
+					Expect(dict.Spec.Template.Spec.Source.Registry.URL).To(HaveValue(Equal(newURL)))
+					Expect(dict.Spec.Schedule).To(Equal("5 */6 * * *"))
+					Expect(dict.Spec.Template.Spec.Storage).ToNot(BeNil())
+					Expect(dict.Spec.Template.Spec.Storage.StorageClassName).To(HaveValue(Equal("fast")))
+					Expect(dict.Spec.ManagedDataSource).To(Equal("image1"))
+					Expect(dict.Status.Conflicts).To(BeEmpty())
+
+					By("keeping the original base")
+					Expect(dict.Status.CommonTemplateSpec).To(Equal(image1.Spec))
+				})
+
+				It("should keep the customized values, and report the conflicts", func() {
+					const customURL = "docker://myregistry/image1"
+					hco.Spec.DataImportCronTemplates[0].Spec.Template.Spec.Source.Registry.URL = ptr.To(customURL)
+
+					dicts, err := getDataImportCronTemplates(hco)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dicts).To(HaveLen(1))
+
+					dict := dicts[0]
+					Expect(dict.Spec.Template.Spec.Source.Registry.URL).To(HaveValue(Equal(customURL)))
+					Expect(dict.Spec.Template.Spec.Storage.StorageClassName).To(HaveValue(Equal("fast")))
+					Expect(dict.Status.Conflicts).To(Equal([]string{"spec.template.spec.source.registry.url"}))
+				})
+
+				It("should not report a conflict if the customization and the new common template are the same", func() {
+					hco.Spec.DataImportCronTemplates[0].Spec.Template.Spec.Source.Registry.URL = ptr.To(newURL)
+
+					dicts, err := getDataImportCronTemplates(hco)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dicts).To(HaveLen(1))
+					Expect(dicts[0].Spec.Template.Spec.Source.Registry.URL).To(HaveValue(Equal(newURL)))
+					Expect(dicts[0].Status.Conflicts).To(BeEmpty())
+				})
 			})
 
 			Context("test applyDataImportSchedule", func() {
@@ -1235,7 +1592,7 @@ var _ = Describe("SSP Operands", func() {
 						expectedResource, _, err := NewSSP(hco)
 						Expect(err).ToNot(HaveOccurred())
 						cl := commontestutils.InitClient([]client.Object{})
-						handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+						handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 						res := handler.ensure(req)
 						Expect(res.Created).To(BeTrue())
 						Expect(res.Updated).To(BeFalse())
@@ -1264,7 +1621,7 @@ var _ = Describe("SSP Operands", func() {
 						expectedResource, _, err := NewSSP(hco)
 						Expect(err).ToNot(HaveOccurred())
 						cl := commontestutils.InitClient([]client.Object{})
-						handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+						handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 						res := handler.ensure(req)
 						Expect(res.Created).To(BeTrue())
 						Expect(res.Updated).To(BeFalse())
@@ -1293,7 +1650,7 @@ var _ = Describe("SSP Operands", func() {
 						expectedResource, _, err := NewSSP(hco)
 						Expect(err).ToNot(HaveOccurred())
 						cl := commontestutils.InitClient([]client.Object{})
-						handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+						handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 						res := handler.ensure(req)
 						Expect(res.Created).To(BeTrue())
 						Expect(res.Updated).To(BeFalse())
@@ -1331,7 +1688,7 @@ var _ = Describe("SSP Operands", func() {
 						expectedResource, _, err := NewSSP(hco)
 						Expect(err).ToNot(HaveOccurred())
 						cl := commontestutils.InitClient([]client.Object{})
-						handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+						handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 						res := handler.ensure(req)
 						Expect(res.Created).To(BeTrue())
 						Expect(res.Updated).To(BeFalse())
@@ -1375,7 +1732,7 @@ var _ = Describe("SSP Operands", func() {
 						expectedResource, _, err := NewSSP(hco)
 						Expect(err).ToNot(HaveOccurred())
 						cl := commontestutils.InitClient([]client.Object{})
-						handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+						handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 						res := handler.ensure(req)
 						Expect(res.Created).To(BeTrue())
 						Expect(res.Updated).To(BeFalse())
@@ -1419,7 +1776,7 @@ var _ = Describe("SSP Operands", func() {
 						expectedResource, _, err := NewSSP(hco)
 						Expect(err).ToNot(HaveOccurred())
 						cl := commontestutils.InitClient([]client.Object{})
-						handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+						handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 						res := handler.ensure(req)
 						Expect(res.Created).To(BeTrue())
 						Expect(res.Updated).To(BeFalse())
@@ -1462,7 +1819,7 @@ var _ = Describe("SSP Operands", func() {
 						expectedResource, _, err := NewSSP(hco)
 						Expect(err).ToNot(HaveOccurred())
 						cl := commontestutils.InitClient([]client.Object{})
-						handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+						handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 						res := handler.ensure(req)
 						Expect(res.Created).To(BeTrue())
 						Expect(res.Updated).To(BeFalse())
@@ -1513,7 +1870,7 @@ var _ = Describe("SSP Operands", func() {
 						origSSP, _, err := NewSSP(hco)
 						Expect(err).ToNot(HaveOccurred())
 						cl := commontestutils.InitClient([]client.Object{origSSP})
-						handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+						handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 						res := handler.ensure(req)
 						Expect(res.Created).To(BeFalse())
 						Expect(res.Updated).To(BeFalse())
@@ -1545,7 +1902,7 @@ var _ = Describe("SSP Operands", func() {
 						hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{image3, image4}
 
 						cl := commontestutils.InitClient([]client.Object{origSSP})
-						handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+						handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 						res := handler.ensure(req)
 						Expect(res.Created).To(BeFalse())
 						Expect(res.Updated).To(BeTrue())
@@ -1577,7 +1934,7 @@ var _ = Describe("SSP Operands", func() {
 						hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{image3, image4}
 
 						cl := commontestutils.InitClient([]client.Object{origSSP})
-						handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+						handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 						res := handler.ensure(req)
 						Expect(res.Created).To(BeFalse())
 						Expect(res.Updated).To(BeTrue())
@@ -1617,7 +1974,7 @@ var _ = Describe("SSP Operands", func() {
 						hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{*disabledCentos8, image3, image4}
 
 						cl := commontestutils.InitClient([]client.Object{origSSP})
-						handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+						handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 						res := handler.ensure(req)
 						Expect(res.Created).To(BeFalse())
 						Expect(res.Updated).To(BeTrue())
@@ -1659,7 +2016,7 @@ var _ = Describe("SSP Operands", func() {
 						hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{*modifiedCentos8, image3, image4}
 
 						cl := commontestutils.InitClient([]client.Object{origSSP})
-						handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+						handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 						res := handler.ensure(req)
 						Expect(res.Created).To(BeFalse())
 						Expect(res.Updated).To(BeTrue())
@@ -1704,7 +2061,7 @@ var _ = Describe("SSP Operands", func() {
 						cl := commontestutils.InitClient([]client.Object{origSSP})
 
 						hco.Spec.CommonBootImageNamespace = ptr.To(customNS)
-						handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+						handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 						res := handler.ensure(req)
 						Expect(res.Created).To(BeFalse())
 						Expect(res.Updated).To(BeTrue())
@@ -1744,7 +2101,7 @@ var _ = Describe("SSP Operands", func() {
 						hco.Spec.CommonBootImageNamespace = ptr.To(customNS)
 
 						cl := commontestutils.InitClient([]client.Object{origSSP})
-						handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+						handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 						res := handler.ensure(req)
 						Expect(res.Created).To(BeFalse())
 						Expect(res.Updated).To(BeTrue())
@@ -1788,6 +2145,166 @@ var _ = Describe("SSP Operands", func() {
 						}
 					})
 				})
+
+				Context("with the DataImportCron status", func() {
+					newDataImportCron := func(name, namespace string) *cdiv1beta1.DataImportCron {
+						return &cdiv1beta1.DataImportCron{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      name,
+								Namespace: namespace,
+							},
+						}
+					}
+
+					It("should not set the import status if the DataImportCron does not exist", func() {
+						hco.Spec.FeatureGates.EnableCommonBootImageImport = ptr.To(false)
+						hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{image3}
+						cl := commontestutils.InitClient([]client.Object{})
+						handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
+						res := handler.ensure(req)
+						Expect(res.Err).ToNot(HaveOccurred())
+
+						Expect(hco.Status.DataImportCronTemplates).To(HaveLen(1))
+						Expect(hco.Status.DataImportCronTemplates[0].Status.Import).To(BeNil())
+					})
+
+					It("should set the import status from the DataImportCron", func() {
+						hco.Spec.FeatureGates.EnableCommonBootImageImport = ptr.To(false)
+						hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{image3}
+
+						lastImport := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+						dic := newDataImportCron(image3.Name, defaultGoldenImagesNamespace)
+						dic.Status.LastImportTimestamp = &lastImport
+						lastTransition := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+						dic.Status.Conditions = []cdiv1beta1.DataImportCronCondition{
+							{
+								Type: cdiv1beta1.DataImportCronProgressing,
+								ConditionState: cdiv1beta1.ConditionState{
+									Status: corev1.ConditionFalse,
+									Reason: "NoImport",
+								},
+							},
+							{
+								Type: cdiv1beta1.DataImportCronUpToDate,
+								ConditionState: cdiv1beta1.ConditionState{
+									Status:             corev1.ConditionTrue,
+									Reason:             "UpToDate",
+									Message:            "Latest import is up to date",
+									LastTransitionTime: lastTransition,
+								},
+							},
+						}
+
+						cl := commontestutils.InitClient([]client.Object{dic})
+						handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
+						res := handler.ensure(req)
+						Expect(res.Err).ToNot(HaveOccurred())
+
+						Expect(hco.Status.DataImportCronTemplates).To(HaveLen(1))
+						importStatus := hco.Status.DataImportCronTemplates[0].Status.Import
+						Expect(importStatus).ToNot(BeNil())
+						Expect(importStatus.LastImportTimestamp).To(HaveValue(Equal(lastImport)))
+						Expect(importStatus.UpToDate).To(Equal(metav1.ConditionTrue))
+						Expect(importStatus.Reason).To(Equal("UpToDate"))
+						Expect(importStatus.Message).To(Equal("Latest import is up to date"))
+						Expect(importStatus.LastTransitionTime).To(HaveValue(Equal(lastTransition)))
+						Expect(hco.Status.DataImportCronTemplates[0].Status.CommonTemplate).To(BeFalse())
+					})
+
+					It("should read the DataImportCron directly from the API server", func() {
+						hco.Spec.FeatureGates.EnableCommonBootImageImport = ptr.To(false)
+						hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{image3}
+
+						dic := newDataImportCron(image3.Name, defaultGoldenImagesNamespace)
+						dic.Status.Conditions = []cdiv1beta1.DataImportCronCondition{
+							{
+								Type: cdiv1beta1.DataImportCronUpToDate,
+								ConditionState: cdiv1beta1.ConditionState{
+									Status: corev1.ConditionTrue,
+									Reason: "UpToDate",
+								},
+							},
+						}
+
+						// the DataImportCrons are not in the cache
+						cl := commontestutils.InitClient([]client.Object{})
+						apiReader := commontestutils.InitClient([]client.Object{dic})
+						handler := (*genericOperand)(newSspHandler(cl, apiReader, commontestutils.GetScheme()))
+						res := handler.ensure(req)
+						Expect(res.Err).ToNot(HaveOccurred())
+
+						Expect(hco.Status.DataImportCronTemplates).To(HaveLen(1))
+						importStatus := hco.Status.DataImportCronTemplates[0].Status.Import
+						Expect(importStatus).ToNot(BeNil())
+						Expect(importStatus.UpToDate).To(Equal(metav1.ConditionTrue))
+					})
+
+					It("should read the DataImportCron from the namespace of the template", func() {
+						hco.Spec.FeatureGates.EnableCommonBootImageImport = ptr.To(false)
+						customImage := image3.DeepCopy()
+						customImage.Namespace = customNS
+						hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{*customImage}
+
+						dic := newDataImportCron(image3.Name, customNS)
+						dic.Status.Conditions = []cdiv1beta1.DataImportCronCondition{
+							{
+								Type: cdiv1beta1.DataImportCronUpToDate,
+								ConditionState: cdiv1beta1.ConditionState{
+									Status: corev1.ConditionFalse,
+									Reason: "ImportProgressing",
+								},
+							},
+						}
+
+						cl := commontestutils.InitClient([]client.Object{dic, newDataImportCron(image3.Name, defaultGoldenImagesNamespace)})
+						handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
+						res := handler.ensure(req)
+						Expect(res.Err).ToNot(HaveOccurred())
+
+						Expect(hco.Status.DataImportCronTemplates).To(HaveLen(1))
+						importStatus := hco.Status.DataImportCronTemplates[0].Status.Import
+						Expect(importStatus).ToNot(BeNil())
+						Expect(importStatus.LastImportTimestamp).To(BeNil())
+						Expect(importStatus.LastTransitionTime).To(BeNil())
+						Expect(importStatus.UpToDate).To(Equal(metav1.ConditionFalse))
+						Expect(importStatus.Reason).To(Equal("ImportProgressing"))
+					})
+
+					It("should update the import status when the DataImportCron is updated", func() {
+						hco.Spec.FeatureGates.EnableCommonBootImageImport = ptr.To(false)
+						hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{image3}
+
+						dic := newDataImportCron(image3.Name, defaultGoldenImagesNamespace)
+						cl := commontestutils.InitClient([]client.Object{dic})
+						handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
+						res := handler.ensure(req)
+						Expect(res.Err).ToNot(HaveOccurred())
+
+						Expect(hco.Status.DataImportCronTemplates).To(HaveLen(1))
+						Expect(hco.Status.DataImportCronTemplates[0].Status.Import).ToNot(BeNil())
+						Expect(hco.Status.DataImportCronTemplates[0].Status.Import.UpToDate).To(BeEmpty())
+
+						Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(dic), dic)).To(Succeed())
+						dic.Status.Conditions = []cdiv1beta1.DataImportCronCondition{
+							{
+								Type: cdiv1beta1.DataImportCronUpToDate,
+								ConditionState: cdiv1beta1.ConditionState{
+									Status: corev1.ConditionTrue,
+									Reason: "UpToDate",
+								},
+							},
+						}
+						Expect(cl.Status().Update(context.TODO(), dic)).To(Succeed())
+
+						req.StatusDirty = false
+						handler.hooks.(*sspHooks).reset()
+						res = handler.ensure(req)
+						Expect(res.Err).ToNot(HaveOccurred())
+
+						Expect(req.StatusDirty).To(BeTrue())
+						Expect(hco.Status.DataImportCronTemplates[0].Status.Import.UpToDate).To(Equal(metav1.ConditionTrue))
+					})
+				})
 			})
 
 			Context("test isDataImportCronTemplateEnabled", func() {
@@ -1859,7 +2376,7 @@ var _ = Describe("SSP Operands", func() {
 				hco.Spec.TLSSecurityProfile = modernTLSSecurityProfile
 
 				cl := commontestutils.InitClient([]client.Object{hco, existingResource})
-				handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+				handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 				res := handler.ensure(req)
 				Expect(res.UpgradeDone).To(BeFalse())
 				Expect(res.Updated).To(BeTrue())
@@ -1889,7 +2406,7 @@ var _ = Describe("SSP Operands", func() {
 				existingResource.Spec.TLSSecurityProfile = modernTLSSecurityProfile
 
 				cl := commontestutils.InitClient([]client.Object{hco, existingResource})
-				handler := (*genericOperand)(newSspHandler(cl, commontestutils.GetScheme()))
+				handler := (*genericOperand)(newSspHandler(cl, cl, commontestutils.GetScheme()))
 				res := handler.ensure(req)
 				Expect(res.UpgradeDone).To(BeFalse())
 				Expect(res.Updated).To(BeTrue())
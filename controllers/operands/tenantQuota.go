@@ -0,0 +1,161 @@
+package operands
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mtqv1alpha1 "kubevirt.io/managed-tenant-quota/staging/src/kubevirt.io/managed-tenant-quota-api/pkg/apis/core/v1alpha1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	tenantQuotaNamespaceNotFoundReason = "TenantQuotaNamespaceNotFound"
+
+	// tenantQuotaRetryInterval is how long HCO waits before trying again to create the quotas in the namespaces that
+	// don't exist. HCO does not watch the tenant namespaces, so their creation does not trigger a reconciliation.
+	tenantQuotaRetryInterval = 5 * time.Minute
+)
+
+// tenantQuotaOperand creates the VirtualMachineMigrationResourceQuotas, defined by the tenantQuotaTemplates field in
+// the HyperConverged CR, and removes the ones that are no longer required.
+//
+// The quotas are created in the tenant namespaces, so HCO can't set an owner reference on them. Instead, the quotas
+// are identified by the HCO labels, and are explicitly removed when the HyperConverged CR is deleted.
+type tenantQuotaOperand struct {
+	Client       client.Client
+	Scheme       *runtime.Scheme
+	eventEmitter hcoutil.EventEmitter
+}
+
+func newTenantQuotaHandler(Client client.Client, Scheme *runtime.Scheme, eventEmitter hcoutil.EventEmitter) Operand {
+	return &tenantQuotaOperand{
+		Client:       Client,
+		Scheme:       Scheme,
+		eventEmitter: eventEmitter,
+	}
+}
+
+func (h tenantQuotaOperand) ensure(req *common.HcoRequest) *EnsureResult {
+	res := NewEnsureResult(&mtqv1alpha1.VirtualMachineMigrationResourceQuota{})
+
+	var required map[types.NamespacedName]*mtqv1alpha1.VirtualMachineMigrationResourceQuota
+	if IsMTQEnabled(req.Instance) {
+		required = NewTenantQuotas(req.Instance)
+	}
+
+	existing := &mtqv1alpha1.VirtualMachineMigrationResourceQuotaList{}
+	err := h.Client.List(req.Ctx, existing, client.MatchingLabels{
+		hcoutil.AppLabel:          req.Instance.Name,
+		hcoutil.AppLabelComponent: string(hcoutil.AppComponentMultiTenant),
+	})
+	if err != nil {
+		if meta.IsNoMatchError(err) && len(required) == 0 {
+			// the MTQ CRDs are not deployed; there is nothing to remove
+			return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
+		}
+		return res.Error(err)
+	}
+
+	cl := common.NewCustomMetadataClient(h.Client, req.Instance)
+	for i := range existing.Items {
+		found := &existing.Items[i]
+		key := client.ObjectKeyFromObject(found)
+
+		quota, ok := required[key]
+		if !ok {
+			req.Logger.Info("Removing a VirtualMachineMigrationResourceQuota that is no longer required", "namespace", found.Namespace, "name", found.Name)
+			if err = h.Client.Delete(req.Ctx, found); client.IgnoreNotFound(err) != nil {
+				return res.Error(err)
+			}
+			res.SetName(found.Name).SetDeleted()
+			continue
+		}
+		delete(required, key)
+
+		hasCustomMetadata := common.StripCustomMetadata(req.Instance, found)
+		if !reflect.DeepEqual(found.Spec, quota.Spec) || !reflect.DeepEqual(found.Labels, quota.Labels) || !hasCustomMetadata {
+			if req.HCOTriggered {
+				req.Logger.Info("Updating existing VirtualMachineMigrationResourceQuota to new opinionated values", "namespace", found.Namespace, "name", found.Name)
+			} else {
+				req.Logger.Info("Reconciling an externally updated VirtualMachineMigrationResourceQuota to its opinionated values", "namespace", found.Namespace, "name", found.Name)
+			}
+			fieldManager := hcoutil.GetLastFieldManager(found)
+			hcoutil.DeepCopyLabels(&quota.ObjectMeta, &found.ObjectMeta)
+			quota.Spec.DeepCopyInto(&found.Spec)
+			if err = cl.Update(req.Ctx, found); err != nil {
+				return res.Error(err)
+			}
+			res.SetName(found.Name).SetNamespace(found.Namespace).SetUpdated().SetOverwritten(!req.HCOTriggered).SetFieldManager(fieldManager)
+		}
+	}
+
+	for _, quota := range required {
+		req.Logger.Info("Creating VirtualMachineMigrationResourceQuota", "namespace", quota.Namespace, "name", quota.Name)
+		err = cl.Create(req.Ctx, quota)
+		if apierrors.IsNotFound(err) {
+			// the namespace does not exist (yet). Don't block the other quotas and operands; try again later.
+			req.Logger.Info("Can't create the VirtualMachineMigrationResourceQuota; the namespace does not exist", "namespace", quota.Namespace, "name", quota.Name)
+			h.emitNamespaceNotFoundEvent(req, quota)
+			req.SetRequeueAfter(tenantQuotaRetryInterval)
+			continue
+		} else if err != nil {
+			return res.Error(fmt.Errorf("failed to create the %s VirtualMachineMigrationResourceQuota in the %s namespace; %w", quota.Name, quota.Namespace, err))
+		}
+		res.SetName(quota.Name).SetCreated()
+	}
+
+	return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
+}
+
+func (h tenantQuotaOperand) reset() { /* no implementation */ }
+
+func (h tenantQuotaOperand) emitNamespaceNotFoundEvent(req *common.HcoRequest, quota *mtqv1alpha1.VirtualMachineMigrationResourceQuota) {
+	if h.eventEmitter == nil {
+		return
+	}
+
+	msg := fmt.Sprintf("Can't create the %s VirtualMachineMigrationResourceQuota; the %s namespace does not exist", quota.Name, quota.Namespace)
+	h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, tenantQuotaNamespaceNotFoundReason, msg)
+}
+
+// IsMTQEnabled returns true if HCO should deploy MTQ
+func IsMTQEnabled(hc *hcov1beta1.HyperConverged) bool {
+	// MTQ is not supported at a single node cluster, and on some node architectures
+	return hcoutil.GetClusterInfo().IsInfrastructureHighlyAvailable() &&
+		hc.Spec.FeatureGates.EnableManagedTenantQuota != nil && *hc.Spec.FeatureGates.EnableManagedTenantQuota &&
+		isFeatureGateSupported(enableManagedTenantQuotaFeatureGate)
+}
+
+// NewTenantQuotas returns the VirtualMachineMigrationResourceQuotas that HCO should create, by their namespaced name
+func NewTenantQuotas(hc *hcov1beta1.HyperConverged) map[types.NamespacedName]*mtqv1alpha1.VirtualMachineMigrationResourceQuota {
+	quotas := make(map[types.NamespacedName]*mtqv1alpha1.VirtualMachineMigrationResourceQuota)
+	for _, template := range hc.Spec.TenantQuotaTemplates {
+		for _, ns := range template.Namespaces {
+			quota := &mtqv1alpha1.VirtualMachineMigrationResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      template.Name,
+					Namespace: ns,
+					Labels:    getLabels(hc, hcoutil.AppComponentMultiTenant),
+				},
+				Spec: mtqv1alpha1.VirtualMachineMigrationResourceQuotaSpec{
+					AdditionalMigrationResources: template.AdditionalMigrationResources.DeepCopy(),
+				},
+			}
+			quotas[client.ObjectKeyFromObject(quota)] = quota
+		}
+	}
+
+	return quotas
+}
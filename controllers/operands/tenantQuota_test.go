@@ -0,0 +1,193 @@
+package operands
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mtqv1alpha1 "kubevirt.io/managed-tenant-quota/staging/src/kubevirt.io/managed-tenant-quota-api/pkg/apis/core/v1alpha1"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Tenant quota tests", func() {
+	var (
+		hco *v1beta1.HyperConverged
+		req *common.HcoRequest
+	)
+
+	getClusterInfo := hcoutil.GetClusterInfo
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		hco.Spec.FeatureGates.EnableManagedTenantQuota = ptr.To(true)
+		hco.Spec.TenantQuotaTemplates = []v1beta1.TenantQuotaTemplate{
+			{
+				Name:       "default-quota",
+				Namespaces: []string{"ns1", "ns2"},
+				AdditionalMigrationResources: corev1.ResourceList{
+					corev1.ResourceRequestsMemory: resource.MustParse("4Gi"),
+				},
+			},
+		}
+		req = commontestutils.NewReq(hco)
+		hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+			return &commontestutils.ClusterInfoMock{}
+		}
+	})
+
+	AfterEach(func() {
+		hcoutil.GetClusterInfo = getClusterInfo
+	})
+
+	listQuotas := func(cl client.Client) []mtqv1alpha1.VirtualMachineMigrationResourceQuota {
+		quotas := &mtqv1alpha1.VirtualMachineMigrationResourceQuotaList{}
+		Expect(cl.List(context.TODO(), quotas)).To(Succeed())
+		return quotas.Items
+	}
+
+	Context("test NewTenantQuotas", func() {
+		It("should create a quota for each namespace", func() {
+			quotas := NewTenantQuotas(hco)
+			Expect(quotas).To(HaveLen(2))
+
+			for _, ns := range []string{"ns1", "ns2"} {
+				quota := quotas[client.ObjectKey{Namespace: ns, Name: "default-quota"}]
+				Expect(quota).ToNot(BeNil())
+				Expect(quota.Labels).To(HaveKeyWithValue(hcoutil.AppLabelComponent, string(hcoutil.AppComponentMultiTenant)))
+				Expect(quota.Spec.AdditionalMigrationResources).To(HaveKeyWithValue(corev1.ResourceRequestsMemory, resource.MustParse("4Gi")))
+			}
+		})
+
+		It("should return an empty map if there are no templates", func() {
+			hco.Spec.TenantQuotaTemplates = nil
+			Expect(NewTenantQuotas(hco)).To(BeEmpty())
+		})
+	})
+
+	Context("test tenantQuotaOperand", func() {
+		It("should create the quotas if the feature gate is enabled", func() {
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := newTenantQuotaHandler(cl, commontestutils.GetScheme(), nil)
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+
+			Expect(listQuotas(cl)).To(HaveLen(2))
+			Expect(req.RequeueAfter).To(BeZero())
+		})
+
+		It("should skip a namespace that does not exist, and create the other quotas", func() {
+			cl := commontestutils.InitClient([]client.Object{hco})
+			cl.InitiateCreateErrors(func(obj client.Object) error {
+				if obj.GetNamespace() == "ns1" {
+					return apierrors.NewNotFound(corev1.Resource("namespaces"), "ns1")
+				}
+				return nil
+			})
+			eventEmitter := commontestutils.NewEventEmitterMock()
+			handler := newTenantQuotaHandler(cl, commontestutils.GetScheme(), eventEmitter)
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+
+			quotas := listQuotas(cl)
+			Expect(quotas).To(HaveLen(1))
+			Expect(quotas[0].Namespace).To(Equal("ns2"))
+
+			By("trying again later, because the namespace creation does not trigger a reconciliation")
+			Expect(req.RequeueAfter).To(Equal(tenantQuotaRetryInterval))
+
+			Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{
+				{
+					EventType: corev1.EventTypeWarning,
+					Reason:    tenantQuotaNamespaceNotFoundReason,
+					Msg:       "Can't create the default-quota VirtualMachineMigrationResourceQuota; the ns1 namespace does not exist",
+				},
+			})).To(BeTrue())
+		})
+
+		It("should not create the quotas if the feature gate is disabled", func() {
+			hco.Spec.FeatureGates.EnableManagedTenantQuota = ptr.To(false)
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := newTenantQuotaHandler(cl, commontestutils.GetScheme(), nil)
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeFalse())
+
+			Expect(listQuotas(cl)).To(BeEmpty())
+		})
+
+		It("should not create the quotas on a single node cluster", func() {
+			hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+				return &commontestutils.ClusterInfoSNOMock{}
+			}
+
+			cl := commontestutils.InitClient([]client.Object{hco})
+			handler := newTenantQuotaHandler(cl, commontestutils.GetScheme(), nil)
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(listQuotas(cl)).To(BeEmpty())
+		})
+
+		It("should reconcile a modified quota", func() {
+			modified := NewTenantQuotas(hco)[client.ObjectKey{Namespace: "ns1", Name: "default-quota"}]
+			modified.Spec.AdditionalMigrationResources[corev1.ResourceRequestsMemory] = resource.MustParse("1Gi")
+
+			cl := commontestutils.InitClient([]client.Object{hco, modified})
+			handler := newTenantQuotaHandler(cl, commontestutils.GetScheme(), nil)
+
+			req.HCOTriggered = false
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+			Expect(res.Overwritten).To(BeTrue())
+
+			found := &mtqv1alpha1.VirtualMachineMigrationResourceQuota{}
+			Expect(cl.Get(context.TODO(), client.ObjectKey{Namespace: "ns1", Name: "default-quota"}, found)).To(Succeed())
+			Expect(found.Spec.AdditionalMigrationResources).To(HaveKeyWithValue(corev1.ResourceRequestsMemory, resource.MustParse("4Gi")))
+		})
+
+		It("should remove quotas that are no longer required", func() {
+			stale := &mtqv1alpha1.VirtualMachineMigrationResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "old-quota",
+					Namespace: "ns3",
+					Labels:    getLabels(hco, hcoutil.AppComponentMultiTenant),
+				},
+			}
+			unmanaged := &mtqv1alpha1.VirtualMachineMigrationResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "user-quota",
+					Namespace: "ns3",
+				},
+			}
+
+			hco.Spec.FeatureGates.EnableManagedTenantQuota = ptr.To(false)
+			cl := commontestutils.InitClient([]client.Object{hco, stale, unmanaged})
+			handler := newTenantQuotaHandler(cl, commontestutils.GetScheme(), nil)
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Deleted).To(BeTrue())
+
+			quotas := listQuotas(cl)
+			Expect(quotas).To(HaveLen(1))
+			Expect(quotas[0].Name).To(Equal("user-quota"))
+		})
+	})
+})
@@ -0,0 +1,212 @@
+package operands
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	// trustedCABundleConfigMapName is the name of the copies of the trusted CA bundle, that HCO creates in the
+	// namespaces of the DataImportCronTemplates
+	trustedCABundleConfigMapName = "hco-trusted-ca-bundle"
+
+	trustedCABundleNotFoundReason = "TrustedCABundleNotFound"
+)
+
+// trustedCABundleOperand copies the ConfigMap that is set in the trustedCABundle field of the HyperConverged CR, to
+// the namespaces of the DataImportCronTemplates that use it as their certConfigMap, and removes the copies that are
+// no longer required.
+//
+// The source ConfigMap is created by the user, without the HCO labels, so it is not in the cache of HCO, and is read
+// directly from the API server. The copies are in other namespaces, so HCO can't set an owner reference on them.
+// Instead, the copies are identified by the HCO labels, and are explicitly removed when the HyperConverged CR is
+// deleted.
+type trustedCABundleOperand struct {
+	Client       client.Client
+	APIReader    client.Reader
+	Scheme       *runtime.Scheme
+	eventEmitter hcoutil.EventEmitter
+}
+
+func newTrustedCABundleHandler(Client client.Client, APIReader client.Reader, Scheme *runtime.Scheme, eventEmitter hcoutil.EventEmitter) Operand {
+	return &trustedCABundleOperand{
+		Client:       Client,
+		APIReader:    APIReader,
+		Scheme:       Scheme,
+		eventEmitter: eventEmitter,
+	}
+}
+
+func (h trustedCABundleOperand) ensure(req *common.HcoRequest) *EnsureResult {
+	res := NewEnsureResult(&corev1.ConfigMap{})
+
+	required, err := h.getRequiredConfigMaps(req)
+	if apierrors.IsNotFound(err) {
+		// the source ConfigMap was removed or renamed. Don't block the other operands, and keep the existing copies,
+		// so the imports keep using the last known CA bundle. HCO reconciles again once the ConfigMap is created.
+		req.Logger.Info("Can't copy the trusted CA bundle; the ConfigMap does not exist", "namespace", req.Instance.Namespace, "name", *req.Instance.Spec.TrustedCABundle)
+		h.emitSourceNotFoundEvent(req)
+		return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
+	} else if err != nil {
+		return res.Error(err)
+	}
+
+	existing, err := listTrustedCABundleConfigMaps(req.Ctx, h.Client, req.Instance)
+	if err != nil {
+		return res.Error(err)
+	}
+
+	cl := common.NewCustomMetadataClient(h.Client, req.Instance)
+	for _, found := range existing {
+		key := client.ObjectKeyFromObject(found)
+
+		cm, ok := required[key]
+		if !ok {
+			req.Logger.Info("Removing a trusted CA bundle ConfigMap that is no longer required", "namespace", found.Namespace, "name", found.Name)
+			if err = h.Client.Delete(req.Ctx, found); client.IgnoreNotFound(err) != nil {
+				return res.Error(err)
+			}
+			res.SetName(found.Name).SetDeleted()
+			continue
+		}
+		delete(required, key)
+
+		hasCustomMetadata := common.StripCustomMetadata(req.Instance, found)
+		if !reflect.DeepEqual(found.Data, cm.Data) || !reflect.DeepEqual(found.Labels, cm.Labels) || !hasCustomMetadata {
+			if req.HCOTriggered {
+				req.Logger.Info("Updating existing trusted CA bundle ConfigMap to new opinionated values", "namespace", found.Namespace, "name", found.Name)
+			} else {
+				req.Logger.Info("Reconciling an externally updated trusted CA bundle ConfigMap to its opinionated values", "namespace", found.Namespace, "name", found.Name)
+			}
+			fieldManager := hcoutil.GetLastFieldManager(found)
+			hcoutil.DeepCopyLabels(&cm.ObjectMeta, &found.ObjectMeta)
+			found.Data = cm.Data
+			found.BinaryData = nil
+			if err = cl.Update(req.Ctx, found); err != nil {
+				return res.Error(err)
+			}
+			res.SetName(found.Name).SetNamespace(found.Namespace).SetUpdated().SetOverwritten(!req.HCOTriggered).SetFieldManager(fieldManager)
+		}
+	}
+
+	for _, cm := range required {
+		req.Logger.Info("Creating the trusted CA bundle ConfigMap", "namespace", cm.Namespace, "name", cm.Name)
+		err = cl.Create(req.Ctx, cm)
+		if apierrors.IsNotFound(err) {
+			// the namespace was not created yet, by SSP or by the user. HCO will retry on the next reconciliation.
+			req.Logger.Info("Can't create the trusted CA bundle ConfigMap; the namespace does not exist yet", "namespace", cm.Namespace)
+			continue
+		} else if err != nil {
+			return res.Error(fmt.Errorf("failed to create the %s ConfigMap in the %s namespace; %w", cm.Name, cm.Namespace, err))
+		}
+		res.SetName(cm.Name).SetCreated()
+	}
+
+	return res.SetUpgradeDone(req.ComponentUpgradeInProgress)
+}
+
+func (h trustedCABundleOperand) reset() { /* no implementation */ }
+
+func (h trustedCABundleOperand) getRequiredConfigMaps(req *common.HcoRequest) (map[types.NamespacedName]*corev1.ConfigMap, error) {
+	if req.Instance.Spec.TrustedCABundle == nil {
+		return nil, nil
+	}
+
+	source := &corev1.ConfigMap{}
+	key := client.ObjectKey{Namespace: req.Instance.Namespace, Name: *req.Instance.Spec.TrustedCABundle}
+	if err := h.APIReader.Get(req.Ctx, key, source); err != nil {
+		return nil, fmt.Errorf("failed to read the %s trusted CA bundle ConfigMap; %w", key.Name, err)
+	}
+
+	return NewTrustedCABundleConfigMaps(req.Instance, source)
+}
+
+func (h trustedCABundleOperand) emitSourceNotFoundEvent(req *common.HcoRequest) {
+	if h.eventEmitter == nil {
+		return
+	}
+
+	msg := fmt.Sprintf("Can't copy the trusted CA bundle; the %s ConfigMap does not exist in the %s namespace", *req.Instance.Spec.TrustedCABundle, req.Instance.Namespace)
+	h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, trustedCABundleNotFoundReason, msg)
+}
+
+// listTrustedCABundleConfigMaps returns the copies of the trusted CA bundle that HCO created, in all the namespaces
+func listTrustedCABundleConfigMaps(ctx context.Context, cl client.Client, hc *hcov1beta1.HyperConverged) ([]*corev1.ConfigMap, error) {
+	cmList := &corev1.ConfigMapList{}
+	err := cl.List(ctx, cmList, client.MatchingLabels{
+		hcoutil.AppLabel:          hc.Name,
+		hcoutil.AppLabelComponent: string(hcoutil.AppComponentStorage),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var configMaps []*corev1.ConfigMap
+	for i := range cmList.Items {
+		if cmList.Items[i].Name == trustedCABundleConfigMapName {
+			configMaps = append(configMaps, &cmList.Items[i])
+		}
+	}
+
+	return configMaps, nil
+}
+
+// NewTrustedCABundleConfigMaps returns the copies of the trusted CA bundle, by their namespaced name, for the
+// namespaces of the DataImportCronTemplates that use the trusted CA bundle as their certConfigMap
+func NewTrustedCABundleConfigMaps(hc *hcov1beta1.HyperConverged, source *corev1.ConfigMap) (map[types.NamespacedName]*corev1.ConfigMap, error) {
+	dicts, err := getDataImportCronTemplates(hc)
+	if err != nil {
+		return nil, err
+	}
+
+	configMaps := make(map[types.NamespacedName]*corev1.ConfigMap)
+	for _, dict := range dicts {
+		if dict.Spec == nil || dict.Spec.Template.Spec.Source == nil || dict.Spec.Template.Spec.Source.Registry == nil {
+			continue
+		}
+
+		certConfigMap := dict.Spec.Template.Spec.Source.Registry.CertConfigMap
+		if certConfigMap == nil || *certConfigMap != trustedCABundleConfigMapName {
+			continue
+		}
+
+		namespace := dict.Namespace
+		if namespace == "" {
+			namespace = defaultGoldenImagesNamespace
+		}
+
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      trustedCABundleConfigMapName,
+				Namespace: namespace,
+				Labels:    getLabels(hc, hcoutil.AppComponentStorage),
+			},
+			Data: getTrustedCABundleData(source),
+		}
+		configMaps[client.ObjectKeyFromObject(cm)] = cm
+	}
+
+	return configMaps, nil
+}
+
+// getTrustedCABundleData returns the data of the source ConfigMap. The CDI importer mounts all the keys of the
+// certConfigMap, so all the keys are copied as is.
+func getTrustedCABundleData(source *corev1.ConfigMap) map[string]string {
+	data := make(map[string]string, len(source.Data))
+	for key, value := range source.Data {
+		data[key] = value
+	}
+	return data
+}
@@ -0,0 +1,288 @@
+package operands
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Trusted CA bundle tests", func() {
+	const (
+		sourceName = "my-ca-bundle"
+		caBundle   = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+	)
+
+	var (
+		hco    *v1beta1.HyperConverged
+		req    *common.HcoRequest
+		source *corev1.ConfigMap
+	)
+
+	newDict := func(name, namespace string, source cdiv1beta1.DataVolumeSource) v1beta1.DataImportCronTemplate {
+		return v1beta1.DataImportCronTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: &cdiv1beta1.DataImportCronSpec{
+				Schedule: "1 */12 * * *",
+				Template: cdiv1beta1.DataVolume{
+					Spec: cdiv1beta1.DataVolumeSpec{
+						Source: &source,
+					},
+				},
+				ManagedDataSource: name,
+			},
+		}
+	}
+
+	registrySource := func(certConfigMap *string) cdiv1beta1.DataVolumeSource {
+		return cdiv1beta1.DataVolumeSource{
+			Registry: &cdiv1beta1.DataVolumeSourceRegistry{
+				URL:           ptr.To("docker://mirror.example.com/containerdisks/fedora:latest"),
+				CertConfigMap: certConfigMap,
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		hco.Spec.FeatureGates.EnableCommonBootImageImport = ptr.To(false)
+		hco.Spec.TrustedCABundle = ptr.To(sourceName)
+		hco.Spec.DataImportCronTemplates = []v1beta1.DataImportCronTemplate{
+			newDict("default-ns-image", "", registrySource(nil)),
+			newDict("custom-ns-image", "custom-ns", registrySource(nil)),
+			newDict("own-cert-image", "other-ns", registrySource(ptr.To("own-cert"))),
+			newDict("http-image", "http-ns", cdiv1beta1.DataVolumeSource{
+				HTTP: &cdiv1beta1.DataVolumeSourceHTTP{URL: "https://example.com/disk.img"},
+			}),
+		}
+		req = commontestutils.NewReq(hco)
+
+		source = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      sourceName,
+				Namespace: commontestutils.Namespace,
+			},
+			Data: map[string]string{"ca.crt": caBundle},
+		}
+	})
+
+	listCopies := func(cl client.Client) []corev1.ConfigMap {
+		cms := &corev1.ConfigMapList{}
+		Expect(cl.List(context.TODO(), cms)).To(Succeed())
+
+		var copies []corev1.ConfigMap
+		for _, cm := range cms.Items {
+			if cm.Name == trustedCABundleConfigMapName {
+				copies = append(copies, cm)
+			}
+		}
+		return copies
+	}
+
+	Context("test the DataImportCronTemplates", func() {
+		It("should set the certConfigMap of the registry sources that do not set their own", func() {
+			dicts, err := getDataImportCronTemplates(hco)
+			Expect(err).ToNot(HaveOccurred())
+
+			certConfigMaps := make(map[string]*string)
+			for _, dict := range dicts {
+				if dict.Spec.Template.Spec.Source.Registry != nil {
+					certConfigMaps[dict.Name] = dict.Spec.Template.Spec.Source.Registry.CertConfigMap
+				}
+			}
+
+			Expect(certConfigMaps).To(HaveLen(3))
+			Expect(certConfigMaps["default-ns-image"]).To(HaveValue(Equal(trustedCABundleConfigMapName)))
+			Expect(certConfigMaps["custom-ns-image"]).To(HaveValue(Equal(trustedCABundleConfigMapName)))
+			Expect(certConfigMaps["own-cert-image"]).To(HaveValue(Equal("own-cert")))
+
+			By("not modifying the HyperConverged CR")
+			Expect(hco.Spec.DataImportCronTemplates[0].Spec.Template.Spec.Source.Registry.CertConfigMap).To(BeNil())
+		})
+
+		It("should not set the certConfigMap if the trusted CA bundle is not set", func() {
+			hco.Spec.TrustedCABundle = nil
+			dicts, err := getDataImportCronTemplates(hco)
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, dict := range dicts {
+				if dict.Name != "own-cert-image" && dict.Spec.Template.Spec.Source.Registry != nil {
+					Expect(dict.Spec.Template.Spec.Source.Registry.CertConfigMap).To(BeNil())
+				}
+			}
+		})
+	})
+
+	Context("test NewTrustedCABundleConfigMaps", func() {
+		It("should create a copy for each namespace of a DataImportCronTemplate that uses the trusted CA bundle", func() {
+			cms, err := NewTrustedCABundleConfigMaps(hco, source)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cms).To(HaveLen(2))
+
+			for _, ns := range []string{defaultGoldenImagesNamespace, "custom-ns"} {
+				cm := cms[client.ObjectKey{Namespace: ns, Name: trustedCABundleConfigMapName}]
+				Expect(cm).ToNot(BeNil())
+				Expect(cm.Labels).To(HaveKeyWithValue(hcoutil.AppLabelComponent, string(hcoutil.AppComponentStorage)))
+				Expect(cm.Data).To(Equal(source.Data))
+			}
+		})
+	})
+
+	Context("test trustedCABundleOperand", func() {
+		It("should create the copies of the trusted CA bundle", func() {
+			cl := commontestutils.InitClient([]client.Object{hco, source})
+			handler := newTrustedCABundleHandler(cl, cl, commontestutils.GetScheme(), nil)
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeTrue())
+
+			copies := listCopies(cl)
+			Expect(copies).To(HaveLen(2))
+			for _, cm := range copies {
+				Expect(cm.Data).To(HaveKeyWithValue("ca.crt", caBundle))
+			}
+		})
+
+		It("should not create any copy if the trusted CA bundle is not set", func() {
+			hco.Spec.TrustedCABundle = nil
+			cl := commontestutils.InitClient([]client.Object{hco, source})
+			handler := newTrustedCABundleHandler(cl, cl, commontestutils.GetScheme(), nil)
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeFalse())
+			Expect(listCopies(cl)).To(BeEmpty())
+		})
+
+		It("should emit an event and skip, if the trusted CA bundle ConfigMap does not exist", func() {
+			cl := commontestutils.InitClient([]client.Object{hco})
+			eventEmitter := commontestutils.NewEventEmitterMock()
+			handler := newTrustedCABundleHandler(cl, cl, commontestutils.GetScheme(), eventEmitter)
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Created).To(BeFalse())
+			Expect(listCopies(cl)).To(BeEmpty())
+
+			Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{
+				{
+					EventType: corev1.EventTypeWarning,
+					Reason:    trustedCABundleNotFoundReason,
+					Msg:       "Can't copy the trusted CA bundle; the my-ca-bundle ConfigMap does not exist in the kubevirt-hyperconverged namespace",
+				},
+			})).To(BeTrue())
+		})
+
+		It("should keep the existing copies, if the trusted CA bundle ConfigMap was removed", func() {
+			cl := commontestutils.InitClient([]client.Object{hco, source})
+			handler := newTrustedCABundleHandler(cl, cl, commontestutils.GetScheme(), nil)
+
+			Expect(handler.ensure(req).Err).ToNot(HaveOccurred())
+			Expect(listCopies(cl)).To(HaveLen(2))
+
+			Expect(cl.Delete(context.TODO(), source)).To(Succeed())
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Deleted).To(BeFalse())
+			Expect(listCopies(cl)).To(HaveLen(2))
+		})
+
+		It("should fail if the trusted CA bundle ConfigMap can't be read", func() {
+			cl := commontestutils.InitClient([]client.Object{hco, source})
+			cl.InitiateGetErrors(func(key client.ObjectKey) error {
+				if key.Name == sourceName {
+					return errors.New("fake get error")
+				}
+				return nil
+			})
+			handler := newTrustedCABundleHandler(cl, cl, commontestutils.GetScheme(), nil)
+
+			res := handler.ensure(req)
+			Expect(res.Err).To(MatchError(ContainSubstring("failed to read the my-ca-bundle trusted CA bundle ConfigMap")))
+			Expect(listCopies(cl)).To(BeEmpty())
+		})
+
+		It("should reconcile a modified copy", func() {
+			cms, err := NewTrustedCABundleConfigMaps(hco, source)
+			Expect(err).ToNot(HaveOccurred())
+			modified := cms[client.ObjectKey{Namespace: "custom-ns", Name: trustedCABundleConfigMapName}]
+			modified.Data = map[string]string{"ca.crt": "modified"}
+
+			cl := commontestutils.InitClient([]client.Object{hco, source, modified})
+			handler := newTrustedCABundleHandler(cl, cl, commontestutils.GetScheme(), nil)
+
+			req.HCOTriggered = false
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+			Expect(res.Overwritten).To(BeTrue())
+
+			found := &corev1.ConfigMap{}
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(modified), found)).To(Succeed())
+			Expect(found.Data).To(Equal(source.Data))
+		})
+
+		It("should propagate a modified trusted CA bundle", func() {
+			cl := commontestutils.InitClient([]client.Object{hco, source})
+			handler := newTrustedCABundleHandler(cl, cl, commontestutils.GetScheme(), nil)
+
+			Expect(handler.ensure(req).Err).ToNot(HaveOccurred())
+
+			source.Data = map[string]string{"ca.crt": caBundle + caBundle}
+			Expect(cl.Update(context.TODO(), source)).To(Succeed())
+
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+			Expect(res.Overwritten).To(BeFalse())
+
+			for _, cm := range listCopies(cl) {
+				Expect(cm.Data).To(Equal(source.Data))
+			}
+		})
+
+		It("should remove the copies if the trusted CA bundle was removed", func() {
+			cl := commontestutils.InitClient([]client.Object{hco, source})
+			handler := newTrustedCABundleHandler(cl, cl, commontestutils.GetScheme(), nil)
+
+			Expect(handler.ensure(req).Err).ToNot(HaveOccurred())
+			Expect(listCopies(cl)).To(HaveLen(2))
+
+			hco.Spec.TrustedCABundle = nil
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Deleted).To(BeTrue())
+			Expect(listCopies(cl)).To(BeEmpty())
+		})
+
+		It("should remove the copy from a namespace that is no longer used", func() {
+			cl := commontestutils.InitClient([]client.Object{hco, source})
+			handler := newTrustedCABundleHandler(cl, cl, commontestutils.GetScheme(), nil)
+
+			Expect(handler.ensure(req).Err).ToNot(HaveOccurred())
+
+			hco.Spec.DataImportCronTemplates = hco.Spec.DataImportCronTemplates[:1]
+			res := handler.ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Deleted).To(BeTrue())
+
+			copies := listCopies(cl)
+			Expect(copies).To(HaveLen(1))
+			Expect(copies[0].Namespace).To(Equal(defaultGoldenImagesNamespace))
+		})
+	})
+})
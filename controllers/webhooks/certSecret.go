@@ -0,0 +1,100 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync/atomic"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// ReconcileCertSecret serves the webhook certificate from a user provided Secret, instead of the certificate files
+// that are mounted by the OLM. The certificate is reloaded whenever the Secret is modified, so the certificate can be
+// rotated without restarting the webhook.
+type ReconcileCertSecret struct {
+	client client.Reader
+	key    types.NamespacedName
+	cert   atomic.Pointer[tls.Certificate]
+}
+
+// Implement reconcile.Reconciler so the controller can reconcile objects
+var _ reconcile.Reconciler = &ReconcileCertSecret{}
+
+// NewCertSecretReconciler returns a new ReconcileCertSecret, that reads the certificate from the key Secret. The
+// certificate must be loaded with LoadCertificate, before starting the webhook server.
+func NewCertSecretReconciler(cli client.Reader, key types.NamespacedName) *ReconcileCertSecret {
+	return &ReconcileCertSecret{
+		client: cli,
+		key:    key,
+	}
+}
+
+func (r *ReconcileCertSecret) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	if err := r.LoadCertificate(ctx); err != nil {
+		// keep serving the previous certificate, until the Secret is fixed
+		logger.Error(err, "failed to reload the webhook certificate; keep using the previous one")
+		return reconcile.Result{}, err
+	}
+
+	logger.Info("the webhook certificate was reloaded", "secret", r.key.String())
+	return reconcile.Result{}, nil
+}
+
+// LoadCertificate reads the certificate from the Secret, and replaces the served certificate with it
+func (r *ReconcileCertSecret) LoadCertificate(ctx context.Context) error {
+	secret := &corev1.Secret{}
+	if err := r.client.Get(ctx, r.key, secret); err != nil {
+		return fmt.Errorf("failed to read the %s webhook certificate Secret; %w", r.key.String(), err)
+	}
+
+	cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return fmt.Errorf("failed to load the webhook certificate from the %s Secret; %w", r.key.String(), err)
+	}
+
+	r.cert.Store(&cert)
+	return nil
+}
+
+// GetCertificate implements tls.Config.GetCertificate
+func (r *ReconcileCertSecret) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cert := r.cert.Load()
+	if cert == nil {
+		return nil, fmt.Errorf("the webhook certificate was not loaded from the %s Secret", r.key.String())
+	}
+	return cert, nil
+}
+
+// MutateTLSConfig sets the webhook server to serve the certificate from the Secret. When GetCertificate is set, the
+// webhook server does not watch the certificate files.
+func (r *ReconcileCertSecret) MutateTLSConfig(cfg *tls.Config) {
+	cfg.GetCertificate = r.GetCertificate
+}
+
+// RegisterCertSecretReconciler registers the reconciler into manager, to reload the certificate on each change of the
+// Secret.
+func RegisterCertSecretReconciler(mgr manager.Manager, r *ReconcileCertSecret) error {
+	logger.Info("Setting up the webhook certificate Secret controller")
+	c, err := controller.New("hco-webhook-cert-secret-controller", mgr, controller.Options{
+		Reconciler: r,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Watch only the certificate Secret
+	isCertSecret := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetNamespace() == r.key.Namespace && obj.GetName() == r.key.Name
+	})
+
+	return c.Watch(source.Kind(mgr.GetCache(), &corev1.Secret{}), &handler.EnqueueRequestForObject{}, isCertSecret)
+}
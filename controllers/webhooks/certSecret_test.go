@@ -0,0 +1,127 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/tls"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	certutil "k8s.io/client-go/util/cert"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("Webhook certificate Secret", func() {
+	var (
+		key    = types.NamespacedName{Namespace: commontestutils.Namespace, Name: "my-webhook-cert"}
+		secret *corev1.Secret
+	)
+
+	newCertData := func(host string) map[string][]byte {
+		certPEM, keyPEM, err := certutil.GenerateSelfSignedCertKey(host, nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+		return map[string][]byte{
+			corev1.TLSCertKey:       certPEM,
+			corev1.TLSPrivateKeyKey: keyPEM,
+		}
+	}
+
+	getServedCert := func(r *ReconcileCertSecret) *tls.Certificate {
+		cfg := &tls.Config{}
+		r.MutateTLSConfig(cfg)
+		cert, err := cfg.GetCertificate(&tls.ClientHelloInfo{})
+		Expect(err).ToNot(HaveOccurred())
+		return cert
+	}
+
+	BeforeEach(func() {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+			Type: corev1.SecretTypeTLS,
+			Data: newCertData("first.example.com"),
+		}
+	})
+
+	It("should setup the controller", func() {
+		cl := commontestutils.InitClient([]client.Object{})
+		mgr, err := commontestutils.NewManagerMock(&rest.Config{}, manager.Options{}, cl, logger)
+		Expect(err).ToNot(HaveOccurred())
+		mockmgr, ok := mgr.(*commontestutils.ManagerMock)
+		Expect(ok).To(BeTrue())
+
+		Expect(RegisterCertSecretReconciler(mgr, NewCertSecretReconciler(cl, key))).To(Succeed())
+		Expect(mockmgr.GetRunnables()).To(HaveLen(1))
+	})
+
+	It("should serve the certificate from the Secret", func() {
+		cl := commontestutils.InitClient([]client.Object{secret})
+		r := NewCertSecretReconciler(cl, key)
+
+		Expect(r.LoadCertificate(context.TODO())).To(Succeed())
+
+		expected, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(getServedCert(r).Certificate).To(Equal(expected.Certificate))
+	})
+
+	It("should fail to serve before the certificate was loaded", func() {
+		r := NewCertSecretReconciler(commontestutils.InitClient([]client.Object{}), key)
+
+		_, err := r.GetCertificate(&tls.ClientHelloInfo{})
+		Expect(err).To(MatchError(ContainSubstring("the webhook certificate was not loaded")))
+	})
+
+	It("should fail if the Secret does not exist", func() {
+		r := NewCertSecretReconciler(commontestutils.InitClient([]client.Object{}), key)
+
+		Expect(r.LoadCertificate(context.TODO())).To(MatchError(ContainSubstring("failed to read the " + key.String() + " webhook certificate Secret")))
+	})
+
+	It("should fail if the Secret does not contain a valid certificate", func() {
+		secret.Data[corev1.TLSPrivateKeyKey] = []byte("not a key")
+		r := NewCertSecretReconciler(commontestutils.InitClient([]client.Object{secret}), key)
+
+		Expect(r.LoadCertificate(context.TODO())).To(MatchError(ContainSubstring("failed to load the webhook certificate")))
+	})
+
+	It("should reload a rotated certificate", func() {
+		cl := commontestutils.InitClient([]client.Object{secret})
+		r := NewCertSecretReconciler(cl, key)
+		Expect(r.LoadCertificate(context.TODO())).To(Succeed())
+		first := getServedCert(r)
+
+		secret.Data = newCertData("second.example.com")
+		Expect(cl.Update(context.TODO(), secret)).To(Succeed())
+
+		_, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: key})
+		Expect(err).ToNot(HaveOccurred())
+
+		second := getServedCert(r)
+		Expect(second.Certificate).ToNot(Equal(first.Certificate))
+	})
+
+	It("should keep serving the previous certificate if the rotated one is invalid", func() {
+		cl := commontestutils.InitClient([]client.Object{secret})
+		r := NewCertSecretReconciler(cl, key)
+		Expect(r.LoadCertificate(context.TODO())).To(Succeed())
+		first := getServedCert(r)
+
+		secret.Data = map[string][]byte{corev1.TLSCertKey: []byte("broken")}
+		Expect(cl.Update(context.TODO(), secret)).To(Succeed())
+
+		_, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: key})
+		Expect(err).To(HaveOccurred())
+
+		Expect(getServedCert(r).Certificate).To(Equal(first.Certificate))
+	})
+})
@@ -17,10 +17,12 @@ import (
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
-// ReconcileAPIServer reconciles APIServer to consume uptodate TLSSecurityProfile
+// ReconcileAPIServer reconciles APIServer to consume uptodate TLSSecurityProfile, and periodically refreshes the rest
+// of the cluster information, that is used by the validations of the webhook
 type ReconcileAPIServer struct {
-	client client.Client
-	ci     hcoutil.ClusterInfo
+	client    client.Client
+	apiReader client.Reader
+	ci        hcoutil.ClusterInfo
 }
 
 var (
@@ -37,6 +39,11 @@ func (r *ReconcileAPIServer) Reconcile(ctx context.Context, _ reconcile.Request)
 	if err != nil {
 		return reconcile.Result{Requeue: true}, err
 	}
+
+	if _, err = r.ci.RefreshClusterInfo(ctx, r.apiReader); err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
 	return reconcile.Result{RequeueAfter: 1 * time.Minute}, nil
 }
 
@@ -51,8 +58,9 @@ func RegisterReconciler(mgr manager.Manager, ci hcoutil.ClusterInfo) error {
 // newReconciler returns a new reconcile.Reconciler
 func newReconciler(mgr manager.Manager, ci hcoutil.ClusterInfo) reconcile.Reconciler {
 	r := &ReconcileAPIServer{
-		client: mgr.GetClient(),
-		ci:     ci,
+		client:    mgr.GetClient(),
+		apiReader: mgr.GetAPIReader(),
+		ci:        ci,
 	}
 	return r
 }
@@ -160,8 +160,9 @@ var _ = Describe("HyperconvergedController", func() {
 				Expect(ci.GetTLSSecurityProfile(nil)).To(Equal(initialTLSSecurityProfile), "should return the initial value)")
 
 				r := ReconcileAPIServer{
-					client: cl,
-					ci:     ci,
+					client:    cl,
+					apiReader: cl,
+					ci:        ci,
 				}
 
 				request := reconcile.Request{
@@ -189,6 +190,16 @@ var _ = Describe("HyperconvergedController", func() {
 
 				Expect(hcoutil.GetClusterInfo().GetTLSSecurityProfile(nil)).To(Equal(customTLSSecurityProfile), "should return the up-to-date value")
 
+				By("refreshing the rest of the cluster information")
+				Expect(ci.IsInfrastructureHighlyAvailable()).To(BeTrue())
+
+				infrastructure.Status.InfrastructureTopology = openshiftconfigv1.SingleReplicaTopologyMode
+				Expect(cl.Status().Update(context.TODO(), infrastructure)).To(Succeed())
+
+				_, err = r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ci.IsInfrastructureHighlyAvailable()).To(BeFalse())
+				Expect(ci.GetInfrastructureTopology()).To(Equal(openshiftconfigv1.SingleReplicaTopologyMode))
 			})
 
 		})
@@ -43,6 +43,7 @@ const (
 	hcoDeploymentName   = "hco-operator"
 	hcoWhDeploymentName = "hco-webhook"
 	certVolume          = "apiservice-cert"
+	metricsCertVolume   = "metrics-cert"
 
 	cliDownloadsName = "hyperconverged-cluster-cli-download"
 
@@ -262,6 +263,33 @@ func GetDeploymentSpecOperator(params *DeploymentOperatorParams) appsv1.Deployme
 							},
 						},
 						SecurityContext: GetStdContainerSecurityContext(),
+						Ports: []corev1.ContainerPort{
+							{
+								Name:          hcoutil.MetricsPortName,
+								ContainerPort: hcoutil.MetricsPort,
+								Protocol:      corev1.ProtocolTCP,
+							},
+						},
+						VolumeMounts: []corev1.VolumeMount{
+							{
+								Name:      metricsCertVolume,
+								MountPath: hcoutil.MetricsCertDir,
+								ReadOnly:  true,
+							},
+						},
+					},
+				},
+				Volumes: []corev1.Volume{
+					{
+						Name: metricsCertVolume,
+						VolumeSource: corev1.VolumeSource{
+							Secret: &corev1.SecretVolumeSource{
+								SecretName: hcoutil.MetricsServingCertSecretName,
+								// The secret is generated only after HCO creates the metrics service, and only
+								// on OpenShift
+								Optional: ptr.To(true),
+							},
+						},
 					},
 				},
 				PriorityClassName: "system-cluster-critical",
@@ -444,6 +472,18 @@ var (
 	emptyAPIGroup = []string{""}
 )
 
+// GetPermissions returns the permissions of HCO in its own namespace
+func GetPermissions() []rbacv1.PolicyRule {
+	return []rbacv1.PolicyRule{
+		// the webhook reads its serving certificate from a user provided Secret
+		{
+			APIGroups: emptyAPIGroup,
+			Resources: stringListToSlice("secrets"),
+			Verbs:     stringListToSlice("get", "list", "watch"),
+		},
+	}
+}
+
 func GetClusterPermissions() []rbacv1.PolicyRule {
 	const configOpenshiftIO = "config.openshift.io"
 	return []rbacv1.PolicyRule{
@@ -458,10 +498,31 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 			Verbs:     stringListToSlice("get", "list", "create", "update", "watch"),
 		},
 		roleWithAllPermissions("kubevirt.io", stringListToSlice("kubevirts", "kubevirts/finalizers")),
+		{
+			APIGroups: stringListToSlice("kubevirt.io"),
+			Resources: stringListToSlice("virtualmachines"),
+			Verbs:     stringListToSlice("get", "list"),
+		},
 		roleWithAllPermissions("cdi.kubevirt.io", stringListToSlice("cdis", "cdis/finalizers")),
+		{
+			APIGroups: stringListToSlice("cdi.kubevirt.io"),
+			Resources: stringListToSlice("dataimportcrons"),
+			Verbs:     stringListToSlice("get", "list", "watch"),
+		},
+		{
+			APIGroups: stringListToSlice("storage.k8s.io"),
+			Resources: stringListToSlice("storageclasses"),
+			Verbs:     stringListToSlice("get", "list", "watch"),
+		},
+		{
+			APIGroups: stringListToSlice("k8s.cni.cncf.io"),
+			Resources: stringListToSlice("network-attachment-definitions"),
+			Verbs:     stringListToSlice("get", "list", "watch"),
+		},
 		roleWithAllPermissions("ssp.kubevirt.io", stringListToSlice("ssps", "ssps/finalizers")),
 		roleWithAllPermissions("networkaddonsoperator.network.kubevirt.io", stringListToSlice("networkaddonsconfigs", "networkaddonsconfigs/finalizers")),
-		roleWithAllPermissions("mtq.kubevirt.io", stringListToSlice("mtqs", "mtqs/finalizers")),
+		roleWithAllPermissions("mtq.kubevirt.io", stringListToSlice("mtqs", "mtqs/finalizers", "virtualmachinemigrationresourcequotas")),
+		roleWithAllPermissions("migrations.kubevirt.io", stringListToSlice("migrationpolicies")),
 		roleWithAllPermissions("", stringListToSlice("configmaps")),
 		{
 			APIGroups: emptyAPIGroup,
@@ -477,7 +538,7 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 		{
 			APIGroups: emptyAPIGroup,
 			Resources: stringListToSlice("nodes"),
-			Verbs:     stringListToSlice("get", "list"),
+			Verbs:     stringListToSlice("get", "list", "watch"),
 		},
 		{
 			APIGroups: emptyAPIGroup,
@@ -487,7 +548,7 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 		{
 			APIGroups: emptyAPIGroup,
 			Resources: stringListToSlice("namespaces"),
-			Verbs:     stringListToSlice("get", "list", "watch", "patch", "update"),
+			Verbs:     stringListToSlice("get", "list", "watch", "patch", "update", "create"),
 		},
 		{
 			APIGroups: stringListToSlice("apps"),
@@ -505,7 +566,13 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 			Resources: stringListToSlice("customresourcedefinitions/status"),
 			Verbs:     stringListToSlice("get", "list", "watch", "patch", "update"),
 		},
-		roleWithAllPermissions("monitoring.coreos.com", stringListToSlice("servicemonitors", "prometheusrules")),
+		roleWithAllPermissions("monitoring.coreos.com", stringListToSlice("servicemonitors", "podmonitors", "prometheusrules", "alertmanagerconfigs", "scrapeconfigs")),
+		{
+			APIGroups: stringListToSlice("monitoring.coreos.com"),
+			Resources: stringListToSlice("prometheuses"),
+			Verbs:     stringListToSlice("get", "list", "watch"),
+		},
+		roleWithAllPermissions("networking.k8s.io", stringListToSlice("networkpolicies")),
 		{
 			APIGroups: stringListToSlice("operators.coreos.com"),
 			Resources: stringListToSlice("clusterserviceversions"),
@@ -706,7 +773,12 @@ func GetInstallStrategyBase(params *DeploymentOperatorParams) *csvv1alpha1.Strat
 				Label: getLabels(cliDownloadsName, params.HcoKvIoVersion),
 			},
 		},
-		Permissions: []csvv1alpha1.StrategyDeploymentPermissions{},
+		Permissions: []csvv1alpha1.StrategyDeploymentPermissions{
+			{
+				ServiceAccountName: hcoName,
+				Rules:              GetPermissions(),
+			},
+		},
 		ClusterPermissions: []csvv1alpha1.StrategyDeploymentPermissions{
 			{
 				ServiceAccountName: hcoName,
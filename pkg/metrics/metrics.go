@@ -3,6 +3,7 @@ package metrics
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	dto "github.com/prometheus/client_model/go"
@@ -12,18 +13,59 @@ import (
 const (
 	counterLabelCompName = "component_name"
 	counterLabelAnnName  = "annotation_name"
+	labelConditionType   = "condition_type"
+	labelKind            = "kind"
+	labelOutcome         = "outcome"
+	labelNamespace       = "resource_namespace"
+	labelFieldManager    = "field_manager"
+	labelReason          = "reason"
+	labelResource        = "resource"
 
 	HCOMetricOverwrittenModifications = "overwrittenModifications"
 	HCOMetricUnsafeModifications      = "unsafeModifications"
 	HCOMetricHyperConvergedExists     = "HyperConvergedCRExists"
 	HCOMetricSystemHealthStatus       = "systemHealthStatus"
 	HCOMetricSingleStackIPv6          = "singleStackIpv6"
+	HCOMetricOperandCondition         = "operandCondition"
+	HCOMetricOperandEnsureDuration    = "operandEnsureDuration"
+	HCOMetricUpgradeable              = "upgradeable"
+	HCOMetricClusterReady             = "clusterReady"
+	HCOMetricReconcileRequeues        = "reconcileRequeues"
+	HCOMetricReconcileConflicts       = "reconcileConflicts"
+	HCOMetricGoldenImagesNotUpToDate  = "goldenImagesNotUpToDate"
+	HCOMetricOperandReconcilePaused   = "operandReconcilePaused"
+	HCOMetricOperandUpgradeTimedOut   = "operandUpgradeTimedOut"
+	HCOMetricComponentHealthStatus    = "componentHealthStatus"
 
 	HyperConvergedExists    = float64(1)
 	HyperConvergedNotExists = float64(0)
 
 	SingleStackIPv6True  = float64(1)
 	SingleStackIPv6False = float64(0)
+
+	OperandConditionTrue  = float64(1)
+	OperandConditionFalse = float64(0)
+
+	UpgradeableTrue  = float64(1)
+	UpgradeableFalse = float64(0)
+
+	ClusterReadyTrue  = float64(1)
+	ClusterReadyFalse = float64(0)
+
+	ReconcilePausedTrue  = float64(1)
+	ReconcilePausedFalse = float64(0)
+
+	UpgradeTimedOutTrue  = float64(1)
+	UpgradeTimedOutFalse = float64(0)
+
+	RequeueReasonError     = "error"
+	RequeueReasonRequested = "requested"
+
+	EnsureOutcomeError     = "error"
+	EnsureOutcomeCreated   = "created"
+	EnsureOutcomeUpdated   = "updated"
+	EnsureOutcomeDeleted   = "deleted"
+	EnsureOutcomeUnchanged = "unchanged"
 )
 
 const (
@@ -49,9 +91,9 @@ var HcoMetrics = func() hcoMetrics {
 	metricDescList := map[string]metricDesc{
 		HCOMetricOverwrittenModifications: {
 			fqName:          "kubevirt_hco_out_of_band_modifications_total",
-			help:            "Count of out-of-band modifications overwritten by HCO",
+			help:            "Count of out-of-band modifications overwritten by HCO. The field_manager label is the field manager of the last modification, according to the managed fields of the modified resource",
 			mType:           "Counter",
-			constLabelPairs: []string{counterLabelCompName},
+			constLabelPairs: []string{counterLabelCompName, labelKind, labelNamespace, labelFieldManager},
 			initFunc: func(md metricDesc) prometheus.Collector {
 				return prometheus.NewCounterVec(
 					prometheus.CounterOpts{
@@ -118,6 +160,151 @@ var HcoMetrics = func() hcoMetrics {
 					})
 			},
 		},
+		HCOMetricOperandCondition: {
+			fqName:          "kubevirt_hco_operand_condition",
+			help:            "Indicates whether the Available, Progressing or Degraded condition of an operand managed by HCO is true (1) or false (0)",
+			mType:           "Gauge",
+			constLabelPairs: []string{counterLabelCompName, labelConditionType},
+			initFunc: func(md metricDesc) prometheus.Collector {
+				return prometheus.NewGaugeVec(
+					prometheus.GaugeOpts{
+						Name: md.fqName,
+						Help: md.help,
+					},
+					md.constLabelPairs,
+				)
+			},
+		},
+		HCOMetricOperandEnsureDuration: {
+			fqName:          "kubevirt_hco_operand_ensure_duration_seconds",
+			help:            "Duration of ensuring a resource managed by HCO, in seconds, by the kind of the resource and by the outcome (error, created, updated, deleted or unchanged)",
+			mType:           "Histogram",
+			constLabelPairs: []string{labelKind, labelOutcome},
+			initFunc: func(md metricDesc) prometheus.Collector {
+				return prometheus.NewHistogramVec(
+					prometheus.HistogramOpts{
+						Name:    md.fqName,
+						Help:    md.help,
+						Buckets: prometheus.ExponentialBuckets(0.001, 2, 15),
+					},
+					md.constLabelPairs,
+				)
+			},
+		},
+		HCOMetricUpgradeable: {
+			fqName:          "kubevirt_hco_upgradeable",
+			help:            "Indicates whether the Upgradeable condition of the HyperConverged custom resource is true (1) or false (0)",
+			mType:           "Gauge",
+			constLabelPairs: []string{counterLabelAnnName},
+			initFunc: func(md metricDesc) prometheus.Collector {
+				return prometheus.NewGauge(
+					prometheus.GaugeOpts{
+						Name: md.fqName,
+						Help: md.help,
+					})
+			},
+		},
+		HCOMetricReconcileRequeues: {
+			fqName:          "kubevirt_hco_reconcile_requeues_total",
+			help:            "Count of the HyperConverged reconciliations that were requeued with backoff. The reason label is error if the reconciliation failed, or requested if HCO requested to reconcile again",
+			mType:           "Counter",
+			constLabelPairs: []string{labelReason},
+			initFunc: func(md metricDesc) prometheus.Collector {
+				return prometheus.NewCounterVec(
+					prometheus.CounterOpts{
+						Name: md.fqName,
+						Help: md.help,
+					},
+					md.constLabelPairs,
+				)
+			},
+		},
+		HCOMetricReconcileConflicts: {
+			fqName:          "kubevirt_hco_reconcile_conflicts_total",
+			help:            "Count of the HyperConverged reconciliations that were retried because of an update conflict. The resource label is the type of the conflicting resource",
+			mType:           "Counter",
+			constLabelPairs: []string{labelResource},
+			initFunc: func(md metricDesc) prometheus.Collector {
+				return prometheus.NewCounterVec(
+					prometheus.CounterOpts{
+						Name: md.fqName,
+						Help: md.help,
+					},
+					md.constLabelPairs,
+				)
+			},
+		},
+		HCOMetricClusterReady: {
+			fqName:          "kubevirt_hco_hyperconverged_cluster_ready",
+			help:            "Indicates whether the HyperConverged custom resource is fully reconciled and available (1) or not (0)",
+			mType:           "Gauge",
+			constLabelPairs: []string{counterLabelAnnName},
+			initFunc: func(md metricDesc) prometheus.Collector {
+				return prometheus.NewGauge(
+					prometheus.GaugeOpts{
+						Name: md.fqName,
+						Help: md.help,
+					})
+			},
+		},
+		HCOMetricGoldenImagesNotUpToDate: {
+			fqName:          "kubevirt_hco_golden_images_not_up_to_date",
+			help:            "Count of the DataImportCrons of the golden images managed by HCO, that are not up to date",
+			mType:           "Gauge",
+			constLabelPairs: []string{counterLabelAnnName},
+			initFunc: func(md metricDesc) prometheus.Collector {
+				return prometheus.NewGauge(
+					prometheus.GaugeOpts{
+						Name: md.fqName,
+						Help: md.help,
+					})
+			},
+		},
+		HCOMetricOperandReconcilePaused: {
+			fqName:          "kubevirt_hco_operand_reconcile_paused",
+			help:            "Indicates whether the reconciliation of an operand CR is paused by the hco.kubevirt.io/pause-reconcile annotation (1) or not (0)",
+			mType:           "Gauge",
+			constLabelPairs: []string{counterLabelCompName},
+			initFunc: func(md metricDesc) prometheus.Collector {
+				return prometheus.NewGaugeVec(
+					prometheus.GaugeOpts{
+						Name: md.fqName,
+						Help: md.help,
+					},
+					md.constLabelPairs,
+				)
+			},
+		},
+		HCOMetricOperandUpgradeTimedOut: {
+			fqName:          "kubevirt_hco_operand_upgrade_timed_out",
+			help:            "Indicates whether an operand did not reach its expected version within the HCO upgrade timeout (1) or not (0)",
+			mType:           "Gauge",
+			constLabelPairs: []string{counterLabelCompName},
+			initFunc: func(md metricDesc) prometheus.Collector {
+				return prometheus.NewGaugeVec(
+					prometheus.GaugeOpts{
+						Name: md.fqName,
+						Help: md.help,
+					},
+					md.constLabelPairs,
+				)
+			},
+		},
+		HCOMetricComponentHealthStatus: {
+			fqName:          "kubevirt_hco_component_health_status",
+			help:            "Indicates whether the health status of an operand managed by HCO is healthy (0), warning (1), or error (2), according to its conditions",
+			mType:           "Gauge",
+			constLabelPairs: []string{counterLabelCompName},
+			initFunc: func(md metricDesc) prometheus.Collector {
+				return prometheus.NewGaugeVec(
+					prometheus.GaugeOpts{
+						Name: md.fqName,
+						Help: md.help,
+					},
+					md.constLabelPairs,
+				)
+			},
+		},
 	}
 
 	metricList := make(map[string]prometheus.Collector)
@@ -136,6 +323,10 @@ var hcoRecordingRules = []MetricDescription{
 		"Indicates whether HCO and its secondary resources health status is healthy (0), warning (1) or critical (2), based both on the firing alerts that impact the operator health, and on kubevirt_hco_system_health_status metric",
 		"Gauge",
 	},
+	{`kubevirt_hco_reconcile_queue_depth`,
+		"The number of the requests that are waiting in the work queue of the HyperConverged controller",
+		"Gauge",
+	},
 }
 
 // hcoMetrics holds all HCO metrics
@@ -233,14 +424,111 @@ func (hm *hcoMetrics) SetMetric(metricName string, label prometheus.Labels, valu
 	return nil
 }
 
+func (hm *hcoMetrics) ObserveMetric(metricName string, label prometheus.Labels, value float64) error {
+	metric, found := hm.metricList[metricName]
+	if !found {
+		return unknownMetricNameError(metricName)
+	}
+
+	switch m := metric.(type) {
+	case *prometheus.HistogramVec:
+		m.With(label).Observe(value)
+
+	default:
+		return unknownMetricTypeError(metricName)
+	}
+
+	return nil
+}
+
+// sumCounterVec sums the values of all the counters that match the partial labels
+func (hm *hcoMetrics) sumCounterVec(metricName string, partialLabels prometheus.Labels) (float64, error) {
+	metric, found := hm.metricList[metricName]
+	if !found {
+		return 0, unknownMetricNameError(metricName)
+	}
+
+	m, ok := metric.(*prometheus.CounterVec)
+	if !ok {
+		return 0, unknownMetricTypeError(metricName)
+	}
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		m.Collect(ch)
+		close(ch)
+	}()
+
+	sum := float64(0)
+	var err error
+	for counter := range ch {
+		res := &dto.Metric{}
+		if writeErr := counter.Write(res); writeErr != nil {
+			err = writeErr
+			continue
+		}
+
+		if matchLabels(res.GetLabel(), partialLabels) {
+			sum += res.Counter.GetValue()
+		}
+	}
+
+	return sum, err
+}
+
+func matchLabels(labelPairs []*dto.LabelPair, partialLabels prometheus.Labels) bool {
+	matched := 0
+	for _, pair := range labelPairs {
+		if val, ok := partialLabels[pair.GetName()]; ok {
+			if val != pair.GetValue() {
+				return false
+			}
+			matched++
+		}
+	}
+
+	return matched == len(partialLabels)
+}
+
+func (hm *hcoMetrics) getHistogramSampleCount(metricName string, label prometheus.Labels) (uint64, error) {
+	metric, found := hm.metricList[metricName]
+	if !found {
+		return 0, unknownMetricNameError(metricName)
+	}
+
+	m, ok := metric.(*prometheus.HistogramVec)
+	if !ok {
+		return 0, unknownMetricTypeError(metricName)
+	}
+
+	observer, err := m.GetMetricWith(label)
+	if err != nil {
+		return 0, err
+	}
+
+	var res = &dto.Metric{}
+	if err = observer.(prometheus.Metric).Write(res); err != nil {
+		return 0, err
+	}
+
+	return res.Histogram.GetSampleCount(), nil
+}
+
 // IncOverwrittenModifications increments counter by 1
-func (hm *hcoMetrics) IncOverwrittenModifications(kind, name string) error {
-	return hm.IncMetric(HCOMetricOverwrittenModifications, getLabelsForObj(kind, name))
+func (hm *hcoMetrics) IncOverwrittenModifications(kind, name, namespace, fieldManager string) error {
+	return hm.IncMetric(HCOMetricOverwrittenModifications, getLabelsForOverwrittenModification(kind, name, namespace, fieldManager))
 }
 
-// GetOverwrittenModificationsCount returns current value of counter. If error is not nil then value is undefined
+// GetOverwrittenModificationsCount returns current value of counter, summed over all the namespaces and field
+// managers. If error is not nil then value is undefined
 func (hm *hcoMetrics) GetOverwrittenModificationsCount(kind, name string) (float64, error) {
-	return hm.GetMetricValue(HCOMetricOverwrittenModifications, getLabelsForObj(kind, name))
+	return hm.sumCounterVec(HCOMetricOverwrittenModifications, getLabelsForObj(kind, name))
+}
+
+// GetOverwrittenModificationsCountByFieldManager returns current value of counter for a specific namespace and field
+// manager. If error is not nil then value is undefined
+func (hm *hcoMetrics) GetOverwrittenModificationsCountByFieldManager(kind, name, namespace, fieldManager string) (float64, error) {
+	return hm.GetMetricValue(HCOMetricOverwrittenModifications, getLabelsForOverwrittenModification(kind, name, namespace, fieldManager))
 }
 
 // SetUnsafeModificationCount sets the counter to the required number
@@ -299,14 +587,184 @@ func (hm *hcoMetrics) IsHCOMetricSingleStackIPv6() (bool, error) {
 	return val == SingleStackIPv6True, nil
 }
 
+// SetHCOMetricOperandCondition sets the gauge of the operand condition to 1 if the condition is true, or to 0 if not
+func (hm *hcoMetrics) SetHCOMetricOperandCondition(operand, conditionType string, status bool) error {
+	value := OperandConditionFalse
+	if status {
+		value = OperandConditionTrue
+	}
+	return hm.SetMetric(HCOMetricOperandCondition, getLabelsForOperandCondition(operand, conditionType), value)
+}
+
+// GetHCOMetricOperandCondition returns current value of the operand condition gauge. If error is not nil then value is undefined
+func (hm *hcoMetrics) GetHCOMetricOperandCondition(operand, conditionType string) (float64, error) {
+	return hm.GetMetricValue(HCOMetricOperandCondition, getLabelsForOperandCondition(operand, conditionType))
+}
+
+// ObserveHCOMetricOperandEnsureDuration records the duration of ensuring a resource of the kind, with the outcome
+func (hm *hcoMetrics) ObserveHCOMetricOperandEnsureDuration(kind, outcome string, duration time.Duration) error {
+	return hm.ObserveMetric(HCOMetricOperandEnsureDuration, getLabelsForEnsureDuration(kind, outcome), duration.Seconds())
+}
+
+// GetHCOMetricOperandEnsureDurationCount returns the number of recorded durations of ensuring a resource of the kind,
+// with the outcome. If error is not nil then value is undefined
+func (hm *hcoMetrics) GetHCOMetricOperandEnsureDurationCount(kind, outcome string) (uint64, error) {
+	return hm.getHistogramSampleCount(HCOMetricOperandEnsureDuration, getLabelsForEnsureDuration(kind, outcome))
+}
+
+// SetHCOMetricUpgradeable sets the gauge to 1 if the Upgradeable condition is true, or to 0 if not
+func (hm *hcoMetrics) SetHCOMetricUpgradeable(upgradeable bool) error {
+	value := UpgradeableFalse
+	if upgradeable {
+		value = UpgradeableTrue
+	}
+	return hm.SetMetric(HCOMetricUpgradeable, nil, value)
+}
+
+// IsHCOMetricUpgradeable returns true if the Upgradeable condition is true; else, return false
+func (hm *hcoMetrics) IsHCOMetricUpgradeable() (bool, error) {
+	val, err := hm.GetMetricValue(HCOMetricUpgradeable, nil)
+	if err != nil {
+		return false, err
+	}
+
+	return val == UpgradeableTrue, nil
+}
+
+// SetHCOMetricClusterReady sets the gauge to 1 if the HyperConverged custom resource is fully reconciled and
+// available, or to 0 if not
+func (hm *hcoMetrics) SetHCOMetricClusterReady(ready bool) error {
+	value := ClusterReadyFalse
+	if ready {
+		value = ClusterReadyTrue
+	}
+	return hm.SetMetric(HCOMetricClusterReady, nil, value)
+}
+
+// IsHCOMetricClusterReady returns true if the HyperConverged custom resource is fully reconciled and available; else,
+// return false
+func (hm *hcoMetrics) IsHCOMetricClusterReady() (bool, error) {
+	val, err := hm.GetMetricValue(HCOMetricClusterReady, nil)
+	if err != nil {
+		return false, err
+	}
+
+	return val == ClusterReadyTrue, nil
+}
+
+// IncReconcileRequeues increments the count of the reconciliations that were requeued with backoff, for the reason
+func (hm *hcoMetrics) IncReconcileRequeues(reason string) error {
+	return hm.IncMetric(HCOMetricReconcileRequeues, prometheus.Labels{labelReason: reason})
+}
+
+// GetReconcileRequeuesCount returns current value of the requeues counter for the reason. If error is not nil then
+// value is undefined
+func (hm *hcoMetrics) GetReconcileRequeuesCount(reason string) (float64, error) {
+	return hm.GetMetricValue(HCOMetricReconcileRequeues, prometheus.Labels{labelReason: reason})
+}
+
+// IncReconcileConflicts increments the count of the reconciliations that were retried because of an update conflict
+// of a resource of the type
+func (hm *hcoMetrics) IncReconcileConflicts(resource string) error {
+	return hm.IncMetric(HCOMetricReconcileConflicts, prometheus.Labels{labelResource: strings.ToLower(resource)})
+}
+
+// GetReconcileConflictsCount returns current value of the conflicts counter for the resource type. If error is not nil
+// then value is undefined
+func (hm *hcoMetrics) GetReconcileConflictsCount(resource string) (float64, error) {
+	return hm.GetMetricValue(HCOMetricReconcileConflicts, prometheus.Labels{labelResource: strings.ToLower(resource)})
+}
+
+// SetGoldenImagesNotUpToDateCount sets the count of the golden images DataImportCrons that are not up to date
+func (hm *hcoMetrics) SetGoldenImagesNotUpToDateCount(count int) error {
+	return hm.SetMetric(HCOMetricGoldenImagesNotUpToDate, nil, float64(count))
+}
+
+// GetGoldenImagesNotUpToDateCount returns current value of the gauge. If error is not nil then value is undefined
+func (hm *hcoMetrics) GetGoldenImagesNotUpToDateCount() (float64, error) {
+	return hm.GetMetricValue(HCOMetricGoldenImagesNotUpToDate, nil)
+}
+
+// SetOperandReconcilePaused sets whether the reconciliation of the operand CR is paused
+func (hm *hcoMetrics) SetOperandReconcilePaused(operand string, paused bool) error {
+	value := ReconcilePausedFalse
+	if paused {
+		value = ReconcilePausedTrue
+	}
+	return hm.SetMetric(HCOMetricOperandReconcilePaused, prometheus.Labels{counterLabelCompName: strings.ToLower(operand)}, value)
+}
+
+// IsOperandReconcilePaused returns true if the reconciliation of the operand CR is paused. If error is not nil then
+// value is undefined
+func (hm *hcoMetrics) IsOperandReconcilePaused(operand string) (bool, error) {
+	val, err := hm.GetMetricValue(HCOMetricOperandReconcilePaused, prometheus.Labels{counterLabelCompName: strings.ToLower(operand)})
+	if err != nil {
+		return false, err
+	}
+	return val == ReconcilePausedTrue, nil
+}
+
+// SetOperandUpgradeTimedOut sets whether the operand did not reach its expected version within the upgrade timeout
+func (hm *hcoMetrics) SetOperandUpgradeTimedOut(operand string, timedOut bool) error {
+	value := UpgradeTimedOutFalse
+	if timedOut {
+		value = UpgradeTimedOutTrue
+	}
+	return hm.SetMetric(HCOMetricOperandUpgradeTimedOut, prometheus.Labels{counterLabelCompName: strings.ToLower(operand)}, value)
+}
+
+// IsOperandUpgradeTimedOut returns true if the operand did not reach its expected version within the upgrade timeout.
+// If error is not nil then value is undefined
+func (hm *hcoMetrics) IsOperandUpgradeTimedOut(operand string) (bool, error) {
+	val, err := hm.GetMetricValue(HCOMetricOperandUpgradeTimedOut, prometheus.Labels{counterLabelCompName: strings.ToLower(operand)})
+	if err != nil {
+		return false, err
+	}
+	return val == UpgradeTimedOutTrue, nil
+}
+
+// SetHCOMetricComponentHealthStatus sets the gauge of the operand health status
+func (hm *hcoMetrics) SetHCOMetricComponentHealthStatus(operand string, status float64) error {
+	return hm.SetMetric(HCOMetricComponentHealthStatus, prometheus.Labels{counterLabelCompName: strings.ToLower(operand)}, status)
+}
+
+// GetHCOMetricComponentHealthStatus returns current value of the operand health status gauge. If error is not nil then
+// value is undefined
+func (hm *hcoMetrics) GetHCOMetricComponentHealthStatus(operand string) (float64, error) {
+	return hm.GetMetricValue(HCOMetricComponentHealthStatus, prometheus.Labels{counterLabelCompName: strings.ToLower(operand)})
+}
+
 func getLabelsForObj(kind string, name string) prometheus.Labels {
 	return prometheus.Labels{counterLabelCompName: strings.ToLower(kind + "/" + name)}
 }
 
+func getLabelsForOverwrittenModification(kind, name, namespace, fieldManager string) prometheus.Labels {
+	labels := getLabelsForObj(kind, name)
+	labels[labelKind] = strings.ToLower(kind)
+	labels[labelNamespace] = namespace
+	labels[labelFieldManager] = fieldManager
+
+	return labels
+}
+
 func getLabelsForUnsafeAnnotation(unsafeAnnotation string) prometheus.Labels {
 	return prometheus.Labels{counterLabelAnnName: strings.ToLower(unsafeAnnotation)}
 }
 
+func getLabelsForOperandCondition(operand, conditionType string) prometheus.Labels {
+	return prometheus.Labels{
+		counterLabelCompName: strings.ToLower(operand),
+		labelConditionType:   strings.ToLower(conditionType),
+	}
+}
+
+func getLabelsForEnsureDuration(kind, outcome string) prometheus.Labels {
+	return prometheus.Labels{
+		labelKind:    strings.ToLower(kind),
+		labelOutcome: outcome,
+	}
+}
+
 type MetricDescription struct {
 	FqName string
 	Help   string
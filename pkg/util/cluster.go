@@ -4,6 +4,10 @@ import (
 	"context"
 	"errors"
 	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
 
 	csvv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
@@ -14,8 +18,6 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
-	"k8s.io/apimachinery/pkg/labels"
-	"k8s.io/apimachinery/pkg/selection"
 
 	"github.com/go-logr/logr"
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
@@ -34,11 +36,25 @@ type ClusterInfo interface {
 	IsManagedByOLM() bool
 	IsControlPlaneHighlyAvailable() bool
 	IsInfrastructureHighlyAvailable() bool
+	GetControlPlaneTopology() openshiftconfigv1.TopologyMode
+	GetInfrastructureTopology() openshiftconfigv1.TopologyMode
+	IsConsoleAvailable() bool
+	GetManagedService() ManagedService
 	IsConsolePluginImageProvided() bool
 	IsMonitoringAvailable() bool
+	RefreshMonitoringAvailability(ctx context.Context, cl client.Client) bool
+	IsScrapeConfigAvailable() bool
+	IsAlertmanagerConfigAvailable() bool
+	GetMonitoringNamespace() string
+	GetMonitoringServiceAccount() string
 	IsSingleStackIPv6() bool
+	GetNodeArchitectures() []string
+	GetInfraNodeCount() int
+	GetWorkerNodeCount() int
 	GetTLSSecurityProfile(hcoTLSSecurityProfile *openshiftconfigv1.TLSSecurityProfile) *openshiftconfigv1.TLSSecurityProfile
 	RefreshAPIServerCR(ctx context.Context, c client.Client) error
+	RefreshClusterInfo(ctx context.Context, cl client.Reader) (bool, error)
+	RefreshNodeTopology(ctx context.Context, cl client.Reader) (bool, error)
 	GetPod() *corev1.Pod
 	GetDeployment() *appsv1.Deployment
 	GetCSV() *csvv1alpha1.ClusterServiceVersion
@@ -50,18 +66,37 @@ type ClusterInfoImp struct {
 	runningLocally                bool
 	controlPlaneHighlyAvailable   bool
 	infrastructureHighlyAvailable bool
+	controlPlaneTopology          openshiftconfigv1.TopologyMode
+	infrastructureTopology        openshiftconfigv1.TopologyMode
+	clusterInfrastructureTopology openshiftconfigv1.TopologyMode
+	consoleAvailable              bool
+	managedService                ManagedService
 	consolePluginImageProvided    bool
 	monitoringAvailable           bool
+	scrapeConfigAvailable         bool
+	alertmanagerConfigAvailable   bool
 	singlestackipv6               bool
+	nodeArchitectures             []string
+	infraNodeCount                int
+	workerNodeCount               int
 	domain                        string
 	baseDomain                    string
+	monitoringStack               monitoringStack
 	ownResources                  *OwnResources
 	logger                        logr.Logger
+
+	// lock protects the fields that are modified by RefreshClusterInfo and RefreshNodeTopology, while HCO is running
+	lock sync.RWMutex
 }
 
 var clusterInfo ClusterInfo
 
-var validatedAPIServerTLSSecurityProfile *openshiftconfigv1.TLSSecurityProfile
+var (
+	// validatedAPIServerTLSSecurityProfile is read on each TLS handshake of the webhook server, while it may be
+	// refreshed by the APIServer reconciler
+	validatedAPIServerTLSSecurityProfile     *openshiftconfigv1.TLSSecurityProfile
+	validatedAPIServerTLSSecurityProfileLock sync.RWMutex
+)
 
 var GetClusterInfo = func() ClusterInfo {
 	return clusterInfo
@@ -81,13 +116,22 @@ func (c *ClusterInfoImp) Init(ctx context.Context, cl client.Client, logger logr
 	_, c.managedByOLM = os.LookupEnv(OperatorConditionNameEnvVar)
 
 	if c.runningInOpenshift {
-		err = c.initOpenshift(ctx, cl)
-	} else {
-		err = c.initKubernetes(cl)
+		if err = c.initOpenshift(ctx, cl); err != nil {
+			return err
+		}
 	}
+
+	nodes, err := listNodes(ctx, cl)
 	if err != nil {
 		return err
 	}
+	c.setNodeTopology(nodes)
+	c.logger.Info("Cluster nodes",
+		"architectures", c.nodeArchitectures,
+		"schedulableInfraNodes", c.infraNodeCount,
+		"schedulableWorkerNodes", c.workerNodeCount,
+	)
+
 	if c.runningInOpenshift && c.singlestackipv6 {
 		if err := metrics.HcoMetrics.SetHCOMetricSingleStackIPv6True(); err != nil {
 			return err
@@ -99,6 +143,9 @@ func (c *ClusterInfoImp) Init(ctx context.Context, cl client.Client, logger logr
 	c.consolePluginImageProvided = uiPluginVarExists && len(uiPluginVarValue) > 0 && uiProxyVarExists && len(uiProxyVarValue) > 0
 
 	c.monitoringAvailable = isPrometheusExists(ctx, cl)
+	c.scrapeConfigAvailable = c.monitoringAvailable && isCRDExists(ctx, cl, ScrapeConfigCRDName)
+	c.alertmanagerConfigAvailable = c.monitoringAvailable && isCRDExists(ctx, cl, AlertmanagerConfigCRDName)
+	c.monitoringStack = discoverMonitoringStack(ctx, cl, c.runningInOpenshift, c.logger)
 
 	err = c.RefreshAPIServerCR(ctx, cl)
 	if err != nil {
@@ -109,37 +156,7 @@ func (c *ClusterInfoImp) Init(ctx context.Context, cl client.Client, logger logr
 	return nil
 }
 
-func (c *ClusterInfoImp) initKubernetes(cl client.Client) error {
-	masterNodeList := &corev1.NodeList{}
-	masterReq, err := labels.NewRequirement("node-role.kubernetes.io/master", selection.Exists, nil)
-	if err != nil {
-		return err
-	}
-	masterSelector := labels.NewSelector().Add(*masterReq)
-	masterLabelSelector := client.MatchingLabelsSelector{Selector: masterSelector}
-	err = cl.List(context.TODO(), masterNodeList, masterLabelSelector)
-	if err != nil {
-		return err
-	}
-
-	workerNodeList := &corev1.NodeList{}
-	workerReq, err := labels.NewRequirement("node-role.kubernetes.io/worker", selection.Exists, nil)
-	if err != nil {
-		return err
-	}
-	workerSelector := labels.NewSelector().Add(*workerReq)
-	workerLabelSelector := client.MatchingLabelsSelector{Selector: workerSelector}
-	err = cl.List(context.TODO(), workerNodeList, workerLabelSelector)
-	if err != nil {
-		return err
-	}
-
-	c.controlPlaneHighlyAvailable = len(masterNodeList.Items) >= 3
-	c.infrastructureHighlyAvailable = len(workerNodeList.Items) >= 2
-	return nil
-}
-
-func (c *ClusterInfoImp) initOpenshift(ctx context.Context, cl client.Client) error {
+func (c *ClusterInfoImp) initOpenshift(ctx context.Context, cl client.Reader) error {
 	clusterInfrastructure := &openshiftconfigv1.Infrastructure{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: "cluster",
@@ -156,8 +173,20 @@ func (c *ClusterInfoImp) initOpenshift(ctx context.Context, cl client.Client) er
 		"infrastructureTopology", clusterInfrastructure.Status.InfrastructureTopology,
 	)
 
-	c.controlPlaneHighlyAvailable = clusterInfrastructure.Status.ControlPlaneTopology == openshiftconfigv1.HighlyAvailableTopologyMode
-	c.infrastructureHighlyAvailable = clusterInfrastructure.Status.InfrastructureTopology == openshiftconfigv1.HighlyAvailableTopologyMode
+	c.controlPlaneTopology = clusterInfrastructure.Status.ControlPlaneTopology
+	c.infrastructureTopology = clusterInfrastructure.Status.InfrastructureTopology
+	c.clusterInfrastructureTopology = clusterInfrastructure.Status.InfrastructureTopology
+
+	// a hosted control plane (e.g. HyperShift) runs outside the cluster, on the highly available management cluster;
+	// there are no control plane nodes in the cluster itself.
+	c.controlPlaneHighlyAvailable = c.controlPlaneTopology == openshiftconfigv1.HighlyAvailableTopologyMode ||
+		c.controlPlaneTopology == openshiftconfigv1.ExternalTopologyMode
+	c.infrastructureHighlyAvailable = c.infrastructureTopology == openshiftconfigv1.HighlyAvailableTopologyMode
+
+	c.managedService = detectManagedService(ctx, cl, clusterInfrastructure)
+	if c.managedService.IsManaged() {
+		c.logger.Info("Running on a managed OpenShift service", "service", c.managedService)
+	}
 
 	clusterNetwork := &openshiftconfigv1.Network{
 		ObjectMeta: metav1.ObjectMeta{
@@ -187,6 +216,15 @@ func (c *ClusterInfoImp) IsOpenshift() bool {
 	return c.runningInOpenshift
 }
 
+// GetManagedService returns the managed OpenShift offering that the cluster is running on, if any. On managed services,
+// HCO applies the guardrails of the service to the resources it deploys.
+func (c *ClusterInfoImp) GetManagedService() ManagedService {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.managedService
+}
+
 func (c *ClusterInfoImp) IsConsolePluginImageProvided() bool {
 	return c.consolePluginImageProvided
 }
@@ -195,6 +233,45 @@ func (c *ClusterInfoImp) IsMonitoringAvailable() bool {
 	return c.monitoringAvailable
 }
 
+// RefreshMonitoringAvailability checks again if the monitoring CRDs exist, as they may be installed or removed after
+// HCO was started. Returns true if the monitoring availability was changed.
+func (c *ClusterInfoImp) RefreshMonitoringAvailability(ctx context.Context, cl client.Client) bool {
+	available := isPrometheusExists(ctx, cl)
+	if available == c.monitoringAvailable {
+		return false
+	}
+
+	c.monitoringAvailable = available
+	c.scrapeConfigAvailable = available && isCRDExists(ctx, cl, ScrapeConfigCRDName)
+	c.alertmanagerConfigAvailable = available && isCRDExists(ctx, cl, AlertmanagerConfigCRDName)
+	if available {
+		c.monitoringStack = discoverMonitoringStack(ctx, cl, c.runningInOpenshift, c.logger)
+	}
+
+	return true
+}
+
+// IsScrapeConfigAvailable returns true if the ScrapeConfig CRD exists. The ScrapeConfig CRD is only checked when HCO
+// is started, or when the monitoring CRDs are installed after HCO was started, so the scrape resources and their
+// watches are kept consistent.
+func (c *ClusterInfoImp) IsScrapeConfigAvailable() bool {
+	return c.scrapeConfigAvailable
+}
+
+// IsAlertmanagerConfigAvailable returns true if the AlertmanagerConfig CRD exists. Like the ScrapeConfig CRD, it is
+// only checked when HCO is started, or when the monitoring CRDs are installed after HCO was started.
+func (c *ClusterInfoImp) IsAlertmanagerConfigAvailable() bool {
+	return c.alertmanagerConfigAvailable
+}
+
+func (c *ClusterInfoImp) GetMonitoringNamespace() string {
+	return c.monitoringStack.namespace
+}
+
+func (c *ClusterInfoImp) GetMonitoringServiceAccount() string {
+	return c.monitoringStack.serviceAccount
+}
+
 func (c *ClusterInfoImp) IsRunningLocally() bool {
 	return c.runningLocally
 }
@@ -203,35 +280,355 @@ func (c *ClusterInfoImp) IsSingleStackIPv6() bool {
 	return c.singlestackipv6
 }
 
+// GetNodeArchitectures returns the sorted list of the architectures of the cluster nodes; e.g. amd64, arm64 or s390x
+func (c *ClusterInfoImp) GetNodeArchitectures() []string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.nodeArchitectures
+}
+
+// GetInfraNodeCount returns the number of the schedulable infra nodes; i.e. the nodes with the infra role
+func (c *ClusterInfoImp) GetInfraNodeCount() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.infraNodeCount
+}
+
+// GetWorkerNodeCount returns the number of the schedulable worker nodes, that are not infra nodes
+func (c *ClusterInfoImp) GetWorkerNodeCount() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.workerNodeCount
+}
+
 func (c *ClusterInfoImp) IsControlPlaneHighlyAvailable() bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
 	return c.controlPlaneHighlyAvailable
 }
 
 func (c *ClusterInfoImp) IsInfrastructureHighlyAvailable() bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
 	return c.infrastructureHighlyAvailable
 }
 
+// GetControlPlaneTopology returns the topology of the control plane: HighlyAvailable, SingleReplica, or External if
+// the control plane is hosted outside the cluster
+func (c *ClusterInfoImp) GetControlPlaneTopology() openshiftconfigv1.TopologyMode {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.controlPlaneTopology
+}
+
+// GetInfrastructureTopology returns the topology of the infrastructure nodes: HighlyAvailable or SingleReplica
+func (c *ClusterInfoImp) GetInfrastructureTopology() openshiftconfigv1.TopologyMode {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.infrastructureTopology
+}
+
+// IsConsoleAvailable returns true if the cluster runs the OpenShift web console, so HCO can deploy its console
+// integrations; e.g. the console plugin, the quick starts and the CLI downloads. The Console capability is usually
+// disabled on clusters with hosted control planes.
+func (c *ClusterInfoImp) IsConsoleAvailable() bool {
+	return c.consoleAvailable
+}
+
 func (c *ClusterInfoImp) GetDomain() string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
 	return c.domain
 }
 
 func (c *ClusterInfoImp) GetBaseDomain() string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
 	return c.baseDomain
 }
 
 func (c *ClusterInfoImp) GetPod() *corev1.Pod {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
 	return c.ownResources.GetPod()
 }
 
 func (c *ClusterInfoImp) GetDeployment() *appsv1.Deployment {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
 	return c.ownResources.GetDeployment()
 }
 
 func (c *ClusterInfoImp) GetCSV() *csvv1alpha1.ClusterServiceVersion {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
 	return c.ownResources.GetCSV()
 }
 
-func getClusterDomain(ctx context.Context, cl client.Client) (string, error) {
+// RefreshClusterInfo reads again the cluster information that may be modified while HCO is running: the cluster
+// topology, the managed service, the cluster domains and the resources of HCO itself, like its CSV. Returns true if the
+// cluster topology, the managed service or the domains were changed, so the operands should be reconciled again.
+//
+// The console availability is not refreshed, as the console integrations are only registered when HCO is started.
+func (c *ClusterInfoImp) RefreshClusterInfo(ctx context.Context, cl client.Reader) (bool, error) {
+	refreshed := &ClusterInfoImp{runningInOpenshift: c.runningInOpenshift, logger: logr.Discard()}
+	if c.runningInOpenshift {
+		if err := refreshed.initOpenshift(ctx, cl); err != nil {
+			return false, err
+		}
+
+		var err error
+		if refreshed.domain, err = getClusterDomain(ctx, cl); err != nil {
+			return false, err
+		}
+
+		if refreshed.baseDomain, err = getClusterBaseDomain(ctx, cl); err != nil {
+			return false, err
+		}
+	}
+
+	nodes, err := listNodes(ctx, cl)
+	if err != nil {
+		return false, err
+	}
+	refreshed.setNodeTopology(nodes)
+
+	ownResources := findOwnResources(ctx, cl, c.logger)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// keep the last known resources, if they can't be read right now
+	if ownResources.GetDeployment() != nil {
+		c.ownResources = ownResources
+	}
+
+	changed := c.controlPlaneTopology != refreshed.controlPlaneTopology ||
+		c.infrastructureTopology != refreshed.infrastructureTopology ||
+		c.managedService != refreshed.managedService ||
+		c.domain != refreshed.domain ||
+		c.baseDomain != refreshed.baseDomain ||
+		!reflect.DeepEqual(c.nodeArchitectures, refreshed.nodeArchitectures) ||
+		c.infraNodeCount != refreshed.infraNodeCount ||
+		c.workerNodeCount != refreshed.workerNodeCount
+
+	if !changed {
+		return false, nil
+	}
+
+	c.logger.Info("the cluster information was changed",
+		"controlPlaneTopology", refreshed.controlPlaneTopology,
+		"infrastructureTopology", refreshed.infrastructureTopology,
+		"managedService", refreshed.managedService,
+		"domain", refreshed.domain,
+		"baseDomain", refreshed.baseDomain,
+	)
+
+	c.controlPlaneHighlyAvailable = refreshed.controlPlaneHighlyAvailable
+	c.infrastructureHighlyAvailable = refreshed.infrastructureHighlyAvailable
+	c.controlPlaneTopology = refreshed.controlPlaneTopology
+	c.infrastructureTopology = refreshed.infrastructureTopology
+	c.clusterInfrastructureTopology = refreshed.clusterInfrastructureTopology
+	c.nodeArchitectures = refreshed.nodeArchitectures
+	c.infraNodeCount = refreshed.infraNodeCount
+	c.workerNodeCount = refreshed.workerNodeCount
+	c.managedService = refreshed.managedService
+	c.domain = refreshed.domain
+	c.baseDomain = refreshed.baseDomain
+
+	return true, nil
+}
+
+// RefreshNodeTopology counts the cluster nodes again, when nodes are added or removed while HCO is running; e.g. when
+// worker nodes are added to a single node cluster. Returns true if the node based topology or the node architectures
+// were changed, so the operands should be reconciled again.
+func (c *ClusterInfoImp) RefreshNodeTopology(ctx context.Context, cl client.Reader) (bool, error) {
+	nodes, err := listNodes(ctx, cl)
+	if err != nil {
+		return false, err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	refreshed := &ClusterInfoImp{
+		runningInOpenshift:            c.runningInOpenshift,
+		controlPlaneHighlyAvailable:   c.controlPlaneHighlyAvailable,
+		controlPlaneTopology:          c.controlPlaneTopology,
+		clusterInfrastructureTopology: c.clusterInfrastructureTopology,
+	}
+	refreshed.setNodeTopology(nodes)
+
+	changed := c.controlPlaneTopology != refreshed.controlPlaneTopology ||
+		c.infrastructureTopology != refreshed.infrastructureTopology ||
+		!reflect.DeepEqual(c.nodeArchitectures, refreshed.nodeArchitectures) ||
+		c.infraNodeCount != refreshed.infraNodeCount ||
+		c.workerNodeCount != refreshed.workerNodeCount
+
+	if !changed {
+		return false, nil
+	}
+
+	c.logger.Info("the node topology was changed",
+		"controlPlaneTopology", refreshed.controlPlaneTopology,
+		"infrastructureTopology", refreshed.infrastructureTopology,
+		"architectures", refreshed.nodeArchitectures,
+		"schedulableInfraNodes", refreshed.infraNodeCount,
+		"schedulableWorkerNodes", refreshed.workerNodeCount,
+	)
+
+	c.controlPlaneHighlyAvailable = refreshed.controlPlaneHighlyAvailable
+	c.infrastructureHighlyAvailable = refreshed.infrastructureHighlyAvailable
+	c.controlPlaneTopology = refreshed.controlPlaneTopology
+	c.infrastructureTopology = refreshed.infrastructureTopology
+	c.nodeArchitectures = refreshed.nodeArchitectures
+	c.infraNodeCount = refreshed.infraNodeCount
+	c.workerNodeCount = refreshed.workerNodeCount
+
+	return true, nil
+}
+
+// setNodeTopology sets the topology and the node architectures of the cluster, by its nodes.
+//
+// On OpenShift, the topology is read from the Infrastructure CR, which is set when the cluster is installed and is
+// never modified later. The infrastructure is still considered as highly available, if more worker nodes were added
+// to the cluster since then.
+func (c *ClusterInfoImp) setNodeTopology(nodes []corev1.Node) {
+	masters, workers := countNodeRoles(nodes)
+
+	if c.runningInOpenshift {
+		c.infrastructureHighlyAvailable = c.clusterInfrastructureTopology == openshiftconfigv1.HighlyAvailableTopologyMode ||
+			workers >= 2
+	} else {
+		c.controlPlaneHighlyAvailable = masters >= 3
+		c.infrastructureHighlyAvailable = workers >= 2
+		c.controlPlaneTopology = getTopologyMode(c.controlPlaneHighlyAvailable)
+	}
+	c.infrastructureTopology = getTopologyMode(c.infrastructureHighlyAvailable)
+
+	c.nodeArchitectures = getNodeArchitectures(nodes)
+	c.infraNodeCount, c.workerNodeCount = countSchedulableNodes(nodes)
+}
+
+func listNodes(ctx context.Context, cl client.Reader) ([]corev1.Node, error) {
+	nodes := &corev1.NodeList{}
+	if err := cl.List(ctx, nodes); err != nil {
+		return nil, err
+	}
+
+	return nodes.Items, nil
+}
+
+func countNodeRoles(nodes []corev1.Node) (int, int) {
+	masters, workers := 0, 0
+	for _, node := range nodes {
+		if _, ok := node.Labels[NodeRoleMasterLabel]; ok {
+			masters++
+		}
+		if _, ok := node.Labels[NodeRoleWorkerLabel]; ok {
+			workers++
+		}
+	}
+
+	return masters, workers
+}
+
+// countSchedulableNodes counts the schedulable infra nodes, and the schedulable worker nodes that are not infra nodes
+func countSchedulableNodes(nodes []corev1.Node) (int, int) {
+	infra, workers := 0, 0
+	for _, node := range nodes {
+		if !isSchedulable(node) {
+			continue
+		}
+
+		if _, ok := node.Labels[NodeRoleInfraLabel]; ok {
+			infra++
+		} else if _, ok = node.Labels[NodeRoleWorkerLabel]; ok {
+			workers++
+		}
+	}
+
+	return infra, workers
+}
+
+// isSchedulable returns false if the node is tainted to repel the pods; e.g. a control plane node.
+//
+// The node condition taints, like the ones of the cordoned or the not ready nodes, are ignored, as they are usually
+// temporary, and the replica count of the operands should not be modified while the nodes are drained. The taint of the
+// infra nodes is also ignored, as it is tolerated by the operands that are placed on them.
+func isSchedulable(node corev1.Node) bool {
+	for _, taint := range node.Spec.Taints {
+		if taint.Effect != corev1.TaintEffectNoSchedule && taint.Effect != corev1.TaintEffectNoExecute {
+			continue
+		}
+
+		if taint.Key == NodeRoleInfraLabel || strings.HasPrefix(taint.Key, nodeConditionTaintPrefix) {
+			continue
+		}
+
+		return false
+	}
+
+	return true
+}
+
+func getNodeArchitectures(nodes []corev1.Node) []string {
+	archSet := make(map[string]bool)
+	for _, node := range nodes {
+		arch := node.Status.NodeInfo.Architecture
+		if arch == "" {
+			arch = node.Labels[corev1.LabelArchStable]
+		}
+		if arch != "" {
+			archSet[arch] = true
+		}
+	}
+
+	archs := make([]string, 0, len(archSet))
+	for arch := range archSet {
+		archs = append(archs, arch)
+	}
+	sort.Strings(archs)
+
+	return archs
+}
+
+func getTopologyMode(highlyAvailable bool) openshiftconfigv1.TopologyMode {
+	if highlyAvailable {
+		return openshiftconfigv1.HighlyAvailableTopologyMode
+	}
+	return openshiftconfigv1.SingleReplicaTopologyMode
+}
+
+// isConsoleCapabilityEnabled returns true if the Console capability of the cluster is enabled. Older OpenShift versions
+// do not report the capabilities; the console is always installed on these versions.
+func isConsoleCapabilityEnabled(clusterVersion *openshiftconfigv1.ClusterVersion) bool {
+	capabilities := clusterVersion.Status.Capabilities
+	if len(capabilities.KnownCapabilities) == 0 {
+		return true
+	}
+
+	for _, capability := range capabilities.EnabledCapabilities {
+		if capability == openshiftconfigv1.ClusterVersionCapabilityConsole {
+			return true
+		}
+	}
+	return false
+}
+
+func getClusterDomain(ctx context.Context, cl client.Reader) (string, error) {
 	clusterIngress := &openshiftconfigv1.Ingress{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: "cluster",
@@ -244,7 +641,7 @@ func getClusterDomain(ctx context.Context, cl client.Client) (string, error) {
 
 }
 
-func getClusterBaseDomain(ctx context.Context, cl client.Client) (string, error) {
+func getClusterBaseDomain(ctx context.Context, cl client.Reader) (string, error) {
 	clusterDNS := &openshiftconfigv1.DNS{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: "cluster",
@@ -256,14 +653,28 @@ func getClusterBaseDomain(ctx context.Context, cl client.Client) (string, error)
 	return clusterDNS.Spec.BaseDomain, nil
 }
 
-func isPrometheusExists(ctx context.Context, cl client.Client) bool {
-	prometheusRuleCRDExists := isCRDExists(ctx, cl, PrometheusRuleCRDName)
-	serviceMonitorCRDExists := isCRDExists(ctx, cl, ServiceMonitorCRDName)
+var monitoringCRDNames = []string{PrometheusRuleCRDName, ServiceMonitorCRDName, PodMonitorCRDName}
 
-	return prometheusRuleCRDExists && serviceMonitorCRDExists
+// IsMonitoringCRD returns true if crdName is the name of one of the CRDs that are required for monitoring
+func IsMonitoringCRD(crdName string) bool {
+	for _, name := range monitoringCRDNames {
+		if name == crdName {
+			return true
+		}
+	}
+	return false
 }
 
-func isCRDExists(ctx context.Context, cl client.Client, crdName string) bool {
+func isPrometheusExists(ctx context.Context, cl client.Client) bool {
+	for _, crdName := range monitoringCRDNames {
+		if !isCRDExists(ctx, cl, crdName) {
+			return false
+		}
+	}
+	return true
+}
+
+func isCRDExists(ctx context.Context, cl client.Reader, crdName string) bool {
 	found := &apiextensionsv1.CustomResourceDefinition{}
 	key := client.ObjectKey{Name: crdName}
 	err := cl.Get(ctx, key, found)
@@ -277,7 +688,7 @@ func init() {
 	}
 }
 
-func (c *ClusterInfoImp) queryCluster(ctx context.Context, cl client.Client) error {
+func (c *ClusterInfoImp) queryCluster(ctx context.Context, cl client.Reader) error {
 	clusterVersion := &openshiftconfigv1.ClusterVersion{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: "version",
@@ -289,6 +700,7 @@ func (c *ClusterInfoImp) queryCluster(ctx context.Context, cl client.Client) err
 		if meta.IsNoMatchError(err) || apierrors.IsNotFound(err) || errors.As(err, &gdferr) {
 			// Not on OpenShift
 			c.runningInOpenshift = false
+			c.consoleAvailable = false
 			c.logger.Info("Cluster type = kubernetes")
 		} else {
 			c.logger.Error(err, "Failed to get ClusterVersion")
@@ -297,6 +709,7 @@ func (c *ClusterInfoImp) queryCluster(ctx context.Context, cl client.Client) err
 	} else {
 		c.runningInOpenshift = true
 		c.logger.Info("Cluster type = openshift", "version", clusterVersion.Status.Desired.Version)
+		c.consoleAvailable = isConsoleCapabilityEnabled(clusterVersion)
 		c.domain, err = getClusterDomain(ctx, cl)
 		if err != nil {
 			return err
@@ -312,7 +725,11 @@ func (c *ClusterInfoImp) queryCluster(ctx context.Context, cl client.Client) err
 func (c *ClusterInfoImp) GetTLSSecurityProfile(hcoTLSSecurityProfile *openshiftconfigv1.TLSSecurityProfile) *openshiftconfigv1.TLSSecurityProfile {
 	if hcoTLSSecurityProfile != nil {
 		return hcoTLSSecurityProfile
-	} else if validatedAPIServerTLSSecurityProfile != nil {
+	}
+
+	validatedAPIServerTLSSecurityProfileLock.RLock()
+	defer validatedAPIServerTLSSecurityProfileLock.RUnlock()
+	if validatedAPIServerTLSSecurityProfile != nil {
 		return validatedAPIServerTLSSecurityProfile
 	}
 	return &openshiftconfigv1.TLSSecurityProfile{
@@ -330,14 +747,24 @@ func (c *ClusterInfoImp) RefreshAPIServerCR(ctx context.Context, cl client.Clien
 		if err != nil {
 			return err
 		}
-		validatedAPIServerTLSSecurityProfile = c.validateAPIServerTLSSecurityProfile(instance.Spec.TLSSecurityProfile)
+		c.setAPIServerTLSSecurityProfile(c.validateAPIServerTLSSecurityProfile(instance.Spec.TLSSecurityProfile))
 		return nil
 	}
-	validatedAPIServerTLSSecurityProfile = nil
+	c.setAPIServerTLSSecurityProfile(nil)
 
 	return nil
 }
 
+func (c *ClusterInfoImp) setAPIServerTLSSecurityProfile(profile *openshiftconfigv1.TLSSecurityProfile) {
+	validatedAPIServerTLSSecurityProfileLock.Lock()
+	defer validatedAPIServerTLSSecurityProfileLock.Unlock()
+
+	if !reflect.DeepEqual(validatedAPIServerTLSSecurityProfile, profile) {
+		c.logger.Info("the APIServer TLS security profile was updated", "tlsSecurityProfile", profile)
+	}
+	validatedAPIServerTLSSecurityProfile = profile
+}
+
 func (c *ClusterInfoImp) validateAPIServerTLSSecurityProfile(apiServerTLSSecurityProfile *openshiftconfigv1.TLSSecurityProfile) *openshiftconfigv1.TLSSecurityProfile {
 	if apiServerTLSSecurityProfile == nil || apiServerTLSSecurityProfile.Type != openshiftconfigv1.TLSProfileCustomType {
 		return apiServerTLSSecurityProfile
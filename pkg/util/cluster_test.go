@@ -196,6 +196,38 @@ var _ = Describe("test clusterInfo", func() {
 		Expect(GetClusterInfo().IsSingleStackIPv6()).To(BeFalse())
 	})
 
+	It("should detect the node architectures", func() {
+		newNode := func(name, arch, archLabel string) *corev1.Node {
+			node := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+				},
+				Status: corev1.NodeStatus{
+					NodeInfo: corev1.NodeSystemInfo{
+						Architecture: arch,
+					},
+				},
+			}
+			if archLabel != "" {
+				node.Labels = map[string]string{corev1.LabelArchStable: archLabel}
+			}
+			return node
+		}
+
+		cl := fake.NewClientBuilder().
+			WithScheme(testScheme).
+			WithObjects(
+				newNode("node1", ArchS390X, ""),
+				newNode("node2", ArchAMD64, ArchAMD64),
+				newNode("node3", ArchS390X, ""),
+				newNode("node4", "", ArchARM64),
+			).
+			Build()
+		Expect(GetClusterInfo().Init(context.TODO(), cl, logger)).To(Succeed())
+
+		Expect(GetClusterInfo().GetNodeArchitectures()).To(Equal([]string{ArchAMD64, ArchARM64, ArchS390X}))
+	})
+
 	DescribeTable(
 		"check Init on openshift, with OLM, infrastructure topology ...",
 		func(controlPlaneTopology, infrastructureTopology openshiftconfigv1.TopologyMode, expectedIsControlPlaneHighlyAvailable, expectedIsInfrastructureHighlyAvailable bool) {
@@ -225,6 +257,8 @@ var _ = Describe("test clusterInfo", func() {
 			Expect(GetClusterInfo().IsManagedByOLM()).To(BeTrue(), "should return true for IsManagedByOLM()")
 			Expect(GetClusterInfo().IsControlPlaneHighlyAvailable()).To(Equal(expectedIsControlPlaneHighlyAvailable), "should return true for HighlyAvailable ControlPlane")
 			Expect(GetClusterInfo().IsInfrastructureHighlyAvailable()).To(Equal(expectedIsInfrastructureHighlyAvailable), "should return true for HighlyAvailable Infrastructure")
+			Expect(GetClusterInfo().GetControlPlaneTopology()).To(Equal(controlPlaneTopology))
+			Expect(GetClusterInfo().GetInfrastructureTopology()).To(Equal(infrastructureTopology))
 		},
 		Entry(
 			"HighlyAvailable ControlPlane and Infrastructure",
@@ -254,6 +288,51 @@ var _ = Describe("test clusterInfo", func() {
 			false,
 			false,
 		),
+		Entry(
+			"External (hosted) ControlPlane, HighlyAvailable Infrastructure",
+			openshiftconfigv1.ExternalTopologyMode,
+			openshiftconfigv1.HighlyAvailableTopologyMode,
+			true,
+			true,
+		),
+	)
+
+	DescribeTable(
+		"check Init on openshift, console capability ...",
+		func(capabilities openshiftconfigv1.ClusterVersionCapabilitiesStatus, expectedIsConsoleAvailable bool) {
+			testClusterVersion := clusterVersion.DeepCopy()
+			testClusterVersion.Status.Capabilities = capabilities
+
+			cl := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(testClusterVersion, infrastructure, ingress, apiServer, dns, ipv4network).
+				WithStatusSubresource(testClusterVersion, infrastructure, ingress, apiServer, dns, ipv4network).
+				Build()
+			Expect(GetClusterInfo().Init(context.TODO(), cl, logger)).To(Succeed())
+
+			Expect(GetClusterInfo().IsConsoleAvailable()).To(Equal(expectedIsConsoleAvailable))
+		},
+		Entry(
+			"no capabilities reported",
+			openshiftconfigv1.ClusterVersionCapabilitiesStatus{},
+			true,
+		),
+		Entry(
+			"console capability is enabled",
+			openshiftconfigv1.ClusterVersionCapabilitiesStatus{
+				EnabledCapabilities: []openshiftconfigv1.ClusterVersionCapability{openshiftconfigv1.ClusterVersionCapabilityConsole},
+				KnownCapabilities:   []openshiftconfigv1.ClusterVersionCapability{openshiftconfigv1.ClusterVersionCapabilityConsole},
+			},
+			true,
+		),
+		Entry(
+			"console capability is disabled",
+			openshiftconfigv1.ClusterVersionCapabilitiesStatus{
+				EnabledCapabilities: []openshiftconfigv1.ClusterVersionCapability{openshiftconfigv1.ClusterVersionCapabilityInsights},
+				KnownCapabilities:   []openshiftconfigv1.ClusterVersionCapability{openshiftconfigv1.ClusterVersionCapabilityConsole, openshiftconfigv1.ClusterVersionCapabilityInsights},
+			},
+			false,
+		),
 	)
 
 	DescribeTable(
@@ -296,6 +375,7 @@ var _ = Describe("test clusterInfo", func() {
 			Expect(GetClusterInfo().IsManagedByOLM()).To(BeFalse(), "should return false for IsManagedByOLM()")
 			Expect(GetClusterInfo().IsControlPlaneHighlyAvailable()).To(Equal(expectedIsControlPlaneHighlyAvailable), "should return true for HighlyAvailable ControlPlane")
 			Expect(GetClusterInfo().IsInfrastructureHighlyAvailable()).To(Equal(expectedIsInfrastructureHighlyAvailable), "should return true for HighlyAvailable Infrastructure")
+			Expect(GetClusterInfo().IsConsoleAvailable()).To(BeFalse(), "should return false for IsConsoleAvailable()")
 		},
 		Entry(
 			"3 master nodes, 3 worker nodes",
@@ -327,6 +407,220 @@ var _ = Describe("test clusterInfo", func() {
 		),
 	)
 
+	Context("RefreshClusterInfo", func() {
+		It("should refresh the cluster information on openshift", func() {
+			testInfrastructure := infrastructure.DeepCopy()
+			testDNS := dns.DeepCopy()
+
+			cl := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(clusterVersion, testInfrastructure, ingress, apiServer, testDNS, ipv4network).
+				WithStatusSubresource(clusterVersion, testInfrastructure, ingress, apiServer, testDNS, ipv4network).
+				Build()
+			Expect(GetClusterInfo().Init(context.TODO(), cl, logger)).To(Succeed())
+
+			By("not reporting a change if nothing was changed")
+			Expect(GetClusterInfo().RefreshClusterInfo(context.TODO(), cl)).To(BeFalse())
+
+			testInfrastructure.Status.InfrastructureTopology = openshiftconfigv1.SingleReplicaTopologyMode
+			Expect(cl.Status().Update(context.TODO(), testInfrastructure)).To(Succeed())
+
+			testDNS.Spec.BaseDomain = "new." + baseDomain
+			Expect(cl.Update(context.TODO(), testDNS)).To(Succeed())
+
+			Expect(GetClusterInfo().IsInfrastructureHighlyAvailable()).To(BeTrue(), "should still return the cached value")
+
+			By("reporting the changes")
+			Expect(GetClusterInfo().RefreshClusterInfo(context.TODO(), cl)).To(BeTrue())
+			Expect(GetClusterInfo().IsInfrastructureHighlyAvailable()).To(BeFalse())
+			Expect(GetClusterInfo().GetInfrastructureTopology()).To(Equal(openshiftconfigv1.SingleReplicaTopologyMode))
+			Expect(GetClusterInfo().IsControlPlaneHighlyAvailable()).To(BeTrue())
+			Expect(GetClusterInfo().GetBaseDomain()).To(Equal("new." + baseDomain))
+
+			Expect(GetClusterInfo().RefreshClusterInfo(context.TODO(), cl)).To(BeFalse())
+		})
+
+		It("should refresh the cluster topology on kubernetes", func() {
+			newNode := func(name, role string) *corev1.Node {
+				return &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   name,
+						Labels: map[string]string{"node-role.kubernetes.io/" + role: ""},
+					},
+				}
+			}
+
+			cl := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(newNode("master0", "master"), newNode("worker0", "worker")).
+				Build()
+			Expect(GetClusterInfo().Init(context.TODO(), cl, logger)).To(Succeed())
+			Expect(GetClusterInfo().IsInfrastructureHighlyAvailable()).To(BeFalse())
+
+			Expect(cl.Create(context.TODO(), newNode("worker1", "worker"))).To(Succeed())
+
+			Expect(GetClusterInfo().RefreshClusterInfo(context.TODO(), cl)).To(BeTrue())
+			Expect(GetClusterInfo().IsInfrastructureHighlyAvailable()).To(BeTrue())
+			Expect(GetClusterInfo().GetInfrastructureTopology()).To(Equal(openshiftconfigv1.HighlyAvailableTopologyMode))
+		})
+	})
+
+	Context("RefreshNodeTopology", func() {
+		newNode := func(name string, roles ...string) *corev1.Node {
+			node := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   name,
+					Labels: map[string]string{corev1.LabelArchStable: "amd64"},
+				},
+			}
+			for _, role := range roles {
+				node.Labels["node-role.kubernetes.io/"+role] = ""
+			}
+			return node
+		}
+
+		It("should detect worker nodes that were added to a single node openshift cluster", func() {
+			testInfrastructure := infrastructure.DeepCopy()
+			testInfrastructure.Status.ControlPlaneTopology = openshiftconfigv1.SingleReplicaTopologyMode
+			testInfrastructure.Status.InfrastructureTopology = openshiftconfigv1.SingleReplicaTopologyMode
+
+			cl := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(clusterVersion, testInfrastructure, ingress, apiServer, dns, ipv4network, newNode("node0", "master", "worker")).
+				WithStatusSubresource(clusterVersion, testInfrastructure, ingress, apiServer, dns, ipv4network).
+				Build()
+			Expect(GetClusterInfo().Init(context.TODO(), cl, logger)).To(Succeed())
+			Expect(GetClusterInfo().IsInfrastructureHighlyAvailable()).To(BeFalse())
+
+			By("not reporting a change if nothing was changed")
+			Expect(GetClusterInfo().RefreshNodeTopology(context.TODO(), cl)).To(BeFalse())
+
+			By("adding a worker node")
+			worker := newNode("worker1", "worker")
+			worker.Labels[corev1.LabelArchStable] = "arm64"
+			Expect(cl.Create(context.TODO(), worker)).To(Succeed())
+
+			Expect(GetClusterInfo().RefreshNodeTopology(context.TODO(), cl)).To(BeTrue())
+			Expect(GetClusterInfo().IsInfrastructureHighlyAvailable()).To(BeTrue())
+			Expect(GetClusterInfo().GetInfrastructureTopology()).To(Equal(openshiftconfigv1.HighlyAvailableTopologyMode))
+			Expect(GetClusterInfo().GetNodeArchitectures()).To(Equal([]string{"amd64", "arm64"}))
+			Expect(GetClusterInfo().IsControlPlaneHighlyAvailable()).To(BeFalse(), "should keep the control plane topology of the Infrastructure CR")
+			Expect(GetClusterInfo().GetControlPlaneTopology()).To(Equal(openshiftconfigv1.SingleReplicaTopologyMode))
+
+			By("keeping the node topology on the next refresh of the cluster information")
+			Expect(GetClusterInfo().RefreshClusterInfo(context.TODO(), cl)).To(BeFalse())
+			Expect(GetClusterInfo().IsInfrastructureHighlyAvailable()).To(BeTrue())
+
+			By("removing the worker node")
+			Expect(cl.Delete(context.TODO(), worker)).To(Succeed())
+
+			Expect(GetClusterInfo().RefreshNodeTopology(context.TODO(), cl)).To(BeTrue())
+			Expect(GetClusterInfo().IsInfrastructureHighlyAvailable()).To(BeFalse())
+			Expect(GetClusterInfo().GetInfrastructureTopology()).To(Equal(openshiftconfigv1.SingleReplicaTopologyMode))
+			Expect(GetClusterInfo().GetNodeArchitectures()).To(Equal([]string{"amd64"}))
+		})
+
+		It("should detect the node topology changes on kubernetes", func() {
+			cl := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(newNode("master0", "master"), newNode("worker0", "worker"), newNode("worker1", "worker")).
+				Build()
+			Expect(GetClusterInfo().Init(context.TODO(), cl, logger)).To(Succeed())
+			Expect(GetClusterInfo().IsControlPlaneHighlyAvailable()).To(BeFalse())
+			Expect(GetClusterInfo().IsInfrastructureHighlyAvailable()).To(BeTrue())
+
+			Expect(cl.Create(context.TODO(), newNode("master1", "master"))).To(Succeed())
+			Expect(cl.Create(context.TODO(), newNode("master2", "master"))).To(Succeed())
+			Expect(cl.Delete(context.TODO(), newNode("worker1", "worker"))).To(Succeed())
+
+			Expect(GetClusterInfo().RefreshNodeTopology(context.TODO(), cl)).To(BeTrue())
+			Expect(GetClusterInfo().IsControlPlaneHighlyAvailable()).To(BeTrue())
+			Expect(GetClusterInfo().GetControlPlaneTopology()).To(Equal(openshiftconfigv1.HighlyAvailableTopologyMode))
+			Expect(GetClusterInfo().IsInfrastructureHighlyAvailable()).To(BeFalse())
+			Expect(GetClusterInfo().GetInfrastructureTopology()).To(Equal(openshiftconfigv1.SingleReplicaTopologyMode))
+		})
+	})
+
+	Context("countSchedulableNodes", func() {
+		newNode := func(name string, taints []corev1.Taint, roles ...string) corev1.Node {
+			node := corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   name,
+					Labels: map[string]string{},
+				},
+				Spec: corev1.NodeSpec{
+					Taints: taints,
+				},
+			}
+			for _, role := range roles {
+				node.Labels["node-role.kubernetes.io/"+role] = ""
+			}
+			return node
+		}
+
+		It("should count the infra and the worker nodes separately", func() {
+			infraTaints := []corev1.Taint{{Key: NodeRoleInfraLabel, Effect: corev1.TaintEffectNoSchedule}}
+			controlPlaneTaints := []corev1.Taint{{Key: "node-role.kubernetes.io/master", Effect: corev1.TaintEffectNoSchedule}}
+
+			infra, workers := countSchedulableNodes([]corev1.Node{
+				newNode("master0", controlPlaneTaints, "master"),
+				newNode("infra0", infraTaints, "infra", "worker"),
+				newNode("infra1", infraTaints, "infra"),
+				newNode("worker0", nil, "worker"),
+			})
+
+			Expect(infra).To(Equal(2))
+			Expect(workers).To(Equal(1))
+		})
+
+		It("should count the schedulable control plane nodes of compact clusters as workers", func() {
+			infra, workers := countSchedulableNodes([]corev1.Node{
+				newNode("node0", nil, "master", "worker"),
+				newNode("node1", nil, "master", "worker"),
+				newNode("node2", nil, "master", "worker"),
+			})
+
+			Expect(infra).To(BeZero())
+			Expect(workers).To(Equal(3))
+		})
+
+		It("should not count the nodes with other taints", func() {
+			infra, workers := countSchedulableNodes([]corev1.Node{
+				newNode("worker0", []corev1.Taint{{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}}, "worker"),
+				newNode("worker1", []corev1.Taint{{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectPreferNoSchedule}}, "worker"),
+			})
+
+			Expect(infra).To(BeZero())
+			Expect(workers).To(Equal(1))
+		})
+
+		It("should still count the cordoned and the not ready nodes", func() {
+			infra, workers := countSchedulableNodes([]corev1.Node{
+				newNode("worker0", []corev1.Taint{{Key: corev1.TaintNodeUnschedulable, Effect: corev1.TaintEffectNoSchedule}}, "worker"),
+				newNode("worker1", []corev1.Taint{{Key: corev1.TaintNodeNotReady, Effect: corev1.TaintEffectNoExecute}}, "worker"),
+			})
+
+			Expect(infra).To(BeZero())
+			Expect(workers).To(Equal(2))
+		})
+
+		It("should refresh the node counts", func() {
+			cl := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker0", Labels: map[string]string{NodeRoleWorkerLabel: ""}}}).
+				Build()
+			Expect(GetClusterInfo().Init(context.TODO(), cl, logger)).To(Succeed())
+			Expect(GetClusterInfo().GetInfraNodeCount()).To(BeZero())
+			Expect(GetClusterInfo().GetWorkerNodeCount()).To(Equal(1))
+
+			Expect(cl.Create(context.TODO(), &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "infra0", Labels: map[string]string{NodeRoleInfraLabel: ""}}})).To(Succeed())
+
+			Expect(GetClusterInfo().RefreshNodeTopology(context.TODO(), cl)).To(BeTrue())
+			Expect(GetClusterInfo().GetInfraNodeCount()).To(Equal(1))
+			Expect(GetClusterInfo().GetWorkerNodeCount()).To(Equal(1))
+		})
+	})
+
 	Context("TLSSecurityProfile", func() {
 
 		DescribeTable(
@@ -16,10 +16,15 @@ const (
 	MtqVersionEnvV                   = "MTQ_VERSION"
 	KVUIPluginImageEnvV              = "KV_CONSOLE_PLUGIN_IMAGE"
 	KVUIProxyImageEnvV               = "KV_CONSOLE_PROXY_IMAGE"
+	UpgradeTimeoutEnvV               = "UPGRADE_TIMEOUT"
 	HcoValidatingWebhook             = "validate-hco.kubevirt.io"
 	HcoMutatingWebhookNS             = "mutate-ns-hco.kubevirt.io"
 	PrometheusRuleCRDName            = "prometheusrules.monitoring.coreos.com"
 	ServiceMonitorCRDName            = "servicemonitors.monitoring.coreos.com"
+	PodMonitorCRDName                = "podmonitors.monitoring.coreos.com"
+	ScrapeConfigCRDName              = "scrapeconfigs.monitoring.coreos.com"
+	AlertmanagerConfigCRDName        = "alertmanagerconfigs.monitoring.coreos.com"
+	MTQCRDName                       = "mtqs.mtq.kubevirt.io"
 	HcoMutatingWebhookHyperConverged = "mutate-hyperconverged-hco.kubevirt.io"
 	AppLabel                         = "app"
 	UndefinedNamespace               = ""
@@ -46,6 +51,15 @@ const (
 	OpenshiftNodeSelectorAnn = "openshift.io/node-selector"
 	KubernetesMetadataName   = "kubernetes.io/metadata.name"
 
+	// NodeRoleMasterLabel, NodeRoleWorkerLabel and NodeRoleInfraLabel mark the roles of the cluster nodes
+	NodeRoleMasterLabel = "node-role.kubernetes.io/master"
+	NodeRoleWorkerLabel = "node-role.kubernetes.io/worker"
+	NodeRoleInfraLabel  = "node-role.kubernetes.io/infra"
+
+	// the prefix of the taints that the node lifecycle controller sets by the node conditions; e.g. the unschedulable
+	// taint of the cordoned nodes
+	nodeConditionTaintPrefix = "node.kubernetes.io/"
+
 	// PrometheusNSLabel is the monitoring NS enable label, if the value is "true"
 	PrometheusNSLabel = "openshift.io/cluster-monitoring"
 
@@ -53,6 +67,7 @@ const (
 	HyperConvergedName           = "kubevirt-hyperconverged"
 	MetricsHost                  = "0.0.0.0"
 	MetricsPort            int32 = 8383
+	MetricsPortName              = "https-metrics"
 	HealthProbeHost              = "0.0.0.0"
 	HealthProbePort        int32 = 6060
 	ReadinessEndpointName        = "/readyz"
@@ -66,6 +81,11 @@ const (
 	WebhookKeyName        = "apiserver.key"
 	DefaultWebhookCertDir = "/apiserver.local.config/certificates"
 
+	MetricsCertName              = "tls.crt"
+	MetricsKeyName               = "tls.key"
+	MetricsCertDir               = "/metrics.local.config/certificates"
+	MetricsServingCertSecretName = HyperConvergedName + "-operator-metrics-cert"
+
 	CliDownloadsServerPort       = 8080
 	UIPluginServerPort     int32 = 9443
 	UIProxyServerPort      int32 = 8080
@@ -88,3 +108,10 @@ const (
 	AppComponentUIProxy     AppComponent = "kubevirt-apiserver-proxy"
 	AppComponentMultiTenant AppComponent = "multi-tenant"
 )
+
+// The node architectures, as reported by the kubelet
+const (
+	ArchAMD64 = "amd64"
+	ArchARM64 = "arm64"
+	ArchS390X = "s390x"
+)
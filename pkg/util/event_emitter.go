@@ -1,10 +1,12 @@
 package util
 
 import (
+	"path"
 	"reflect"
 
 	csvv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 )
@@ -13,6 +15,10 @@ var (
 	evntEmtr EventEmitter = &eventEmitter{}
 )
 
+// EventInvolvedObjectAnnotation is set on the copies of the events that are emitted on the HCO pod and CSV, with the
+// kind, the namespace and the name of the object that the event is about
+const EventInvolvedObjectAnnotation = "hco.kubevirt.io/involvedObject"
+
 func GetEventEmitter() EventEmitter {
 	return evntEmtr
 }
@@ -34,18 +40,50 @@ func (ee *eventEmitter) Init(pod *corev1.Pod, csv *csvv1alpha1.ClusterServiceVer
 	ee.csv = csv
 }
 
+// EmitEvent emits the event on the object that the event is about, usually the HyperConverged CR, and also on the HCO
+// pod and CSV. The copies of the event on the pod and on the CSV are annotated with the object, so they can be
+// correlated with the event on the object.
 func (ee eventEmitter) EmitEvent(object runtime.Object, eventType, reason, msg string) {
+	var annotations map[string]string
+	if !IsActuallyNil(object) {
+		ee.recorder.Event(object, eventType, reason, msg)
+		annotations = getInvolvedObjectAnnotations(object)
+	}
+
 	if ee.pod != nil {
-		ee.recorder.Event(ee.pod, eventType, reason, msg)
+		ee.emitCopy(ee.pod, annotations, eventType, reason, msg)
 	}
 
-	if !IsActuallyNil(object) {
+	if ee.csv != nil {
+		ee.emitCopy(ee.csv, annotations, eventType, reason, msg)
+	}
+}
+
+func (ee eventEmitter) emitCopy(object runtime.Object, annotations map[string]string, eventType, reason, msg string) {
+	if len(annotations) == 0 {
 		ee.recorder.Event(object, eventType, reason, msg)
+		return
 	}
 
-	if ee.csv != nil {
-		ee.recorder.Event(ee.csv, eventType, reason, msg)
+	ee.recorder.AnnotatedEventf(object, annotations, eventType, reason, "%s", msg)
+}
+
+// getInvolvedObjectAnnotations returns the annotation that references the object that the event is about; e.g.
+// "HyperConverged/kubevirt-hyperconverged/kubevirt-hyperconverged"
+func getInvolvedObjectAnnotations(object runtime.Object) map[string]string {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return nil
+	}
+
+	kind := object.GetObjectKind().GroupVersionKind().Kind
+	if kind == "" {
+		// the type meta of the typed objects is usually empty
+		kind = reflect.Indirect(reflect.ValueOf(object)).Type().Name()
 	}
+
+	ref := path.Join(kind, accessor.GetNamespace(), accessor.GetName())
+	return map[string]string{EventInvolvedObjectAnnotation: ref}
 }
 
 // IsActuallyNil checks if an interface object is actually nil. Just checking for == nil won't work, if the parameter is
@@ -1,6 +1,8 @@
 package util
 
 import (
+	"fmt"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	csvv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
@@ -137,6 +139,11 @@ var _ = Describe("", func() {
 				Expect(found).To(BeTrue())
 				Expect(rsEvent).Should(Equal(expectedEvent))
 
+				// the copies of the event are correlated with the replica set
+				expectedEvent.annotations = map[string]string{
+					EventInvolvedObjectAnnotation: "ReplicaSet/" + namespace + "/" + rsName,
+				}
+
 				rsEvent, found = mock.events["Pod"]
 				Expect(found).To(BeTrue())
 				Expect(rsEvent).Should(Equal(expectedEvent))
@@ -148,6 +155,33 @@ var _ = Describe("", func() {
 
 		})
 
+		It("should not annotate the events on the pod and on the csv, if the object is nil", func() {
+			pod := &corev1.Pod{
+				TypeMeta:   metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: podName, Namespace: namespace},
+			}
+
+			ee.Init(pod, nil, recorder)
+
+			ee.EmitEvent(nil, corev1.EventTypeNormal, "justTesting", "this is a test message")
+			mock := ee.recorder.(*EventRecorderMock)
+
+			Expect(mock.events).To(HaveLen(1))
+			Expect(mock.events["Pod"]).To(Equal(eventMock{
+				eventType: corev1.EventTypeNormal,
+				reason:    "justTesting",
+				message:   "this is a test message",
+			}))
+		})
+
+		It("should use the type name of typed objects without type meta", func() {
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "justACmForTest", Namespace: namespace},
+			}
+
+			Expect(getInvolvedObjectAnnotations(cm)).To(HaveKeyWithValue(EventInvolvedObjectAnnotation, "ConfigMap/"+namespace+"/justACmForTest"))
+		})
+
 		It("should not update resource if it's nil", func() {
 			ee.Init(nil, nil, recorder)
 
@@ -174,9 +208,10 @@ var _ = Describe("", func() {
 })
 
 type eventMock struct {
-	eventType string
-	reason    string
-	message   string
+	eventType   string
+	reason      string
+	message     string
+	annotations map[string]string
 }
 
 type EventRecorderMock struct {
@@ -196,6 +231,7 @@ func (mock EventRecorderMock) Event(object runtime.Object, eventType, reason, me
 func (mock EventRecorderMock) Eventf(_ runtime.Object, _, _, _ string, _ ...interface{}) {
 	/* not implemented */
 }
-func (mock EventRecorderMock) AnnotatedEventf(_ runtime.Object, _ map[string]string, _, _, _ string, _ ...interface{}) {
-	/* not implemented */
+func (mock EventRecorderMock) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventType, reason, messageFmt string, args ...interface{}) {
+	kind := object.GetObjectKind().GroupVersionKind().Kind
+	mock.events[kind] = eventMock{eventType: eventType, reason: reason, message: fmt.Sprintf(messageFmt, args...), annotations: annotations}
 }
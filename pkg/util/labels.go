@@ -14,3 +14,26 @@ func DeepCopyLabels(src, tgt *metav1.ObjectMeta) {
 		tgt.Labels[key] = val
 	}
 }
+
+// UnknownFieldManager is returned by GetLastFieldManager if the managed fields of the object are not available
+const UnknownFieldManager = "unknown"
+
+// GetLastFieldManager returns the field manager of the latest update of the object, according to its managed fields.
+// The updates of subresources, like the status, are ignored, as they are done by the owning operators.
+func GetLastFieldManager(obj metav1.Object) string {
+	manager := UnknownFieldManager
+	var lastUpdate *metav1.Time
+
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Subresource != "" || entry.Time == nil {
+			continue
+		}
+
+		if lastUpdate == nil || !entry.Time.Before(lastUpdate) {
+			lastUpdate = entry.Time
+			manager = entry.Manager
+		}
+	}
+
+	return manager
+}
@@ -1,6 +1,8 @@
 package util
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -49,4 +51,35 @@ var _ = Describe("Test Labels", func() {
 			Expect(dest.Labels).To(HaveKeyWithValue("bbb", "222"))
 		})
 	})
+
+	Context("test GetLastFieldManager", func() {
+		now := time.Now()
+
+		It("should return unknown if there are no managed fields", func() {
+			Expect(GetLastFieldManager(&metav1.ObjectMeta{})).To(Equal(UnknownFieldManager))
+		})
+
+		It("should return the field manager of the latest update", func() {
+			obj := &metav1.ObjectMeta{
+				ManagedFields: []metav1.ManagedFieldsEntry{
+					{Manager: "first", Operation: metav1.ManagedFieldsOperationUpdate, Time: &metav1.Time{Time: now.Add(-time.Hour)}},
+					{Manager: "last", Operation: metav1.ManagedFieldsOperationApply, Time: &metav1.Time{Time: now}},
+					{Manager: "second", Operation: metav1.ManagedFieldsOperationUpdate, Time: &metav1.Time{Time: now.Add(-time.Minute)}},
+				},
+			}
+
+			Expect(GetLastFieldManager(obj)).To(Equal("last"))
+		})
+
+		It("should ignore the updates of subresources", func() {
+			obj := &metav1.ObjectMeta{
+				ManagedFields: []metav1.ManagedFieldsEntry{
+					{Manager: "kubectl-edit", Operation: metav1.ManagedFieldsOperationUpdate, Time: &metav1.Time{Time: now.Add(-time.Minute)}},
+					{Manager: "virt-operator", Operation: metav1.ManagedFieldsOperationUpdate, Time: &metav1.Time{Time: now}, Subresource: "status"},
+				},
+			}
+
+			Expect(GetLastFieldManager(obj)).To(Equal("kubectl-edit"))
+		})
+	})
 })
@@ -0,0 +1,73 @@
+package util
+
+import (
+	"context"
+
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ManagedService is the managed OpenShift offering that the cluster is running on
+type ManagedService string
+
+const (
+	// ManagedServiceNone means that the cluster is not a managed OpenShift service
+	ManagedServiceNone ManagedService = ""
+	// ManagedServiceOSD is OpenShift Dedicated
+	ManagedServiceOSD ManagedService = "OSD"
+	// ManagedServiceROSA is Red Hat OpenShift Service on AWS
+	ManagedServiceROSA ManagedService = "ROSA"
+	// ManagedServiceARO is Azure Red Hat OpenShift
+	ManagedServiceARO ManagedService = "ARO"
+)
+
+const (
+	// ManagedServiceInfraNodeTaintKey is the key of the taint of the infra nodes of the managed OpenShift services
+	ManagedServiceInfraNodeTaintKey = NodeRoleInfraLabel
+
+	aroClusterCRDName           = "clusters.aro.openshift.io"
+	managedUpgradeConfigCRDName = "upgradeconfigs.upgrade.managed.openshift.io"
+
+	awsClusterTypeTag  = "red-hat-clustertype"
+	awsClusterTypeROSA = "rosa"
+)
+
+// IsManaged returns true if the cluster is a managed OpenShift service
+func (ms ManagedService) IsManaged() bool {
+	return ms != ManagedServiceNone
+}
+
+// detectManagedService finds the managed OpenShift offering that the cluster is running on.
+//
+// ARO clusters are detected by the CRD of the ARO operator. ROSA clusters are tagged with the cluster type on AWS.
+// Other clusters that run the managed upgrade operator of the managed services are OpenShift Dedicated clusters.
+func detectManagedService(ctx context.Context, cl client.Reader, infrastructure *openshiftconfigv1.Infrastructure) ManagedService {
+	if isCRDExists(ctx, cl, aroClusterCRDName) {
+		return ManagedServiceARO
+	}
+
+	if isROSA(infrastructure) {
+		return ManagedServiceROSA
+	}
+
+	if isCRDExists(ctx, cl, managedUpgradeConfigCRDName) {
+		return ManagedServiceOSD
+	}
+
+	return ManagedServiceNone
+}
+
+func isROSA(infrastructure *openshiftconfigv1.Infrastructure) bool {
+	platformStatus := infrastructure.Status.PlatformStatus
+	if platformStatus == nil || platformStatus.AWS == nil {
+		return false
+	}
+
+	for _, tag := range platformStatus.AWS.ResourceTags {
+		if tag.Key == awsClusterTypeTag && tag.Value == awsClusterTypeROSA {
+			return true
+		}
+	}
+
+	return false
+}
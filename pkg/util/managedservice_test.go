@@ -0,0 +1,83 @@
+package util
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("test detectManagedService", func() {
+	newClient := func(objs ...client.Object) client.Client {
+		testScheme := runtime.NewScheme()
+		Expect(apiextensionsv1.AddToScheme(testScheme)).To(Succeed())
+
+		return fake.NewClientBuilder().WithScheme(testScheme).WithObjects(objs...).Build()
+	}
+
+	newCRD := func(name string) *apiextensionsv1.CustomResourceDefinition {
+		return &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		}
+	}
+
+	newInfrastructure := func(tags ...openshiftconfigv1.AWSResourceTag) *openshiftconfigv1.Infrastructure {
+		return &openshiftconfigv1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster",
+			},
+			Status: openshiftconfigv1.InfrastructureStatus{
+				PlatformStatus: &openshiftconfigv1.PlatformStatus{
+					Type: openshiftconfigv1.AWSPlatformType,
+					AWS: &openshiftconfigv1.AWSPlatformStatus{
+						ResourceTags: tags,
+					},
+				},
+			},
+		}
+	}
+
+	It("should not detect a managed service on a self-managed cluster", func() {
+		ms := detectManagedService(context.Background(), newClient(), newInfrastructure())
+		Expect(ms).To(Equal(ManagedServiceNone))
+		Expect(ms.IsManaged()).To(BeFalse())
+	})
+
+	It("should detect ARO by the CRD of the ARO operator", func() {
+		ms := detectManagedService(context.Background(), newClient(newCRD(aroClusterCRDName)), newInfrastructure())
+		Expect(ms).To(Equal(ManagedServiceARO))
+		Expect(ms.IsManaged()).To(BeTrue())
+	})
+
+	It("should detect ROSA by the cluster type tag", func() {
+		infrastructure := newInfrastructure(
+			openshiftconfigv1.AWSResourceTag{Key: "red-hat-managed", Value: "true"},
+			openshiftconfigv1.AWSResourceTag{Key: awsClusterTypeTag, Value: awsClusterTypeROSA},
+		)
+
+		ms := detectManagedService(context.Background(), newClient(newCRD(managedUpgradeConfigCRDName)), infrastructure)
+		Expect(ms).To(Equal(ManagedServiceROSA))
+	})
+
+	It("should detect OSD by the CRD of the managed upgrade operator", func() {
+		infrastructure := newInfrastructure(openshiftconfigv1.AWSResourceTag{Key: "red-hat-managed", Value: "true"})
+
+		ms := detectManagedService(context.Background(), newClient(newCRD(managedUpgradeConfigCRDName)), infrastructure)
+		Expect(ms).To(Equal(ManagedServiceOSD))
+	})
+
+	It("should not fail if the platform status is not set", func() {
+		infrastructure := newInfrastructure()
+		infrastructure.Status.PlatformStatus = nil
+
+		Expect(detectManagedService(context.Background(), newClient(), infrastructure)).To(Equal(ManagedServiceNone))
+	})
+})
@@ -0,0 +1,87 @@
+package util
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	certutil "k8s.io/client-go/util/cert"
+)
+
+// MetricsCertProvider provides the serving certificate of the metrics server.
+//
+// The certificate is read from the mounted serving certificate secret, and is reloaded whenever the mounted files
+// are modified, so a rotated certificate is used without restarting the operator. The secret is only created after
+// the metrics service, so the certificate files may not exist when the operator starts. Until they are mounted, a
+// self-signed certificate is used.
+type MetricsCertProvider struct {
+	certFile string
+	keyFile  string
+
+	lock       sync.Mutex
+	cert       *tls.Certificate
+	certMod    time.Time
+	keyMod     time.Time
+	selfSigned *tls.Certificate
+}
+
+func NewMetricsCertProvider(certDir string) *MetricsCertProvider {
+	return &MetricsCertProvider{
+		certFile: filepath.Join(certDir, MetricsCertName),
+		keyFile:  filepath.Join(certDir, MetricsKeyName),
+	}
+}
+
+// GetCertificate implements the tls.Config GetCertificate callback
+func (p *MetricsCertProvider) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	certInfo, certErr := os.Stat(p.certFile)
+	keyInfo, keyErr := os.Stat(p.keyFile)
+	if certErr != nil || keyErr != nil {
+		return p.getSelfSignedCertificate()
+	}
+
+	if p.cert != nil && certInfo.ModTime().Equal(p.certMod) && keyInfo.ModTime().Equal(p.keyMod) {
+		return p.cert, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(p.certFile, p.keyFile)
+	if err != nil {
+		if p.cert != nil {
+			// the files may be in the middle of an update; keep using the last valid certificate
+			return p.cert, nil
+		}
+		return nil, fmt.Errorf("failed to load the metrics serving certificate; %w", err)
+	}
+
+	p.cert = &cert
+	p.certMod = certInfo.ModTime()
+	p.keyMod = keyInfo.ModTime()
+
+	return p.cert, nil
+}
+
+func (p *MetricsCertProvider) getSelfSignedCertificate() (*tls.Certificate, error) {
+	if p.selfSigned != nil {
+		return p.selfSigned, nil
+	}
+
+	certPEM, keyPEM, err := certutil.GenerateSelfSignedCertKeyWithFixtures("localhost", []net.IP{{127, 0, 0, 1}}, nil, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate a self-signed certificate for the metrics server; %w", err)
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a self-signed key pair for the metrics server; %w", err)
+	}
+
+	p.selfSigned = &cert
+	return p.selfSigned, nil
+}
@@ -0,0 +1,109 @@
+package util
+
+import (
+	"crypto/tls"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+var _ = Describe("test MetricsCertProvider", func() {
+	var certDir string
+
+	BeforeEach(func() {
+		certDir = GinkgoT().TempDir()
+	})
+
+	writeCert := func(host string, modTime time.Time) []byte {
+		certPEM, keyPEM, err := certutil.GenerateSelfSignedCertKey(host, nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		certFile := filepath.Join(certDir, MetricsCertName)
+		keyFile := filepath.Join(certDir, MetricsKeyName)
+		Expect(os.WriteFile(certFile, certPEM, 0600)).To(Succeed())
+		Expect(os.WriteFile(keyFile, keyPEM, 0600)).To(Succeed())
+		Expect(os.Chtimes(certFile, modTime, modTime)).To(Succeed())
+		Expect(os.Chtimes(keyFile, modTime, modTime)).To(Succeed())
+
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		Expect(err).ToNot(HaveOccurred())
+		return cert.Certificate[0]
+	}
+
+	It("should use a self-signed certificate if the certificate files are missing", func() {
+		provider := NewMetricsCertProvider(certDir)
+
+		cert, err := provider.GetCertificate(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cert).ToNot(BeNil())
+
+		again, err := provider.GetCertificate(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(again).To(BeIdenticalTo(cert))
+	})
+
+	It("should use the mounted certificate", func() {
+		expected := writeCert("first.example.com", time.Now().Add(-time.Hour))
+		provider := NewMetricsCertProvider(certDir)
+
+		cert, err := provider.GetCertificate(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cert.Certificate[0]).To(Equal(expected))
+	})
+
+	It("should switch from the self-signed certificate once the certificate is mounted", func() {
+		provider := NewMetricsCertProvider(certDir)
+
+		selfSigned, err := provider.GetCertificate(nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		expected := writeCert("first.example.com", time.Now().Add(-time.Hour))
+
+		cert, err := provider.GetCertificate(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cert).ToNot(BeIdenticalTo(selfSigned))
+		Expect(cert.Certificate[0]).To(Equal(expected))
+	})
+
+	It("should reload a rotated certificate", func() {
+		first := writeCert("first.example.com", time.Now().Add(-time.Hour))
+		provider := NewMetricsCertProvider(certDir)
+
+		cert, err := provider.GetCertificate(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cert.Certificate[0]).To(Equal(first))
+
+		second := writeCert("second.example.com", time.Now())
+
+		cert, err = provider.GetCertificate(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cert.Certificate[0]).To(Equal(second))
+	})
+
+	It("should keep the last valid certificate if the new files are invalid", func() {
+		first := writeCert("first.example.com", time.Now().Add(-time.Hour))
+		provider := NewMetricsCertProvider(certDir)
+
+		_, err := provider.GetCertificate(nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(os.WriteFile(filepath.Join(certDir, MetricsCertName), []byte("not a certificate"), 0600)).To(Succeed())
+
+		cert, err := provider.GetCertificate(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cert.Certificate[0]).To(Equal(first))
+	})
+
+	It("should fail if the mounted certificate is invalid", func() {
+		Expect(os.WriteFile(filepath.Join(certDir, MetricsCertName), []byte("not a certificate"), 0600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(certDir, MetricsKeyName), []byte("not a key"), 0600)).To(Succeed())
+		provider := NewMetricsCertProvider(certDir)
+
+		_, err := provider.GetCertificate(nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
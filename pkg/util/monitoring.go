@@ -0,0 +1,104 @@
+package util
+
+import (
+	"context"
+	"os"
+	"sort"
+
+	"github.com/go-logr/logr"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// MonitoringNamespaceEnv and MonitoringServiceAccountEnv override the discovered namespace and ServiceAccount of the
+	// Prometheus instance that scrapes the HCO metrics
+	MonitoringNamespaceEnv      = "MONITORING_NAMESPACE"
+	MonitoringServiceAccountEnv = "MONITORING_SERVICE_ACCOUNT"
+
+	defaultMonitoringNamespace               = "monitoring"
+	openshiftMonitoringNamespace             = "openshift-monitoring"
+	openshiftUserWorkloadMonitoringNamespace = "openshift-user-workload-monitoring"
+	defaultMonitoringServiceAccount          = "prometheus-k8s"
+	// the Prometheus operator runs the Prometheus pods with the default ServiceAccount, if not set in the Prometheus CR
+	prometheusDefaultServiceAccount = "default"
+)
+
+type monitoringStack struct {
+	namespace      string
+	serviceAccount string
+}
+
+// discoverMonitoringStack finds the namespace and the ServiceAccount of the Prometheus instance that should scrape the
+// HCO metrics.
+//
+// On OpenShift, the platform monitoring stack is preferred, and the user workload monitoring stack is used if the
+// platform Prometheus is not deployed. On other clusters, the Prometheus CRs of the Prometheus operator are used.
+// If no Prometheus CR is found, the defaults of the platform monitoring (on OpenShift) or of kube-prometheus are used.
+func discoverMonitoringStack(ctx context.Context, cl client.Client, isOpenshift bool, logger logr.Logger) monitoringStack {
+	stack := getDefaultMonitoringStack(isOpenshift)
+
+	if found, ok := findPrometheus(ctx, cl, isOpenshift, logger); ok {
+		stack.namespace = found.Namespace
+		stack.serviceAccount = found.Spec.ServiceAccountName
+		if stack.serviceAccount == "" {
+			stack.serviceAccount = prometheusDefaultServiceAccount
+		}
+	}
+
+	if ns, ok := os.LookupEnv(MonitoringNamespaceEnv); ok && ns != "" {
+		stack.namespace = ns
+	}
+
+	if sa, ok := os.LookupEnv(MonitoringServiceAccountEnv); ok && sa != "" {
+		stack.serviceAccount = sa
+	}
+
+	logger.Info("Monitoring stack", "namespace", stack.namespace, "serviceAccount", stack.serviceAccount)
+
+	return stack
+}
+
+func getDefaultMonitoringStack(isOpenshift bool) monitoringStack {
+	if isOpenshift {
+		return monitoringStack{namespace: openshiftMonitoringNamespace, serviceAccount: defaultMonitoringServiceAccount}
+	}
+
+	return monitoringStack{namespace: defaultMonitoringNamespace, serviceAccount: defaultMonitoringServiceAccount}
+}
+
+func findPrometheus(ctx context.Context, cl client.Client, isOpenshift bool, logger logr.Logger) (*monitoringv1.Prometheus, bool) {
+	prometheusList := &monitoringv1.PrometheusList{}
+	if err := cl.List(ctx, prometheusList); err != nil {
+		logger.Info("can't read the Prometheus CRs; using the default monitoring stack", "error", err.Error())
+		return nil, false
+	}
+
+	if len(prometheusList.Items) == 0 {
+		return nil, false
+	}
+
+	prometheuses := prometheusList.Items
+	sort.Slice(prometheuses, func(i, j int) bool {
+		if prometheuses[i].Namespace != prometheuses[j].Namespace {
+			return prometheuses[i].Namespace < prometheuses[j].Namespace
+		}
+		return prometheuses[i].Name < prometheuses[j].Name
+	})
+
+	if isOpenshift {
+		for _, ns := range []string{openshiftMonitoringNamespace, openshiftUserWorkloadMonitoringNamespace} {
+			for _, prometheus := range prometheuses {
+				if prometheus.Namespace == ns {
+					return prometheus, true
+				}
+			}
+		}
+	}
+
+	if len(prometheuses) > 1 {
+		logger.Info("found more than one Prometheus CR; using the first one", "namespace", prometheuses[0].Namespace, "name", prometheuses[0].Name)
+	}
+
+	return prometheuses[0], true
+}
@@ -0,0 +1,204 @@
+package util
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+var _ = Describe("test discoverMonitoringStack", func() {
+	logger := zap.New(zap.WriteTo(GinkgoWriter), zap.UseDevMode(true)).WithName("monitoring_test")
+
+	newPrometheus := func(namespace, name, serviceAccount string) *monitoringv1.Prometheus {
+		return &monitoringv1.Prometheus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Spec: monitoringv1.PrometheusSpec{
+				CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+					ServiceAccountName: serviceAccount,
+				},
+			},
+		}
+	}
+
+	newClient := func(objs ...client.Object) client.Client {
+		testScheme := runtime.NewScheme()
+		Expect(monitoringv1.AddToScheme(testScheme)).To(Succeed())
+
+		return fake.NewClientBuilder().WithScheme(testScheme).WithObjects(objs...).Build()
+	}
+
+	BeforeEach(func() {
+		Expect(os.Unsetenv(MonitoringNamespaceEnv)).To(Succeed())
+		Expect(os.Unsetenv(MonitoringServiceAccountEnv)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.Unsetenv(MonitoringNamespaceEnv)).To(Succeed())
+		Expect(os.Unsetenv(MonitoringServiceAccountEnv)).To(Succeed())
+	})
+
+	DescribeTable("should use the defaults if there is no Prometheus CR", func(isOpenshift bool, expectedNamespace string) {
+		stack := discoverMonitoringStack(context.Background(), newClient(), isOpenshift, logger)
+
+		Expect(stack.namespace).To(Equal(expectedNamespace))
+		Expect(stack.serviceAccount).To(Equal("prometheus-k8s"))
+	},
+		Entry("on OpenShift", true, "openshift-monitoring"),
+		Entry("on Kubernetes", false, "monitoring"),
+	)
+
+	It("should use the defaults if the Prometheus kind is not available", func() {
+		cl := fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build()
+
+		stack := discoverMonitoringStack(context.Background(), cl, false, logger)
+
+		Expect(stack.namespace).To(Equal("monitoring"))
+		Expect(stack.serviceAccount).To(Equal("prometheus-k8s"))
+	})
+
+	It("should prefer the platform monitoring stack on OpenShift", func() {
+		cl := newClient(
+			newPrometheus("openshift-user-workload-monitoring", "user-workload", "prometheus-user-workload"),
+			newPrometheus("openshift-monitoring", "k8s", "prometheus-k8s"),
+			newPrometheus("another-monitoring", "custom", "custom-prometheus"),
+		)
+
+		stack := discoverMonitoringStack(context.Background(), cl, true, logger)
+
+		Expect(stack.namespace).To(Equal("openshift-monitoring"))
+		Expect(stack.serviceAccount).To(Equal("prometheus-k8s"))
+	})
+
+	It("should use the user workload monitoring stack on OpenShift, if the platform Prometheus is missing", func() {
+		cl := newClient(
+			newPrometheus("another-monitoring", "custom", "custom-prometheus"),
+			newPrometheus("openshift-user-workload-monitoring", "user-workload", "prometheus-user-workload"),
+		)
+
+		stack := discoverMonitoringStack(context.Background(), cl, true, logger)
+
+		Expect(stack.namespace).To(Equal("openshift-user-workload-monitoring"))
+		Expect(stack.serviceAccount).To(Equal("prometheus-user-workload"))
+	})
+
+	It("should use the Prometheus CR on Kubernetes", func() {
+		cl := newClient(
+			newPrometheus("prometheus", "second", "second-prometheus"),
+			newPrometheus("kube-prometheus", "first", "first-prometheus"),
+		)
+
+		stack := discoverMonitoringStack(context.Background(), cl, false, logger)
+
+		Expect(stack.namespace).To(Equal("kube-prometheus"))
+		Expect(stack.serviceAccount).To(Equal("first-prometheus"))
+	})
+
+	It("should use the default ServiceAccount, if not set in the Prometheus CR", func() {
+		cl := newClient(newPrometheus("prometheus", "prometheus", ""))
+
+		stack := discoverMonitoringStack(context.Background(), cl, false, logger)
+
+		Expect(stack.namespace).To(Equal("prometheus"))
+		Expect(stack.serviceAccount).To(Equal("default"))
+	})
+
+	It("should use the environment variables, if set", func() {
+		Expect(os.Setenv(MonitoringNamespaceEnv, "my-monitoring")).To(Succeed())
+		Expect(os.Setenv(MonitoringServiceAccountEnv, "my-prometheus")).To(Succeed())
+		cl := newClient(newPrometheus("prometheus", "prometheus", "prometheus"))
+
+		stack := discoverMonitoringStack(context.Background(), cl, false, logger)
+
+		Expect(stack.namespace).To(Equal("my-monitoring"))
+		Expect(stack.serviceAccount).To(Equal("my-prometheus"))
+	})
+})
+
+var _ = Describe("test RefreshMonitoringAvailability", func() {
+	logger := zap.New(zap.WriteTo(GinkgoWriter), zap.UseDevMode(true)).WithName("monitoring_test")
+
+	newCRD := func(name string) *apiextensionsv1.CustomResourceDefinition {
+		return &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		}
+	}
+
+	newClient := func(objs ...client.Object) client.Client {
+		testScheme := runtime.NewScheme()
+		Expect(monitoringv1.AddToScheme(testScheme)).To(Succeed())
+		Expect(apiextensionsv1.AddToScheme(testScheme)).To(Succeed())
+
+		return fake.NewClientBuilder().WithScheme(testScheme).WithObjects(objs...).Build()
+	}
+
+	It("should enable monitoring once all the monitoring CRDs are installed", func() {
+		ci := &ClusterInfoImp{logger: logger}
+		cl := newClient(newCRD(PrometheusRuleCRDName), newCRD(ServiceMonitorCRDName))
+
+		Expect(ci.RefreshMonitoringAvailability(context.Background(), cl)).To(BeFalse())
+		Expect(ci.IsMonitoringAvailable()).To(BeFalse())
+
+		Expect(cl.Create(context.Background(), newCRD(PodMonitorCRDName))).To(Succeed())
+
+		Expect(ci.RefreshMonitoringAvailability(context.Background(), cl)).To(BeTrue())
+		Expect(ci.IsMonitoringAvailable()).To(BeTrue())
+		Expect(ci.GetMonitoringNamespace()).To(Equal("monitoring"))
+		Expect(ci.GetMonitoringServiceAccount()).To(Equal("prometheus-k8s"))
+
+		Expect(ci.RefreshMonitoringAvailability(context.Background(), cl)).To(BeFalse())
+		Expect(ci.IsMonitoringAvailable()).To(BeTrue())
+		Expect(ci.IsScrapeConfigAvailable()).To(BeFalse())
+		Expect(ci.IsAlertmanagerConfigAvailable()).To(BeFalse())
+	})
+
+	It("should detect the ScrapeConfig CRD when the monitoring CRDs are installed", func() {
+		ci := &ClusterInfoImp{logger: logger}
+		cl := newClient(newCRD(PrometheusRuleCRDName), newCRD(ServiceMonitorCRDName), newCRD(PodMonitorCRDName), newCRD(ScrapeConfigCRDName))
+
+		Expect(ci.RefreshMonitoringAvailability(context.Background(), cl)).To(BeTrue())
+		Expect(ci.IsMonitoringAvailable()).To(BeTrue())
+		Expect(ci.IsScrapeConfigAvailable()).To(BeTrue())
+	})
+
+	It("should detect the AlertmanagerConfig CRD when the monitoring CRDs are installed", func() {
+		ci := &ClusterInfoImp{logger: logger}
+		cl := newClient(newCRD(PrometheusRuleCRDName), newCRD(ServiceMonitorCRDName), newCRD(PodMonitorCRDName), newCRD(AlertmanagerConfigCRDName))
+
+		Expect(ci.RefreshMonitoringAvailability(context.Background(), cl)).To(BeTrue())
+		Expect(ci.IsMonitoringAvailable()).To(BeTrue())
+		Expect(ci.IsAlertmanagerConfigAvailable()).To(BeTrue())
+	})
+
+	It("should disable monitoring if a monitoring CRD is removed", func() {
+		ci := &ClusterInfoImp{logger: logger, monitoringAvailable: true}
+		cl := newClient(newCRD(PrometheusRuleCRDName), newCRD(PodMonitorCRDName))
+
+		Expect(ci.RefreshMonitoringAvailability(context.Background(), cl)).To(BeTrue())
+		Expect(ci.IsMonitoringAvailable()).To(BeFalse())
+		Expect(ci.IsScrapeConfigAvailable()).To(BeFalse())
+		Expect(ci.IsAlertmanagerConfigAvailable()).To(BeFalse())
+	})
+
+	DescribeTable("should identify the monitoring CRDs", func(name string, expected bool) {
+		Expect(IsMonitoringCRD(name)).To(Equal(expected))
+	},
+		Entry("PrometheusRule", PrometheusRuleCRDName, true),
+		Entry("ServiceMonitor", ServiceMonitorCRDName, true),
+		Entry("PodMonitor", PodMonitorCRDName, true),
+		Entry("other CRD", "hyperconvergeds.hco.kubevirt.io", false),
+	)
+})
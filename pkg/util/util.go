@@ -20,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/reference"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -97,6 +98,27 @@ func GetRuntimeObject(ctx context.Context, c client.Client, obj client.Object) e
 	return c.Get(ctx, key, obj)
 }
 
+// NetworkAttachmentDefinitionGVK is the GroupVersionKind of the multus NetworkAttachmentDefinition
+var NetworkAttachmentDefinitionGVK = schema.GroupVersionKind{Group: "k8s.cni.cncf.io", Version: "v1", Kind: "NetworkAttachmentDefinition"}
+
+// NetworkAttachmentDefinitionExists checks if a NetworkAttachmentDefinition exists. Only its metadata is read, so HCO
+// does not depend on the multus API. If the NetworkAttachmentDefinition CRD is not installed, the
+// NetworkAttachmentDefinition does not exist.
+func NetworkAttachmentDefinitionExists(ctx context.Context, c client.Client, namespace, name string) (bool, error) {
+	nad := &metav1.PartialObjectMetadata{}
+	nad.SetGroupVersionKind(NetworkAttachmentDefinitionGVK)
+
+	err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, nad)
+	if err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
 // ComponentResourceRemoval removes the resource `obj` if it exists and belongs to the HCO
 // with wait=true it will wait, (util ctx timeout, please set it!) for the resource to be effectively deleted
 func ComponentResourceRemoval(ctx context.Context, c client.Client, obj interface{}, hcoName string, logger logr.Logger, dryRun bool, wait bool, protectNonHCOObjects bool) (bool, error) {
@@ -9,6 +9,7 @@ import (
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -97,6 +98,28 @@ var _ = Describe("Test general utilities", func() {
 		})
 	})
 
+	Context("test NetworkAttachmentDefinitionExists", func() {
+		newNAD := func(namespace, name string) *unstructured.Unstructured {
+			nad := &unstructured.Unstructured{}
+			nad.SetGroupVersionKind(NetworkAttachmentDefinitionGVK)
+			nad.SetNamespace(namespace)
+			nad.SetName(name)
+			return nad
+		}
+
+		It("should find an existing NetworkAttachmentDefinition", func() {
+			cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(newNAD("test-namespace", "migration-network")).Build()
+
+			Expect(NetworkAttachmentDefinitionExists(context.Background(), cl, "test-namespace", "migration-network")).To(BeTrue())
+		})
+
+		It("should not find a NetworkAttachmentDefinition in another namespace", func() {
+			cl := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(newNAD("other-namespace", "migration-network")).Build()
+
+			Expect(NetworkAttachmentDefinitionExists(context.Background(), cl, "test-namespace", "migration-network")).To(BeFalse())
+		})
+	})
+
 	Context("test ContainsString", func() {
 		It("should return false if the list is empty", func() {
 			Expect(ContainsString([]string{}, "a word")).Should(BeFalse())
@@ -336,6 +336,54 @@ var _ = Describe("test HyperConverged mutator", func() {
 			)
 		})
 
+		Context("Check defaults for cluster level EvictionStrategy with a workload density preset", func() {
+
+			getClusterInfo := hcoutil.GetClusterInfo
+
+			AfterEach(func() {
+				hcoutil.GetClusterInfo = getClusterInfo
+			})
+
+			DescribeTable("check EvictionStrategy default", func(SNO bool, preset v1beta1.HyperConvergedWorkloadDensityPreset, expected kubevirtcorev1.EvictionStrategy) {
+				if SNO {
+					hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+						return &commontestutils.ClusterInfoSNOMock{}
+					}
+				} else {
+					hcoutil.GetClusterInfo = func() hcoutil.ClusterInfo {
+						return &commontestutils.ClusterInfoMock{}
+					}
+				}
+
+				cr.Spec.EvictionStrategy = nil
+				cr.Spec.WorkloadDensityPreset = preset
+
+				req := admission.Request{AdmissionRequest: newCreateRequest(cr, hcoV1beta1Codec)}
+
+				res := mutator.Handle(context.TODO(), req)
+				Expect(res.Allowed).To(BeTrue())
+
+				Expect(res.Patches).To(Equal([]jsonpatch.JsonPatchOperation{{
+					Operation: "add",
+					Path:      "/spec/evictionStrategy",
+					Value:     expected,
+				}}))
+			},
+				Entry("should set the eviction strategy of the dense preset if not on SNO",
+					false, v1beta1.HyperConvergedDensePreset, kubevirtcorev1.EvictionStrategyLiveMigrate,
+				),
+				Entry("should set the eviction strategy of the performance preset if not on SNO",
+					false, v1beta1.HyperConvergedPerformancePreset, kubevirtcorev1.EvictionStrategyLiveMigrateIfPossible,
+				),
+				Entry("should set EvictionStrategyNone with the dense preset on SNO",
+					true, v1beta1.HyperConvergedDensePreset, kubevirtcorev1.EvictionStrategyNone,
+				),
+				Entry("should set EvictionStrategyNone with the performance preset on SNO",
+					true, v1beta1.HyperConvergedPerformancePreset, kubevirtcorev1.EvictionStrategyNone,
+				),
+			)
+		})
+
 		DescribeTable("Check mediatedDevicesTypes -> mediatedDeviceTypes transition", func(initialMDConfiguration *v1beta1.MediatedDevicesConfiguration, patches []jsonpatch.JsonPatchOperation) {
 			cr.Spec.MediatedDevicesConfiguration = initialMDConfiguration
 
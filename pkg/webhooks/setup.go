@@ -2,9 +2,14 @@ package webhooks
 
 import (
 	"context"
+	"crypto/tls"
+	"fmt"
+	"math"
 	"os"
+	"strconv"
 
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/library-go/pkg/crypto"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/webhooks/mutator"
@@ -21,14 +26,23 @@ import (
 )
 
 const (
-	webHookCertDirEnv = "WEBHOOK_CERT_DIR"
+	webHookCertDirEnv    = "WEBHOOK_CERT_DIR"
+	webHookCertSecretEnv = "WEBHOOK_CERT_SECRET"
+
+	validatingWebHookPortEnv    = "VALIDATING_WEBHOOK_PORT"
+	validatingWebHookCertDirEnv = "VALIDATING_WEBHOOK_CERT_DIR"
+	mutatingWebHookPortEnv      = "MUTATING_WEBHOOK_PORT"
+	mutatingWebHookCertDirEnv   = "MUTATING_WEBHOOK_CERT_DIR"
 )
 
 var (
 	logger = logf.Log.WithName("webhook-setup")
 )
 
-func SetupWebhookWithManager(ctx context.Context, mgr ctrl.Manager, isOpenshift bool, hcoTLSSecurityProfile *openshiftconfigv1.TLSSecurityProfile) error {
+// SetupWebhookWithManager registers the webhooks. By default, all the webhooks are served by the webhook server of the
+// manager. If a dedicated port was set for the validating or for the mutating webhooks, a dedicated webhook server,
+// based on the webhookOpts, is added to the manager to serve them.
+func SetupWebhookWithManager(ctx context.Context, mgr ctrl.Manager, webhookOpts webhook.Options, isOpenshift bool, hcoTLSSecurityProfile *openshiftconfigv1.TLSSecurityProfile) error {
 	operatorNsEnv, nserr := hcoutil.GetOperatorNamespaceFromEnv()
 	if nserr != nil {
 		logger.Error(nserr, "failed to get operator namespace from the environment")
@@ -37,7 +51,7 @@ func SetupWebhookWithManager(ctx context.Context, mgr ctrl.Manager, isOpenshift
 
 	decoder := admission.NewDecoder(mgr.GetScheme())
 
-	whHandler := validator.NewWebhookHandler(logger, mgr.GetClient(), decoder, operatorNsEnv, isOpenshift, hcoTLSSecurityProfile)
+	whHandler := validator.NewWebhookHandler(logger, mgr.GetClient(), mgr.GetAPIReader(), decoder, operatorNsEnv, isOpenshift, hcoTLSSecurityProfile)
 	nsMutator := mutator.NewNsMutator(mgr.GetClient(), decoder, operatorNsEnv)
 	hyperConvergedMutator := mutator.NewHyperConvergedMutator(mgr.GetClient(), decoder)
 
@@ -45,15 +59,81 @@ func SetupWebhookWithManager(ctx context.Context, mgr ctrl.Manager, isOpenshift
 		return err
 	}
 
-	srv := mgr.GetWebhookServer()
+	// the webhook servers by port, so webhooks that are set to the same port are served by the same server
+	servers := map[int]webhook.Server{webhookOpts.Port: mgr.GetWebhookServer()}
+
+	validatingSrv, err := getWebhookServer(mgr, servers, webhookOpts, validatingWebHookPortEnv, validatingWebHookCertDirEnv)
+	if err != nil {
+		return err
+	}
+	validatingSrv.Register(hcoutil.HCOWebhookPath, &webhook.Admission{Handler: whHandler})
 
-	srv.Register(hcoutil.HCONSWebhookPath, &webhook.Admission{Handler: nsMutator})
-	srv.Register(hcoutil.HCOMutatingWebhookPath, &webhook.Admission{Handler: hyperConvergedMutator})
-	srv.Register(hcoutil.HCOWebhookPath, &webhook.Admission{Handler: whHandler})
+	mutatingSrv, err := getWebhookServer(mgr, servers, webhookOpts, mutatingWebHookPortEnv, mutatingWebHookCertDirEnv)
+	if err != nil {
+		return err
+	}
+	mutatingSrv.Register(hcoutil.HCONSWebhookPath, &webhook.Admission{Handler: nsMutator})
+	mutatingSrv.Register(hcoutil.HCOMutatingWebhookPath, &webhook.Admission{Handler: hyperConvergedMutator})
 
 	return nil
 }
 
+// getWebhookServer returns the webhook server for the port that is set in the portEnv environment variable, or the
+// webhook server of the manager, if the variable is not set. A new dedicated server is added to the manager, if there
+// is no server for the port yet.
+func getWebhookServer(mgr ctrl.Manager, servers map[int]webhook.Server, webhookOpts webhook.Options, portEnv, certDirEnv string) (webhook.Server, error) {
+	opts, dedicated, err := getDedicatedWebhookServerOptions(webhookOpts, portEnv, certDirEnv)
+	if err != nil {
+		return nil, err
+	}
+	if !dedicated {
+		return servers[webhookOpts.Port], nil
+	}
+
+	if srv, ok := servers[opts.Port]; ok {
+		return srv, nil
+	}
+
+	logger.Info("serving webhooks on a dedicated port", "port", opts.Port, "env", portEnv)
+	srv := webhook.NewServer(opts)
+	if err = mgr.Add(srv); err != nil {
+		return nil, err
+	}
+	servers[opts.Port] = srv
+
+	return srv, nil
+}
+
+// getDedicatedWebhookServerOptions returns the options of a dedicated webhook server, if a dedicated port was set in
+// the portEnv environment variable. The dedicated server uses the certificate of the manager webhook server, unless a
+// dedicated certificate directory was set as well, in the certDirEnv environment variable.
+func getDedicatedWebhookServerOptions(webhookOpts webhook.Options, portEnv, certDirEnv string) (webhook.Options, bool, error) {
+	portStr := os.Getenv(portEnv)
+	if portStr == "" {
+		return webhook.Options{}, false, nil
+	}
+
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port <= 0 || port > math.MaxUint16 {
+		return webhook.Options{}, false, fmt.Errorf("invalid %s value %q; must be a valid port number", portEnv, portStr)
+	}
+
+	opts := webhookOpts
+	opts.Port = port
+	opts.TLSOpts = append([]func(*tls.Config){}, webhookOpts.TLSOpts...)
+
+	if certDir := os.Getenv(certDirEnv); certDir != "" {
+		opts.CertDir = certDir
+		// read the certificate files from the dedicated directory, even if the manager webhook server reads its
+		// certificate from a Secret
+		opts.TLSOpts = append(opts.TLSOpts, func(cfg *tls.Config) {
+			cfg.GetCertificate = nil
+		})
+	}
+
+	return opts, true, nil
+}
+
 func GetWebhookCertDir() string {
 	webhookCertDir := os.Getenv(webHookCertDirEnv)
 	if webhookCertDir != "" {
@@ -63,6 +143,32 @@ func GetWebhookCertDir() string {
 	return hcoutil.DefaultWebhookCertDir
 }
 
+// GetWebhookCertSecret returns the name of a user provided Secret, in the operator namespace, to read the webhook
+// serving certificate from, instead of the certificate files in the webhook certificate directory. The returned name is
+// empty if no such Secret was set.
+func GetWebhookCertSecret() string {
+	return os.Getenv(webHookCertSecretEnv)
+}
+
+// MutateTLSConfig sets the webhook server to select the TLS ciphers and the minimal TLS version on each TLS handshake,
+// so a modified TLS security profile, either in the HyperConverged CR or in the APIServer CR, is applied without
+// restarting the webhook.
+func MutateTLSConfig(cfg *tls.Config) {
+	// This callback executes on each client call returning a new config to be used
+	// please be aware that the APIServer is using http keepalive so this is going to
+	// be executed only after a while for fresh connections and not on existing ones
+	cfg.GetConfigForClient = func(_ *tls.ClientHelloInfo) (*tls.Config, error) {
+		cipherNames, minTypedTLSVersion := validator.SelectCipherSuitesAndMinTLSVersion()
+
+		// the handshakes run concurrently, so never modify the shared config
+		clientCfg := cfg.Clone()
+		clientCfg.GetConfigForClient = nil
+		clientCfg.CipherSuites = crypto.CipherSuitesOrDie(crypto.OpenSSLToIANACipherSuites(cipherNames))
+		clientCfg.MinVersion = crypto.TLSVersionOrDie(string(minTypedTLSVersion))
+		return clientCfg, nil
+	}
+}
+
 // The OLM limits the webhook scope to the namespaces that are defined in the OperatorGroup
 // by setting namespaceSelector in the ValidatingWebhookConfiguration. We would like our webhook to intercept
 // requests from all namespaces, and fail them if they're not in the correct namespace for HCO (for CREATE).
@@ -2,8 +2,12 @@ package webhooks
 
 import (
 	"context"
+	"crypto/tls"
+	"net/http"
+	"net/url"
 	"os"
 	"path"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -19,8 +23,10 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
 
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/webhooks/validator"
 )
 
 const (
@@ -72,10 +78,226 @@ var _ = Describe("Hyperconverged API: Webhook", func() {
 			mgr, err := commontestutils.NewManagerMock(&rest.Config{}, manager.Options{WebhookServer: ws, Scheme: s}, cl, logger)
 			Expect(err).ToNot(HaveOccurred())
 
-			Expect(SetupWebhookWithManager(context.TODO(), mgr, true, nil)).To(Succeed())
+			Expect(SetupWebhookWithManager(context.TODO(), mgr, webhook.Options{}, true, nil)).To(Succeed())
 		})
 
 	})
+
+	Context("Test the dedicated webhook servers", func() {
+		var (
+			ws      webhook.Server
+			mockmgr *commontestutils.ManagerMock
+		)
+
+		BeforeEach(func() {
+			_ = os.Setenv(hcoutil.OperatorNamespaceEnv, "mynamespace")
+			os.Unsetenv(validatingWebHookPortEnv)
+			os.Unsetenv(validatingWebHookCertDirEnv)
+			os.Unsetenv(mutatingWebHookPortEnv)
+			os.Unsetenv(mutatingWebHookCertDirEnv)
+
+			cl := commontestutils.InitClient([]client.Object{})
+			ws = webhook.NewServer(webhook.Options{Port: hcoutil.WebhookPort})
+			mgr, err := commontestutils.NewManagerMock(&rest.Config{}, manager.Options{WebhookServer: ws, Scheme: scheme.Scheme}, cl, logger)
+			Expect(err).ToNot(HaveOccurred())
+			mockmgr = mgr.(*commontestutils.ManagerMock)
+		})
+
+		AfterEach(func() {
+			os.Unsetenv(validatingWebHookPortEnv)
+			os.Unsetenv(validatingWebHookCertDirEnv)
+			os.Unsetenv(mutatingWebHookPortEnv)
+			os.Unsetenv(mutatingWebHookCertDirEnv)
+		})
+
+		getPattern := func(srv webhook.Server, path string) string {
+			_, pattern := srv.WebhookMux().Handler(&http.Request{URL: &url.URL{Path: path}})
+			return pattern
+		}
+
+		It("should serve all the webhooks by the manager webhook server, by default", func() {
+			Expect(SetupWebhookWithManager(context.TODO(), mockmgr, webhook.Options{Port: hcoutil.WebhookPort}, true, nil)).To(Succeed())
+
+			Expect(mockmgr.GetRunnables()).To(BeEmpty())
+			Expect(getPattern(ws, hcoutil.HCOWebhookPath)).To(Equal(hcoutil.HCOWebhookPath))
+			Expect(getPattern(ws, hcoutil.HCOMutatingWebhookPath)).To(Equal(hcoutil.HCOMutatingWebhookPath))
+			Expect(getPattern(ws, hcoutil.HCONSWebhookPath)).To(Equal(hcoutil.HCONSWebhookPath))
+		})
+
+		It("should serve the mutating webhooks by a dedicated server, if a dedicated port was set", func() {
+			os.Setenv(mutatingWebHookPortEnv, "8443")
+			Expect(SetupWebhookWithManager(context.TODO(), mockmgr, webhook.Options{Port: hcoutil.WebhookPort}, true, nil)).To(Succeed())
+
+			Expect(mockmgr.GetRunnables()).To(HaveLen(1))
+			mutatingSrv, ok := mockmgr.GetRunnables()[0].(webhook.Server)
+			Expect(ok).To(BeTrue())
+
+			Expect(getPattern(ws, hcoutil.HCOWebhookPath)).To(Equal(hcoutil.HCOWebhookPath))
+			Expect(getPattern(ws, hcoutil.HCOMutatingWebhookPath)).To(BeEmpty())
+			Expect(getPattern(ws, hcoutil.HCONSWebhookPath)).To(BeEmpty())
+
+			Expect(getPattern(mutatingSrv, hcoutil.HCOWebhookPath)).To(BeEmpty())
+			Expect(getPattern(mutatingSrv, hcoutil.HCOMutatingWebhookPath)).To(Equal(hcoutil.HCOMutatingWebhookPath))
+			Expect(getPattern(mutatingSrv, hcoutil.HCONSWebhookPath)).To(Equal(hcoutil.HCONSWebhookPath))
+		})
+
+		It("should serve the validating webhook by a dedicated server, if a dedicated port was set", func() {
+			os.Setenv(validatingWebHookPortEnv, "9443")
+			Expect(SetupWebhookWithManager(context.TODO(), mockmgr, webhook.Options{Port: hcoutil.WebhookPort}, true, nil)).To(Succeed())
+
+			Expect(mockmgr.GetRunnables()).To(HaveLen(1))
+			validatingSrv, ok := mockmgr.GetRunnables()[0].(webhook.Server)
+			Expect(ok).To(BeTrue())
+
+			Expect(getPattern(ws, hcoutil.HCOWebhookPath)).To(BeEmpty())
+			Expect(getPattern(ws, hcoutil.HCOMutatingWebhookPath)).To(Equal(hcoutil.HCOMutatingWebhookPath))
+			Expect(getPattern(ws, hcoutil.HCONSWebhookPath)).To(Equal(hcoutil.HCONSWebhookPath))
+
+			Expect(getPattern(validatingSrv, hcoutil.HCOWebhookPath)).To(Equal(hcoutil.HCOWebhookPath))
+			Expect(getPattern(validatingSrv, hcoutil.HCOMutatingWebhookPath)).To(BeEmpty())
+		})
+
+		It("should serve the validating and the mutating webhooks by two dedicated servers", func() {
+			os.Setenv(validatingWebHookPortEnv, "9443")
+			os.Setenv(mutatingWebHookPortEnv, "8443")
+			Expect(SetupWebhookWithManager(context.TODO(), mockmgr, webhook.Options{Port: hcoutil.WebhookPort}, true, nil)).To(Succeed())
+
+			Expect(mockmgr.GetRunnables()).To(HaveLen(2))
+			validatingSrv := mockmgr.GetRunnables()[0].(webhook.Server)
+			mutatingSrv := mockmgr.GetRunnables()[1].(webhook.Server)
+
+			Expect(getPattern(validatingSrv, hcoutil.HCOWebhookPath)).To(Equal(hcoutil.HCOWebhookPath))
+			Expect(getPattern(mutatingSrv, hcoutil.HCOMutatingWebhookPath)).To(Equal(hcoutil.HCOMutatingWebhookPath))
+			Expect(getPattern(mutatingSrv, hcoutil.HCONSWebhookPath)).To(Equal(hcoutil.HCONSWebhookPath))
+		})
+
+		It("should share a dedicated server, if the same dedicated port was set for all the webhooks", func() {
+			os.Setenv(validatingWebHookPortEnv, "8443")
+			os.Setenv(mutatingWebHookPortEnv, "8443")
+			Expect(SetupWebhookWithManager(context.TODO(), mockmgr, webhook.Options{Port: hcoutil.WebhookPort}, true, nil)).To(Succeed())
+
+			Expect(mockmgr.GetRunnables()).To(HaveLen(1))
+			dedicatedSrv := mockmgr.GetRunnables()[0].(webhook.Server)
+			Expect(getPattern(dedicatedSrv, hcoutil.HCOWebhookPath)).To(Equal(hcoutil.HCOWebhookPath))
+			Expect(getPattern(dedicatedSrv, hcoutil.HCOMutatingWebhookPath)).To(Equal(hcoutil.HCOMutatingWebhookPath))
+		})
+
+		It("should not add a dedicated server, if the dedicated port is the validating webhook port", func() {
+			os.Setenv(mutatingWebHookPortEnv, strconv.Itoa(hcoutil.WebhookPort))
+			Expect(SetupWebhookWithManager(context.TODO(), mockmgr, webhook.Options{Port: hcoutil.WebhookPort}, true, nil)).To(Succeed())
+
+			Expect(mockmgr.GetRunnables()).To(BeEmpty())
+		})
+
+		DescribeTable("should reject an invalid dedicated port", func(portEnv, port string) {
+			os.Setenv(portEnv, port)
+			Expect(SetupWebhookWithManager(context.TODO(), mockmgr, webhook.Options{Port: hcoutil.WebhookPort}, true, nil)).To(MatchError(ContainSubstring("invalid %s value", portEnv)))
+		},
+			Entry("not a number", mutatingWebHookPortEnv, "abc"),
+			Entry("negative", mutatingWebHookPortEnv, "-1"),
+			Entry("too large", mutatingWebHookPortEnv, "65536"),
+			Entry("invalid validating webhook port", validatingWebHookPortEnv, "abc"),
+		)
+
+		It("should use the certificate of the validating webhook server, by default", func() {
+			os.Setenv(mutatingWebHookPortEnv, "8443")
+			opts, dedicated, err := getDedicatedWebhookServerOptions(webhook.Options{
+				Port:    hcoutil.WebhookPort,
+				CertDir: "/validating/certs",
+				TLSOpts: []func(*tls.Config){MutateTLSConfig},
+			}, mutatingWebHookPortEnv, mutatingWebHookCertDirEnv)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dedicated).To(BeTrue())
+			Expect(opts.Port).To(Equal(8443))
+			Expect(opts.CertDir).To(Equal("/validating/certs"))
+			Expect(opts.TLSOpts).To(HaveLen(1))
+		})
+
+		It("should use a dedicated certificate directory, if set", func() {
+			os.Setenv(mutatingWebHookPortEnv, "8443")
+			os.Setenv(mutatingWebHookCertDirEnv, "/mutating/certs")
+
+			setCertificate := func(cfg *tls.Config) {
+				cfg.GetCertificate = func(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+					return &tls.Certificate{}, nil
+				}
+			}
+			validatingOpts := webhook.Options{
+				Port:    hcoutil.WebhookPort,
+				CertDir: "/validating/certs",
+				TLSOpts: []func(*tls.Config){setCertificate},
+			}
+
+			opts, dedicated, err := getDedicatedWebhookServerOptions(validatingOpts, mutatingWebHookPortEnv, mutatingWebHookCertDirEnv)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dedicated).To(BeTrue())
+			Expect(opts.CertDir).To(Equal("/mutating/certs"))
+
+			cfg := &tls.Config{}
+			for _, opt := range opts.TLSOpts {
+				opt(cfg)
+			}
+			Expect(cfg.GetCertificate).To(BeNil())
+
+			By("not modifying the validating webhook server options")
+			Expect(validatingOpts.TLSOpts).To(HaveLen(1))
+		})
+	})
+
+	Context("Test MutateTLSConfig", func() {
+		AfterEach(func() {
+			validator.NewWebhookHandler(logger, nil, nil, nil, "", true, nil)
+		})
+
+		It("should apply the current TLS security profile on each handshake", func() {
+			cfg := &tls.Config{}
+			MutateTLSConfig(cfg)
+			Expect(cfg.GetConfigForClient).ToNot(BeNil())
+
+			validator.NewWebhookHandler(logger, nil, nil, nil, "", true, &openshiftconfigv1.TLSSecurityProfile{
+				Type:   openshiftconfigv1.TLSProfileModernType,
+				Modern: &openshiftconfigv1.ModernTLSProfile{},
+			})
+
+			clientCfg, err := cfg.GetConfigForClient(&tls.ClientHelloInfo{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(clientCfg.MinVersion).To(Equal(uint16(tls.VersionTLS13)))
+
+			validator.NewWebhookHandler(logger, nil, nil, nil, "", true, &openshiftconfigv1.TLSSecurityProfile{
+				Type: openshiftconfigv1.TLSProfileOldType,
+				Old:  &openshiftconfigv1.OldTLSProfile{},
+			})
+
+			clientCfg, err = cfg.GetConfigForClient(&tls.ClientHelloInfo{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(clientCfg.MinVersion).To(Equal(uint16(tls.VersionTLS10)))
+			Expect(clientCfg.CipherSuites).ToNot(BeEmpty())
+			Expect(clientCfg.GetConfigForClient).To(BeNil())
+
+			By("not modifying the shared config")
+			Expect(cfg.MinVersion).To(BeZero())
+			Expect(cfg.CipherSuites).To(BeEmpty())
+		})
+	})
+
+	Context("Test GetWebhookCertSecret", func() {
+		BeforeEach(func() {
+			os.Unsetenv(webHookCertSecretEnv)
+		})
+
+		AfterEach(func() {
+			os.Unsetenv(webHookCertSecretEnv)
+		})
+
+		It("should return an empty name, if the env var is not set", func() {
+			Expect(GetWebhookCertSecret()).To(BeEmpty())
+		})
+
+		It("should return the value of the env var, if set", func() {
+			os.Setenv(webHookCertSecretEnv, "my-webhook-cert")
+			Expect(GetWebhookCertSecret()).To(Equal("my-webhook-cert"))
+		})
+	})
 })
 
 func getTestFilesLocation() string {
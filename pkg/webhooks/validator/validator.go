@@ -5,7 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"reflect"
+	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -14,8 +17,16 @@ import (
 	"github.com/samber/lo"
 	xsync "golang.org/x/sync/errgroup"
 	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/strings/slices"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -26,17 +37,29 @@ import (
 	sspv1beta2 "kubevirt.io/ssp-operator/api/v1beta2"
 
 	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
 const (
 	updateDryRunTimeOut = time.Second * 3
+
+	// the lowest vmiCPUAllocationRatio that is allowed with the dense preset. It is the KubeVirt default ratio; a dense
+	// cluster must overcommit the CPU at least as much as the default.
+	minDensePresetCPUAllocationRatio = 10
+
+	// below this value, the virt-launcher pods request much more memory than their virtual machines can use
+	minMemoryOvercommitPercentage = 10
+	// the performance preset does not allow memory overcommit
+	maxPerformancePresetMemoryOvercommit = 100
 )
 
 type WebhookHandler struct {
-	logger      logr.Logger
-	cli         client.Client
+	logger logr.Logger
+	cli    client.Client
+	// apiReader reads directly from the API server, for resources that are not worth caching in the webhook
+	apiReader   client.Reader
 	namespace   string
 	isOpenshift bool
 	decoder     *admission.Decoder
@@ -44,11 +67,12 @@ type WebhookHandler struct {
 
 var hcoTLSConfigCache *openshiftconfigv1.TLSSecurityProfile
 
-func NewWebhookHandler(logger logr.Logger, cli client.Client, decoder *admission.Decoder, namespace string, isOpenshift bool, hcoTLSSecurityProfile *openshiftconfigv1.TLSSecurityProfile) *WebhookHandler {
+func NewWebhookHandler(logger logr.Logger, cli client.Client, apiReader client.Reader, decoder *admission.Decoder, namespace string, isOpenshift bool, hcoTLSSecurityProfile *openshiftconfigv1.TLSSecurityProfile) *WebhookHandler {
 	hcoTLSConfigCache = hcoTLSSecurityProfile
 	return &WebhookHandler{
 		logger:      logger,
 		cli:         cli,
+		apiReader:   apiReader,
 		namespace:   namespace,
 		isOpenshift: isOpenshift,
 		decoder:     decoder,
@@ -65,6 +89,7 @@ func (wh *WebhookHandler) Handle(ctx context.Context, req admission.Request) adm
 	dryRun := req.DryRun != nil && *req.DryRun
 
 	var err error
+	var warnings []string
 	switch req.Operation {
 	case admissionv1.Create:
 		if err := wh.decoder.Decode(req, obj); err != nil {
@@ -72,6 +97,7 @@ func (wh *WebhookHandler) Handle(ctx context.Context, req admission.Request) adm
 		}
 
 		err = wh.ValidateCreate(ctx, dryRun, obj)
+		warnings = append(wh.getScratchSpaceStorageClassWarnings(ctx, obj), wh.getDeprecatedFieldsWarnings(obj)...)
 	case admissionv1.Update:
 		oldObj := &v1beta1.HyperConverged{}
 		if err := wh.decoder.DecodeRaw(req.Object, obj); err != nil {
@@ -82,6 +108,8 @@ func (wh *WebhookHandler) Handle(ctx context.Context, req admission.Request) adm
 		}
 
 		err = wh.ValidateUpdate(ctx, dryRun, obj, oldObj)
+		warnings = append(wh.getScratchSpaceStorageClassWarnings(ctx, obj), wh.getDeprecatedFieldsWarnings(obj)...)
+		warnings = append(warnings, wh.getFeatureGateRemovalWarnings(obj, oldObj)...)
 	case admissionv1.Delete:
 		// In reference to PR: https://github.com/kubernetes/kubernetes/pull/76346
 		// OldObject contains the object being deleted
@@ -104,10 +132,10 @@ func (wh *WebhookHandler) Handle(ctx context.Context, req admission.Request) adm
 	}
 
 	// Return allowed if everything succeeded.
-	return admission.Allowed("")
+	return admission.Allowed("").WithWarnings(warnings...)
 }
 
-func (wh *WebhookHandler) ValidateCreate(_ context.Context, dryrun bool, hc *v1beta1.HyperConverged) error {
+func (wh *WebhookHandler) ValidateCreate(ctx context.Context, dryrun bool, hc *v1beta1.HyperConverged) error {
 	wh.logger.Info("Validating create", "name", hc.Name, "namespace:", hc.Namespace)
 
 	if err := wh.validateCertConfig(hc); err != nil {
@@ -134,19 +162,103 @@ func (wh *WebhookHandler) ValidateCreate(_ context.Context, dryrun bool, hc *v1b
 		return err
 	}
 
-	if _, err := operands.NewKubeVirt(hc); err != nil {
+	if err := wh.validateFeatureGateArchitectures(hc, nil); err != nil {
+		return err
+	}
+
+	if err := wh.validateManagedServiceLockedFields(hc, nil); err != nil {
+		return err
+	}
+
+	if err := wh.validateFeatureGateCRDs(ctx, hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateEvictionStrategy(hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateWorkloadUpdateStrategy(hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateWorkloadDensityPreset(hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateHigherWorkloadDensity(hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateImportProxy(hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateUploadProxyURLOverride(hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateMigrationPolicies(hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateCommonTemplatesNamespace(hc); err != nil {
 		return err
 	}
 
-	if _, err := operands.NewCDI(hc); err != nil {
+	if err := wh.validateCommonBootImageNamespace(hc); err != nil {
 		return err
 	}
 
-	if _, err := operands.NewNetworkAddons(hc); err != nil {
+	if err := wh.validateDefaultCPUModel(hc); err != nil {
 		return err
 	}
 
-	if _, _, err := operands.NewSSP(hc); err != nil {
+	if err := wh.validateVMISeccompProfile(hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateVMStateStorageClass(ctx, hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateLiveMigrationNetwork(ctx, hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateReplicas(ctx, hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateTrustedCABundle(ctx, hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateTopologySpreadConstraints(hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateServiceMonitorConfig(hc); err != nil {
+		return err
+	}
+
+	if err := wh.validatePauseReconcileAnnotation(hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateDeletionProtectionAnnotation(hc); err != nil {
+		return err
+	}
+
+	if err := wh.validateCustomMetadata(hc); err != nil {
+		return err
+	}
+
+	if err := wh.dryRunCreateOperands(ctx, hc); err != nil {
+		return err
+	}
+
+	if _, err := operands.NewMTQ(hc); err != nil {
 		return err
 	}
 
@@ -201,6 +313,124 @@ func (wh *WebhookHandler) ValidateUpdate(ctx context.Context, dryrun bool, reque
 		return err
 	}
 
+	if err := wh.validateFeatureGateArchitectures(requested, exists); err != nil {
+		return err
+	}
+
+	if err := wh.validateManagedServiceLockedFields(requested, exists); err != nil {
+		return err
+	}
+
+	// don't block unrelated updates if the CRD was removed after the feature gate was enabled
+	if !reflect.DeepEqual(requested.Spec.FeatureGates.EnableManagedTenantQuota, exists.Spec.FeatureGates.EnableManagedTenantQuota) {
+		if err := wh.validateFeatureGateCRDs(ctx, requested); err != nil {
+			return err
+		}
+	}
+
+	// don't block unrelated updates of clusters that were already configured with an unsupported eviction strategy
+	if !reflect.DeepEqual(requested.Spec.EvictionStrategy, exists.Spec.EvictionStrategy) {
+		if err := wh.validateEvictionStrategy(requested); err != nil {
+			return err
+		}
+	}
+
+	// don't block unrelated updates of clusters that were already configured with an invalid workload update strategy
+	if !reflect.DeepEqual(requested.Spec.WorkloadUpdateStrategy, exists.Spec.WorkloadUpdateStrategy) {
+		if err := wh.validateWorkloadUpdateStrategy(requested); err != nil {
+			return err
+		}
+	}
+
+	if err := wh.validateWorkloadDensityPreset(requested); err != nil {
+		return err
+	}
+
+	if err := wh.validateHigherWorkloadDensity(requested); err != nil {
+		return err
+	}
+
+	if err := wh.validateImportProxy(requested); err != nil {
+		return err
+	}
+
+	if err := wh.validateUploadProxyURLOverride(requested); err != nil {
+		return err
+	}
+
+	if err := wh.validateMigrationPolicies(requested); err != nil {
+		return err
+	}
+
+	if err := wh.validateCommonTemplatesNamespace(requested); err != nil {
+		return err
+	}
+
+	if err := wh.validateCommonBootImageNamespace(requested); err != nil {
+		return err
+	}
+
+	if err := wh.validateDefaultCPUModel(requested); err != nil {
+		return err
+	}
+
+	if err := wh.validateVMISeccompProfile(requested); err != nil {
+		return err
+	}
+
+	// don't block unrelated updates if an already configured storage class was removed
+	if !reflect.DeepEqual(requested.Spec.VMStateStorageClass, exists.Spec.VMStateStorageClass) {
+		if err := wh.validateVMStateStorageClass(ctx, requested); err != nil {
+			return err
+		}
+	}
+
+	// don't block unrelated updates if the migration network was removed
+	if !reflect.DeepEqual(requested.Spec.LiveMigrationConfig.Network, exists.Spec.LiveMigrationConfig.Network) {
+		if err := wh.validateLiveMigrationNetwork(ctx, requested); err != nil {
+			return err
+		}
+	}
+
+	// don't block unrelated updates if nodes were removed from the cluster
+	if !reflect.DeepEqual(requested.Spec.Infra, exists.Spec.Infra) ||
+		!reflect.DeepEqual(requested.Spec.Workloads.Replicas, exists.Spec.Workloads.Replicas) {
+		if err := wh.validateReplicas(ctx, requested); err != nil {
+			return err
+		}
+	}
+
+	// don't block unrelated updates if the trusted CA bundle ConfigMap was removed
+	if !reflect.DeepEqual(requested.Spec.TrustedCABundle, exists.Spec.TrustedCABundle) {
+		if err := wh.validateTrustedCABundle(ctx, requested); err != nil {
+			return err
+		}
+	}
+
+	if err := wh.validateTopologySpreadConstraints(requested); err != nil {
+		return err
+	}
+
+	if err := wh.validateServiceMonitorConfig(requested); err != nil {
+		return err
+	}
+
+	if err := wh.validateCustomMetadata(requested); err != nil {
+		return err
+	}
+
+	if exists.Annotations[common.PauseReconcileAnnotationName] != requested.Annotations[common.PauseReconcileAnnotationName] {
+		if err := wh.validatePauseReconcileAnnotation(requested); err != nil {
+			return err
+		}
+	}
+
+	if exists.Annotations[common.DeletionProtectionAnnotationName] != requested.Annotations[common.DeletionProtectionAnnotationName] {
+		if err := wh.validateDeletionProtectionAnnotation(requested); err != nil {
+			return err
+		}
+	}
+
 	// If no change is detected in the spec nor the annotations - nothing to validate
 	if reflect.DeepEqual(exists.Spec, requested.Spec) &&
 		reflect.DeepEqual(exists.Annotations, requested.Annotations) {
@@ -212,6 +442,12 @@ func (wh *WebhookHandler) ValidateUpdate(ctx context.Context, dryrun bool, reque
 		return err
 	}
 
+	// the MTQ CR only exists if the EnableManagedTenantQuota feature gate is set, so only check that its jsonpatch
+	// annotation can be applied, without a dry-run update
+	if _, err = operands.NewMTQ(requested); err != nil {
+		return err
+	}
+
 	toCtx, cancel := context.WithTimeout(ctx, updateDryRunTimeOut)
 	defer cancel()
 
@@ -252,6 +488,89 @@ func (wh *WebhookHandler) ValidateUpdate(ctx context.Context, dryrun bool, reque
 	return nil
 }
 
+// dryRunCreateOperands renders the operand CRs from the new HyperConverged CR, and dry-run creates them, so the
+// operand CRD schemas and the operand webhooks reject a bad configuration before the HyperConverged CR is created,
+// rather than later, in the operand conditions
+func (wh *WebhookHandler) dryRunCreateOperands(ctx context.Context, hc *v1beta1.HyperConverged) error {
+	kv, err := operands.NewKubeVirt(hc)
+	if err != nil {
+		return err
+	}
+
+	cdi, err := operands.NewCDI(hc)
+	if err != nil {
+		return err
+	}
+
+	cna, err := operands.NewNetworkAddons(hc)
+	if err != nil {
+		return err
+	}
+
+	ssp, _, err := operands.NewSSP(hc)
+	if err != nil {
+		return err
+	}
+
+	resources := []client.Object{
+		kv,
+		cdi,
+		cna,
+	}
+
+	if wh.isOpenshift {
+		resources = append(resources, ssp)
+	}
+
+	toCtx, cancel := context.WithTimeout(ctx, updateDryRunTimeOut)
+	defer cancel()
+
+	eg, egCtx := xsync.WithContext(toCtx)
+
+	for _, obj := range resources {
+		func(o client.Object) {
+			eg.Go(func() error {
+				return wh.createOperatorCr(egCtx, hc, o)
+			})
+		}(obj)
+	}
+
+	return eg.Wait()
+}
+
+func (wh *WebhookHandler) createOperatorCr(ctx context.Context, hc *v1beta1.HyperConverged, required client.Object) error {
+	err := wh.cli.Create(ctx, required, &client.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+	if apierrors.IsAlreadyExists(err) {
+		// a leftover of a previous HyperConverged CR, that HCO is going to update
+		return wh.updateOperatorCr(ctx, hc, required, &client.UpdateOptions{DryRun: []string{metav1.DryRunAll}})
+	} else if isOperandValidationUnavailable(err) {
+		// the operand CR does not exist yet, and its operator may still be starting. Don't block the creation of the
+		// HyperConverged CR; a bad configuration is reported later, in the operand conditions.
+		wh.logger.Info("can't validate the object; skipping the dry-run create", "kind", required.GetObjectKind(), "reason", err.Error())
+		return nil
+	} else if err != nil {
+		wh.logger.Error(err, "failed to dry-run create the object", "kind", required.GetObjectKind())
+		return err
+	}
+
+	wh.logger.Info("dry-run create the object passed", "kind", required.GetObjectKind())
+	return nil
+}
+
+// isOperandValidationUnavailable returns true if the dry-run create failed, not because the operand CR was rejected,
+// but because the operand CRD is not installed yet, or the operand webhook is not available
+func isOperandValidationUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return meta.IsNoMatchError(err) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		apierrors.IsTimeout(err) ||
+		apierrors.IsServiceUnavailable(err) ||
+		(apierrors.IsInternalError(err) && strings.Contains(err.Error(), "failed calling webhook"))
+}
+
 func (wh *WebhookHandler) updateOperatorCr(ctx context.Context, hc *v1beta1.HyperConverged, exists client.Object, opts *client.UpdateOptions) error {
 	err := hcoutil.GetRuntimeObject(ctx, wh.cli, exists)
 	if err != nil {
@@ -301,6 +620,10 @@ func (wh *WebhookHandler) updateOperatorCr(ctx context.Context, hc *v1beta1.Hype
 func (wh *WebhookHandler) ValidateDelete(ctx context.Context, dryrun bool, hc *v1beta1.HyperConverged) error {
 	wh.logger.Info("Validating delete", "name", hc.Name, "namespace", hc.Namespace)
 
+	if err := wh.validateDeletionProtection(ctx, hc); err != nil {
+		return err
+	}
+
 	kv := operands.NewKubeVirtWithNameOnly(hc)
 	cdi := operands.NewCDIWithNameOnly(hc)
 
@@ -408,6 +731,633 @@ func (wh *WebhookHandler) validateFeatureGates(hc *v1beta1.HyperConverged) error
 	return nil
 }
 
+// validateFeatureGateArchitectures rejects enabling a feature gate that is not supported on the architectures of the
+// cluster nodes. A feature gate that is already enabled is not rejected, so unrelated updates are not blocked if nodes
+// of another architecture were added to the cluster.
+func (wh *WebhookHandler) validateFeatureGateArchitectures(requested, exists *v1beta1.HyperConverged) error {
+	alreadyEnabled := make(map[string]bool)
+	if exists != nil {
+		for _, fg := range operands.GetUnsupportedFeatureGates(exists) {
+			alreadyEnabled[fg.Name] = true
+		}
+	}
+
+	for _, fg := range operands.GetUnsupportedFeatureGates(requested) {
+		if !alreadyEnabled[fg.Name] {
+			return fmt.Errorf("the %s feature gate is not supported on the %s node architectures", fg.Name, strings.Join(fg.Architectures, ", "))
+		}
+	}
+
+	return nil
+}
+
+// managedServiceLockedAnnotations are the annotations that can't be set on the managed OpenShift services, as the
+// managed services don't support the unsafe modifications of the operand CRs.
+var managedServiceLockedAnnotations = []string{
+	common.JSONPatchKVAnnotationName,
+	common.JSONPatchCDIAnnotationName,
+	common.JSONPatchCNAOAnnotationName,
+	common.JSONPatchSSPAnnotationName,
+	common.JSONPatchMTQAnnotationName,
+}
+
+// validateManagedServiceLockedFields rejects setting the fields that are locked on the managed OpenShift services: the
+// TLS security profile, that is managed by the service in the APIServer CR, and the unsafe JSON patch annotations. A
+// field that already has the same value is not rejected, so unrelated updates are not blocked if the cluster became a
+// managed service after the field was set.
+func (wh *WebhookHandler) validateManagedServiceLockedFields(requested, exists *v1beta1.HyperConverged) error {
+	managedService := hcoutil.GetClusterInfo().GetManagedService()
+	if !managedService.IsManaged() {
+		return nil
+	}
+
+	if requested.Spec.TLSSecurityProfile != nil && (exists == nil || !reflect.DeepEqual(requested.Spec.TLSSecurityProfile, exists.Spec.TLSSecurityProfile)) {
+		return fmt.Errorf("the tlsSecurityProfile field can't be set on %s; the TLS security profile of the cluster APIServer is used", managedService)
+	}
+
+	for _, annotation := range managedServiceLockedAnnotations {
+		value, found := requested.Annotations[annotation]
+		if found && (exists == nil || exists.Annotations[annotation] != value) {
+			return fmt.Errorf("the %s annotation can't be set on %s", annotation, managedService)
+		}
+	}
+
+	return nil
+}
+
+// validateEvictionStrategy rejects the LiveMigrate eviction strategy on a single node cluster; there is no other node
+// to migrate the virtual machines to, so they would block the node drain
+func (wh *WebhookHandler) validateEvictionStrategy(hc *v1beta1.HyperConverged) error {
+	if hcoutil.GetClusterInfo().IsInfrastructureHighlyAvailable() {
+		return nil
+	}
+
+	if hc.Spec.EvictionStrategy != nil && *hc.Spec.EvictionStrategy == kubevirtcorev1.EvictionStrategyLiveMigrate {
+		return fmt.Errorf("the %s eviction strategy is not supported on single node clusters, because the virtual machines can't be migrated to another node; use %s, %s or %s instead",
+			kubevirtcorev1.EvictionStrategyLiveMigrate,
+			kubevirtcorev1.EvictionStrategyNone,
+			kubevirtcorev1.EvictionStrategyLiveMigrateIfPossible,
+			kubevirtcorev1.EvictionStrategyExternal,
+		)
+	}
+
+	return nil
+}
+
+// validateFeatureGateCRDs rejects enabling a feature gate that deploys an operand, if the CRD of the operand is not
+// installed in the cluster. HCO would otherwise fail to create the operand CR on every reconciliation.
+func (wh *WebhookHandler) validateFeatureGateCRDs(ctx context.Context, hc *v1beta1.HyperConverged) error {
+	if hc.Spec.FeatureGates.EnableManagedTenantQuota == nil || !*hc.Spec.FeatureGates.EnableManagedTenantQuota {
+		return nil
+	}
+
+	err := wh.cli.Get(ctx, client.ObjectKey{Name: hcoutil.MTQCRDName}, &apiextensionsv1.CustomResourceDefinition{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("the EnableManagedTenantQuota feature gate can't be enabled, because the %s CRD is not installed in the cluster", hcoutil.MTQCRDName)
+		}
+		return fmt.Errorf("failed to read the %s CRD; %w", hcoutil.MTQCRDName, err)
+	}
+
+	return nil
+}
+
+// validateWorkloadUpdateStrategy rejects a workload update strategy that KubeVirt can't apply
+func (wh *WebhookHandler) validateWorkloadUpdateStrategy(hc *v1beta1.HyperConverged) error {
+	strategy := hc.Spec.WorkloadUpdateStrategy
+
+	methods := make(map[string]bool, len(strategy.WorkloadUpdateMethods))
+	for _, method := range strategy.WorkloadUpdateMethods {
+		switch kubevirtcorev1.WorkloadUpdateMethod(method) {
+		case kubevirtcorev1.WorkloadUpdateMethodLiveMigrate, kubevirtcorev1.WorkloadUpdateMethodEvict:
+		default:
+			return fmt.Errorf("spec.workloadUpdateStrategy.workloadUpdateMethods: unsupported method %q; the supported methods are %s and %s",
+				method, kubevirtcorev1.WorkloadUpdateMethodLiveMigrate, kubevirtcorev1.WorkloadUpdateMethodEvict)
+		}
+
+		if methods[method] {
+			return fmt.Errorf("spec.workloadUpdateStrategy.workloadUpdateMethods: the %q method is listed more than once", method)
+		}
+		methods[method] = true
+	}
+
+	if strategy.BatchEvictionSize != nil && *strategy.BatchEvictionSize < 1 {
+		return fmt.Errorf("spec.workloadUpdateStrategy.batchEvictionSize must be a positive number")
+	}
+
+	if strategy.BatchEvictionInterval != nil && strategy.BatchEvictionInterval.Duration <= 0 {
+		return fmt.Errorf("spec.workloadUpdateStrategy.batchEvictionInterval must be a positive duration")
+	}
+
+	return nil
+}
+
+// validateWorkloadDensityPreset rejects explicit settings that contradict the selected workload density preset
+func (wh *WebhookHandler) validateWorkloadDensityPreset(hc *v1beta1.HyperConverged) error {
+	if hc.Spec.ResourceRequirements == nil || hc.Spec.ResourceRequirements.VmiCPUAllocationRatio == nil {
+		return nil
+	}
+
+	ratio := *hc.Spec.ResourceRequirements.VmiCPUAllocationRatio
+	switch hc.Spec.WorkloadDensityPreset {
+	case v1beta1.HyperConvergedPerformancePreset:
+		if ratio != 1 {
+			return fmt.Errorf("the performance workload density preset does not allow CPU overcommit; spec.resourceRequirements.vmiCPUAllocationRatio must be 1")
+		}
+	case v1beta1.HyperConvergedDensePreset:
+		if ratio < minDensePresetCPUAllocationRatio {
+			return fmt.Errorf("the dense workload density preset requires spec.resourceRequirements.vmiCPUAllocationRatio of at least %d", minDensePresetCPUAllocationRatio)
+		}
+	}
+
+	return nil
+}
+
+// validateHigherWorkloadDensity rejects a memory overcommit percentage that is out of range, or that contradicts the
+// selected workload density preset
+func (wh *WebhookHandler) validateHigherWorkloadDensity(hc *v1beta1.HyperConverged) error {
+	if hc.Spec.HigherWorkloadDensity == nil || hc.Spec.HigherWorkloadDensity.MemoryOvercommitPercentage == nil {
+		return nil
+	}
+
+	memoryOvercommit := *hc.Spec.HigherWorkloadDensity.MemoryOvercommitPercentage
+	if memoryOvercommit < minMemoryOvercommitPercentage {
+		return fmt.Errorf("spec.higherWorkloadDensity.memoryOvercommitPercentage must be at least %d", minMemoryOvercommitPercentage)
+	}
+
+	if hc.Spec.WorkloadDensityPreset == v1beta1.HyperConvergedPerformancePreset && memoryOvercommit > maxPerformancePresetMemoryOvercommit {
+		return fmt.Errorf("the performance workload density preset does not allow memory overcommit; spec.higherWorkloadDensity.memoryOvercommitPercentage must not be above %d", maxPerformancePresetMemoryOvercommit)
+	}
+
+	return nil
+}
+
+// validateImportProxy rejects malformed CDI importer proxy URLs. The URLs may contain credentials, so they are not
+// included in the error message.
+func (wh *WebhookHandler) validateImportProxy(hc *v1beta1.HyperConverged) error {
+	if hc.Spec.StorageImport == nil || hc.Spec.StorageImport.ImportProxy == nil {
+		return nil
+	}
+
+	proxy := hc.Spec.StorageImport.ImportProxy
+	for field, proxyURL := range map[string]*string{
+		"HTTPProxy":  proxy.HTTPProxy,
+		"HTTPSProxy": proxy.HTTPSProxy,
+	} {
+		if proxyURL == nil || len(*proxyURL) == 0 {
+			continue
+		}
+
+		u, err := url.Parse(*proxyURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+			return fmt.Errorf("spec.storageImport.importProxy.%s must be a valid http or https URL", field)
+		}
+	}
+
+	return nil
+}
+
+// validateUploadProxyURLOverride rejects an upload proxy URL that clients can't use. A URL without a scheme is allowed,
+// because virtctl uses https in this case.
+func (wh *WebhookHandler) validateUploadProxyURLOverride(hc *v1beta1.HyperConverged) error {
+	if hc.Spec.UploadProxyURLOverride == nil {
+		return nil
+	}
+
+	uploadProxyURL := *hc.Spec.UploadProxyURLOverride
+	if len(uploadProxyURL) == 0 {
+		return fmt.Errorf("spec.uploadProxyURLOverride must not be empty")
+	}
+
+	if !strings.Contains(uploadProxyURL, "://") {
+		uploadProxyURL = "https://" + uploadProxyURL
+	}
+
+	u, err := url.Parse(uploadProxyURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return fmt.Errorf("spec.uploadProxyURLOverride must be a valid host name or http or https URL; %q", *hc.Spec.UploadProxyURLOverride)
+	}
+
+	return nil
+}
+
+// validateMigrationPolicies rejects migration policies that KubeVirt would refuse, so the error is reported when the
+// HyperConverged CR is modified, rather than failing the reconciliation later.
+func (wh *WebhookHandler) validateMigrationPolicies(hc *v1beta1.HyperConverged) error {
+	for _, policy := range hc.Spec.MigrationPolicies {
+		if errs := validation.IsDNS1123Subdomain(policy.Name); len(errs) > 0 {
+			return fmt.Errorf("spec.migrationPolicies: %q is not a valid MigrationPolicy name; %s", policy.Name, strings.Join(errs, "; "))
+		}
+
+		if bandwidth := policy.Spec.BandwidthPerMigration; bandwidth != nil && bandwidth.Sign() < 0 {
+			return fmt.Errorf("spec.migrationPolicies[%s].spec.bandwidthPerMigration must not be negative", policy.Name)
+		}
+
+		if timeout := policy.Spec.CompletionTimeoutPerGiB; timeout != nil && *timeout < 0 {
+			return fmt.Errorf("spec.migrationPolicies[%s].spec.completionTimeoutPerGiB must not be negative", policy.Name)
+		}
+	}
+
+	return nil
+}
+
+// validateCommonTemplatesNamespace rejects a common templates namespace that HCO can't create
+func (wh *WebhookHandler) validateCommonTemplatesNamespace(hc *v1beta1.HyperConverged) error {
+	if hc.Spec.CommonTemplatesNamespace == nil {
+		return nil
+	}
+
+	return validateNamespaceName("spec.commonTemplatesNamespace", *hc.Spec.CommonTemplatesNamespace)
+}
+
+// validateCommonBootImageNamespace rejects a golden images namespace that the DataImportCrons can't be created in. An
+// empty value is allowed, and means the default namespace.
+func (wh *WebhookHandler) validateCommonBootImageNamespace(hc *v1beta1.HyperConverged) error {
+	if hc.Spec.CommonBootImageNamespace == nil || len(*hc.Spec.CommonBootImageNamespace) == 0 {
+		return nil
+	}
+
+	return validateNamespaceName("spec.commonBootImageNamespace", *hc.Spec.CommonBootImageNamespace)
+}
+
+// validatePauseReconcileAnnotation rejects operand names that are not supported by the pause-reconcile annotation
+func (wh *WebhookHandler) validatePauseReconcileAnnotation(hc *v1beta1.HyperConverged) error {
+	if _, unknown := operands.GetPausedOperands(hc); len(unknown) > 0 {
+		return fmt.Errorf("the %s annotation contains unsupported operands: %s; the supported operands are: %s",
+			common.PauseReconcileAnnotationName, strings.Join(unknown, ", "), strings.Join(operands.PausableOperands, ", "))
+	}
+
+	return nil
+}
+
+// reservedMetadataDomains are the label and annotation prefixes that are used by Kubernetes, KubeVirt, OpenShift and
+// HCO itself, and so can't be used in the custom metadata
+var reservedMetadataDomains = []string{"kubernetes.io", "k8s.io", "kubevirt.io", "openshift.io"}
+
+// validateCustomMetadata rejects invalid custom labels and annotations, and keys that HCO or the operands may set
+func (wh *WebhookHandler) validateCustomMetadata(hc *v1beta1.HyperConverged) error {
+	md := hc.Spec.Metadata
+	if md == nil {
+		return nil
+	}
+
+	if errs := metav1validation.ValidateLabels(md.Labels, field.NewPath("spec", "metadata", "labels")); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+
+	if errs := apivalidation.ValidateAnnotations(md.Annotations, field.NewPath("spec", "metadata", "annotations")); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+
+	for _, key := range append(lo.Keys(md.Labels), lo.Keys(md.Annotations)...) {
+		if isReservedMetadataKey(key) {
+			return fmt.Errorf("spec.metadata: the %q key is reserved", key)
+		}
+	}
+
+	return nil
+}
+
+func isReservedMetadataKey(key string) bool {
+	if key == hcoutil.AppLabel {
+		return true
+	}
+
+	prefix, _, found := strings.Cut(key, "/")
+	if !found {
+		return false
+	}
+
+	for _, domain := range reservedMetadataDomains {
+		if prefix == domain || strings.HasSuffix(prefix, "."+domain) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateDeletionProtectionAnnotation rejects an unsupported value of the deletion protection annotation
+func (wh *WebhookHandler) validateDeletionProtectionAnnotation(hc *v1beta1.HyperConverged) error {
+	value, found := hc.Annotations[common.DeletionProtectionAnnotationName]
+	if !found || value == common.DeletionProtectionAlways || value == common.DeletionProtectionIfVirtualMachinesExist {
+		return nil
+	}
+
+	return fmt.Errorf("the %s annotation contains an unsupported value: %q; the supported values are: %s, %s",
+		common.DeletionProtectionAnnotationName, value, common.DeletionProtectionAlways, common.DeletionProtectionIfVirtualMachinesExist)
+}
+
+// validateDeletionProtection denies the deletion of the HyperConverged CR, if it is protected by the deletion
+// protection annotation. Removing the annotation allows the deletion.
+func (wh *WebhookHandler) validateDeletionProtection(ctx context.Context, hc *v1beta1.HyperConverged) error {
+	switch hc.Annotations[common.DeletionProtectionAnnotationName] {
+	case common.DeletionProtectionAlways:
+		return fmt.Errorf("the HyperConverged CR is protected from deletion by the %s annotation; remove the annotation in order to delete it",
+			common.DeletionProtectionAnnotationName)
+
+	case common.DeletionProtectionIfVirtualMachinesExist:
+		// read from the API server; the webhook is not allowed to watch the VirtualMachines, and caching all of them
+		// only to check if there is at least one, is a waste of memory
+		vms := &kubevirtcorev1.VirtualMachineList{}
+		if err := wh.apiReader.List(ctx, vms, client.Limit(1)); err != nil {
+			return fmt.Errorf("failed to read the VirtualMachines; %w", err)
+		}
+
+		if len(vms.Items) > 0 {
+			return fmt.Errorf("the HyperConverged CR is protected from deletion by the %s annotation, while there are VirtualMachines in the cluster; remove the VirtualMachines, or the annotation, in order to delete it",
+				common.DeletionProtectionAnnotationName)
+		}
+	}
+
+	return nil
+}
+
+// validateNamespaceName rejects an invalid namespace name. The "kube-" prefix is reserved for the Kubernetes system
+// namespaces.
+func validateNamespaceName(field, namespace string) error {
+	if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+		return fmt.Errorf("%s: %q is not a valid namespace name; %s", field, namespace, strings.Join(errs, "; "))
+	}
+
+	if strings.HasPrefix(namespace, "kube-") {
+		return fmt.Errorf("%s: the %q namespace is reserved for the Kubernetes system", field, namespace)
+	}
+
+	return nil
+}
+
+// validateVMISeccompProfile rejects a seccomp profile of the virt-launcher pods that KubeVirt can't use
+func (wh *WebhookHandler) validateVMISeccompProfile(hc *v1beta1.HyperConverged) error {
+	profile := hc.Spec.VMISeccompProfile
+	if profile == nil {
+		return nil
+	}
+
+	switch profile.Type {
+	case corev1.SeccompProfileTypeLocalhost:
+		if profile.LocalhostProfile == nil || len(*profile.LocalhostProfile) == 0 {
+			return fmt.Errorf("spec.vmiSeccompProfile.localhostProfile is required for the %s seccomp profile type", corev1.SeccompProfileTypeLocalhost)
+		}
+	case corev1.SeccompProfileTypeRuntimeDefault, corev1.SeccompProfileTypeUnconfined:
+		if profile.LocalhostProfile != nil {
+			return fmt.Errorf("spec.vmiSeccompProfile.localhostProfile is only supported for the %s seccomp profile type", corev1.SeccompProfileTypeLocalhost)
+		}
+	default:
+		return fmt.Errorf("spec.vmiSeccompProfile.type: unsupported seccomp profile type %q", profile.Type)
+	}
+
+	return nil
+}
+
+// validateDefaultCPUModel rejects a default CPU model that KubeVirt can't use. An obsolete CPU model is rejected,
+// because virt-launcher pods that require it can't be scheduled on any node.
+func (wh *WebhookHandler) validateDefaultCPUModel(hc *v1beta1.HyperConverged) error {
+	if hc.Spec.DefaultCPUModel == nil {
+		return nil
+	}
+
+	cpuModel := *hc.Spec.DefaultCPUModel
+	if len(cpuModel) == 0 {
+		return fmt.Errorf("spec.defaultCPUModel must not be empty")
+	}
+
+	if strings.ContainsAny(cpuModel, " \t\n") {
+		return fmt.Errorf("spec.defaultCPUModel must not contain whitespaces; %q", cpuModel)
+	}
+
+	if operands.IsObsoleteCPUModel(hc, cpuModel) {
+		return fmt.Errorf("spec.defaultCPUModel %q is an obsolete CPU model", cpuModel)
+	}
+
+	return nil
+}
+
+// validateVMStateStorageClass rejects a VM state storage class that does not exist in the cluster
+func (wh *WebhookHandler) validateVMStateStorageClass(ctx context.Context, hc *v1beta1.HyperConverged) error {
+	if hc.Spec.VMStateStorageClass == nil {
+		return nil
+	}
+
+	scName := *hc.Spec.VMStateStorageClass
+	if len(scName) == 0 {
+		return fmt.Errorf("spec.vmStateStorageClass must not be empty")
+	}
+
+	err := wh.cli.Get(ctx, client.ObjectKey{Name: scName}, &storagev1.StorageClass{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("spec.vmStateStorageClass: the %q storage class does not exist", scName)
+		}
+		return fmt.Errorf("failed to read the %q storage class; %w", scName, err)
+	}
+
+	return nil
+}
+
+// validateReplicas rejects a replica count of the control-plane components, that is larger than the number of the
+// nodes that are available for the infra components; i.e. the nodes that match the infra node selector. The replica
+// count is not supported for the workloads components.
+func (wh *WebhookHandler) validateReplicas(ctx context.Context, hc *v1beta1.HyperConverged) error {
+	if hc.Spec.Workloads.Replicas != nil {
+		return fmt.Errorf("spec.workloads.replicas: the replica count is only supported for the infra components")
+	}
+
+	if hc.Spec.Infra.Replicas == nil {
+		return nil
+	}
+
+	replicas := int(*hc.Spec.Infra.Replicas)
+
+	var opts []client.ListOption
+	if hc.Spec.Infra.NodePlacement != nil && len(hc.Spec.Infra.NodePlacement.NodeSelector) > 0 {
+		opts = append(opts, client.MatchingLabels(hc.Spec.Infra.NodePlacement.NodeSelector))
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := wh.cli.List(ctx, nodes, opts...); err != nil {
+		return fmt.Errorf("failed to read the cluster nodes; %w", err)
+	}
+
+	if replicas > len(nodes.Items) {
+		return fmt.Errorf("spec.infra.replicas: %d replicas were requested, but only %d nodes are available for the infra components", replicas, len(nodes.Items))
+	}
+
+	return nil
+}
+
+// validateTopologySpreadConstraints rejects invalid topology spread constraints, that would otherwise be rejected only
+// when HCO deploys its components
+func (wh *WebhookHandler) validateTopologySpreadConstraints(hc *v1beta1.HyperConverged) error {
+	for i, constraint := range hc.Spec.Infra.TopologySpreadConstraints {
+		if constraint.MaxSkew < 1 {
+			return fmt.Errorf("spec.infra.topologySpreadConstraints[%d].maxSkew must be greater than zero", i)
+		}
+
+		if len(constraint.TopologyKey) == 0 {
+			return fmt.Errorf("spec.infra.topologySpreadConstraints[%d].topologyKey must not be empty", i)
+		}
+
+		if constraint.WhenUnsatisfiable != corev1.DoNotSchedule && constraint.WhenUnsatisfiable != corev1.ScheduleAnyway {
+			return fmt.Errorf("spec.infra.topologySpreadConstraints[%d].whenUnsatisfiable: unsupported value %q; must be %q or %q", i, constraint.WhenUnsatisfiable, corev1.DoNotSchedule, corev1.ScheduleAnyway)
+		}
+	}
+
+	return nil
+}
+
+// validateLiveMigrationNetwork rejects a live migration network, if its NetworkAttachmentDefinition does not exist in
+// the HCO namespace. KubeVirt would otherwise silently use the pod network for the migrations.
+func (wh *WebhookHandler) validateLiveMigrationNetwork(ctx context.Context, hc *v1beta1.HyperConverged) error {
+	if hc.Spec.LiveMigrationConfig.Network == nil || len(*hc.Spec.LiveMigrationConfig.Network) == 0 {
+		return nil
+	}
+
+	network := *hc.Spec.LiveMigrationConfig.Network
+
+	exists, err := hcoutil.NetworkAttachmentDefinitionExists(ctx, wh.cli, wh.namespace, network)
+	if err != nil {
+		return fmt.Errorf("failed to read the %q NetworkAttachmentDefinition; %w", network, err)
+	}
+
+	if !exists {
+		return fmt.Errorf("spec.liveMigrationConfig.network: the %q NetworkAttachmentDefinition does not exist in the %s namespace", network, wh.namespace)
+	}
+
+	return nil
+}
+
+// validateTrustedCABundle rejects a trusted CA bundle ConfigMap that does not exist in the HCO namespace, or that
+// does not contain any certificate. The CDI importer pods would otherwise fail to import the boot images.
+func (wh *WebhookHandler) validateTrustedCABundle(ctx context.Context, hc *v1beta1.HyperConverged) error {
+	if hc.Spec.TrustedCABundle == nil {
+		return nil
+	}
+
+	cmName := *hc.Spec.TrustedCABundle
+	if len(cmName) == 0 {
+		return fmt.Errorf("spec.trustedCABundle must not be empty")
+	}
+
+	// the ConfigMap is created by the user, without the HCO labels, so it is not cached by the webhook
+	cm := &corev1.ConfigMap{}
+	err := wh.apiReader.Get(ctx, client.ObjectKey{Namespace: wh.namespace, Name: cmName}, cm)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("spec.trustedCABundle: the %q ConfigMap does not exist in the %s namespace", cmName, wh.namespace)
+		}
+		return fmt.Errorf("failed to read the %q ConfigMap; %w", cmName, err)
+	}
+
+	if len(cm.Data) == 0 {
+		return fmt.Errorf("spec.trustedCABundle: the %q ConfigMap does not contain any CA certificate", cmName)
+	}
+
+	return nil
+}
+
+// getScratchSpaceStorageClassWarnings warns about a scratch space storage class that does not exist in the cluster. The
+// request is not rejected, because CDI falls back to the default storage class, but the fallback is usually not what
+// the user meant.
+func (wh *WebhookHandler) getScratchSpaceStorageClassWarnings(ctx context.Context, hc *v1beta1.HyperConverged) []string {
+	if hc.Spec.ScratchSpaceStorageClass == nil || len(*hc.Spec.ScratchSpaceStorageClass) == 0 {
+		return nil
+	}
+
+	scName := *hc.Spec.ScratchSpaceStorageClass
+	err := wh.cli.Get(ctx, client.ObjectKey{Name: scName}, &storagev1.StorageClass{})
+	if err == nil {
+		return nil
+	}
+
+	if !apierrors.IsNotFound(err) {
+		wh.logger.Error(err, "failed to read the scratch space storage class", "name", scName)
+		return nil
+	}
+
+	return []string{fmt.Sprintf("spec.scratchSpaceStorageClass: the %q storage class does not exist; the default storage class will be used for the scratch space", scName)}
+}
+
+// getDeprecatedFieldsWarnings warns about deprecated fields and feature gates that are set in the HyperConverged CR,
+// with their replacement, so they can be migrated before they are removed from the API
+func (wh *WebhookHandler) getDeprecatedFieldsWarnings(hc *v1beta1.HyperConverged) []string {
+	var warnings []string
+
+	if hc.Spec.LocalStorageClassName != "" { //nolint SA1019
+		warnings = append(warnings, "spec.localStorageClassName is deprecated, and is ignored by HCO; please remove it")
+	}
+
+	if hc.Spec.FeatureGates.NonRoot != nil && !*hc.Spec.FeatureGates.NonRoot { //nolint SA1019
+		warnings = append(warnings, "spec.featureGates.nonRoot is deprecated, and has no replacement; in the future, the virt-launcher pods will always run as non-root")
+	}
+
+	if mdc := hc.Spec.MediatedDevicesConfiguration; mdc != nil {
+		if len(mdc.MediatedDevicesTypes) > 0 { //nolint SA1019
+			warnings = append(warnings, "spec.mediatedDevicesConfiguration.mediatedDevicesTypes is deprecated; please use spec.mediatedDevicesConfiguration.mediatedDeviceTypes instead")
+		}
+
+		for i, nmdc := range mdc.NodeMediatedDeviceTypes {
+			if len(nmdc.MediatedDevicesTypes) > 0 { //nolint SA1019
+				warnings = append(warnings, fmt.Sprintf("spec.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[%d].mediatedDevicesTypes is deprecated; please use spec.mediatedDevicesConfiguration.nodeMediatedDeviceTypes[%d].mediatedDeviceTypes instead", i, i))
+			}
+		}
+	}
+
+	return warnings
+}
+
+// getFeatureGateRemovalWarnings warns about the resources that HCO is going to remove, because a feature gate was
+// disabled
+func (wh *WebhookHandler) getFeatureGateRemovalWarnings(requested, exists *v1beta1.HyperConverged) []string {
+	var warnings []string
+
+	if operands.IsMTQEnabled(exists) && !operands.IsMTQEnabled(requested) {
+		mtq := operands.NewMTQWithNameOnly(exists)
+		warning := fmt.Sprintf("disabling the enableManagedTenantQuota feature gate removes the %s MTQ CR, and uninstalls MTQ from the cluster", mtq.Name)
+
+		quotas := operands.NewTenantQuotas(exists)
+		if len(quotas) > 0 {
+			names := make([]string, 0, len(quotas))
+			for key := range quotas {
+				names = append(names, key.String())
+			}
+			sort.Strings(names)
+			warning += fmt.Sprintf("; the following VirtualMachineMigrationResourceQuotas are removed as well: %s", strings.Join(names, ", "))
+		}
+
+		warnings = append(warnings, warning)
+	}
+
+	return warnings
+}
+
+// validateServiceMonitorConfig rejects a ServiceMonitor configuration that Prometheus would fail to load
+func (wh *WebhookHandler) validateServiceMonitorConfig(hc *v1beta1.HyperConverged) error {
+	if hc.Spec.Monitoring == nil || hc.Spec.Monitoring.ServiceMonitor == nil {
+		return nil
+	}
+
+	config := hc.Spec.Monitoring.ServiceMonitor
+	if config.Interval != nil && config.Interval.Duration < 0 {
+		return fmt.Errorf("spec.monitoring.serviceMonitor.interval must not be negative")
+	}
+
+	for i, relabeling := range config.MetricRelabelings {
+		if relabeling.Regex != "" {
+			// Prometheus anchors the regular expression at both ends
+			if _, err := regexp.Compile("^(?:" + relabeling.Regex + ")$"); err != nil {
+				return fmt.Errorf("invalid regex in spec.monitoring.serviceMonitor.metricRelabelings[%d]; %w", i, err)
+			}
+		}
+
+		if (relabeling.Action == "" || relabeling.Action == "replace") && relabeling.TargetLabel == "" {
+			return fmt.Errorf("spec.monitoring.serviceMonitor.metricRelabelings[%d]: the replace action requires a target label", i)
+		}
+	}
+
+	return nil
+}
+
 func hasRequiredHTTP2Ciphers(ciphers []string) bool {
 	var requiredHTTP2Ciphers = []string{
 		"ECDHE-RSA-AES128-GCM-SHA256",
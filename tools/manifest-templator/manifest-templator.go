@@ -105,6 +105,8 @@ func main() {
 	// enough to deploy the HCO itself.
 	// 1 deployment
 	// 1 service account
+	// 1 role
+	// 1 role binding
 	// 1 cluster role
 	// 1 cluster role binding
 	// as we handle each CSV we will add to our slices of deployments,
@@ -134,8 +136,16 @@ func main() {
 	serviceAccounts := map[string]v1.ServiceAccount{
 		"hyperconverged-cluster-operator": components.GetServiceAccount(*operatorNamespace),
 	}
-	permissions := make([]rbacv1.Role, 0)
-	roleBindings := make([]rbacv1.RoleBinding, 0)
+	hcoPermission := csvv1alpha1.StrategyDeploymentPermissions{
+		ServiceAccountName: "hyperconverged-cluster-operator",
+		Rules:              components.GetPermissions(),
+	}
+	permissions := []rbacv1.Role{
+		getRole(hcoPermission),
+	}
+	roleBindings := []rbacv1.RoleBinding{
+		getRoleBinding(hcoPermission),
+	}
 	clusterPermissions := []rbacv1.ClusterRole{
 		components.GetClusterRole(),
 	}
@@ -11,7 +11,23 @@ import (
 const (
 	title      = "# Hyperconverged Cluster Operator metrics\n"
 	background = "This document aims to help users that are not familiar with metrics exposed by the Hyperconverged Cluster Operator.\n" +
-		"All metrics documented here are auto-generated by the utility tool `tools/metricsdocs` and reflects exactly what is being exposed.\n\n"
+		"All metrics documented here are auto-generated by the utility tool `tools/metricsdocs` and reflects exactly what is being exposed.\n\n" +
+		"HCO serves its metrics over HTTPS, on port 8383 of the `kubevirt-hyperconverged-operator-metrics` service. " +
+		"The service prefers dual-stack, so the metrics are reachable over both IPv4 and IPv6 on dual-stack clusters, " +
+		"and over the single IP family of single-stack IPv4 or IPv6 clusters. " +
+		"On OpenShift, the serving certificate is generated by the service CA operator into the " +
+		"`kubevirt-hyperconverged-operator-metrics-cert` secret, and HCO reloads it when it is rotated. " +
+		"On other clusters, HCO uses a self-signed certificate. " +
+		"The `hyperconverged-cluster-operator-allow-metrics` NetworkPolicy only allows the monitoring namespace " +
+		"to reach the metrics port. HCO discovers the monitoring namespace and the Prometheus ServiceAccount from the " +
+		"Prometheus CRs in the cluster, preferring `openshift-monitoring` and then `openshift-user-workload-monitoring` " +
+		"on OpenShift; set the `MONITORING_NAMESPACE` and `MONITORING_SERVICE_ACCOUNT` environment variables of the " +
+		"HCO operator deployment to override them. " +
+		"Prometheus scrapes the metrics using a ServiceMonitor. Set the `METRICS_SCRAPE_RESOURCE` environment variable of " +
+		"the HCO operator deployment to `PodMonitor` or to `ScrapeConfig` to use another scrape resource; the PodMonitor " +
+		"scrapes the operator pod directly. On OpenShift, the ScrapeConfig verifies the serving certificate against the " +
+		"service CA bundle, that the service CA operator injects into the `kubevirt-hyperconverged-operator-metrics-ca` " +
+		"ConfigMap.\n\n"
 
 	KVSpecificMetrics = "## Hyperconverged Cluster Operator Metrics List\n"
 